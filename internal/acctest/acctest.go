@@ -9,7 +9,6 @@ import (
 	"sync"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
@@ -52,6 +51,9 @@ var credsEnvVars = []string{
 var regionEnvVar = "NCLOUD_REGION"
 
 func init() {
+	startMockServer()
+	setupVCR()
+
 	testAccProvider = getTestAccProvider(true)
 	testAccClassicProvider = getTestAccProvider(false)
 }
@@ -76,31 +78,45 @@ func getTestAccProvider(isVpc bool) *schema.Provider {
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		d.Set("region", testAccGetRegion())
 		d.Set("support_vpc", isVpc)
-		return provider.ProviderConfigure(ctx, d)
+		return provider.ConfigureWithHTTPClient(ctx, d, testAccHTTPClient())
 	}
 	return p
 }
 
 func TestAccPreCheck(t *testing.T) {
-	testAccProviderConfigure.Do(func() {
-		if v := multiEnvSearch(credsEnvVars); v == "" {
-			t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
+	if testAccVCR != nil {
+		// Calls of other tests would end up in the running test's fixture
+		if !vcrActive(t) {
+			t.Skipf("%s is set and the test doesn't use acctest.VCRTest", vcrModeEnvVar)
 		}
+		// Every fixture holds the calls made configuring the shared providers
+		testAccConfigureProviders(t)
+		return
+	}
 
-		region := testAccGetRegion()
-		log.Printf("[INFO] Test: Using %s as test region", region)
-
-		diags := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
-		if diags.HasError() {
-			t.Fatalf("configuring provider: %v", diags)
-		}
-		diags2 := testAccClassicProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
-		if diags2.HasError() {
-			t.Fatalf("configuring provider: %v", diags2)
-		}
+	testAccProviderConfigure.Do(func() {
+		testAccConfigureProviders(t)
 	})
 }
 
+func testAccConfigureProviders(t *testing.T) {
+	if v := multiEnvSearch(credsEnvVars); v == "" {
+		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
+	}
+
+	region := testAccGetRegion()
+	log.Printf("[INFO] Test: Using %s as test region", region)
+
+	diags := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+	diags2 := testAccClassicProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags2.HasError() {
+		t.Fatalf("configuring provider: %v", diags2)
+	}
+}
+
 func testAccGetRegion() string {
 	v := os.Getenv(regionEnvVar)
 	if v == "" {
@@ -118,9 +134,21 @@ func multiEnvSearch(ks []string) string {
 	return ""
 }
 
+// TestNamePrefix starts the name of everything the acceptance tests create. The
+// sweepers delete objects by it, so it must not start the name of real infrastructure.
+const TestNamePrefix = "tf-acc"
+
+// RandomName returns a name for an object created by t, e.g. "tf-acc-vpc-abcde".
+// kind is a short lower case word; names limited to 15 characters take a 2 letter kind.
+func RandomName(t *testing.T, kind string) string {
+	return fmt.Sprintf("%s-%s-%s", TestNamePrefix, kind, RandString(t, 5))
+}
+
+// GetTestPrefix returns a name without hyphens, for objects that do not allow them
+// or whose name is built by appending to it, e.g. "tfaccabcde".
 func GetTestPrefix() string {
 	rand := acctest.RandString(5)
-	return fmt.Sprintf("tf%s", rand)
+	return fmt.Sprintf("tfacc%s", rand)
 }
 
 func ComposeConfig(config ...string) string {
@@ -176,9 +204,7 @@ func TestAccValidateOneResult(t *testing.T) {
 }
 
 func GetTestClusterName() string {
-	rInt := acctest.RandIntRange(1, 9999)
-	testClusterName := fmt.Sprintf("tf-%d-cluster", rInt)
-	return testClusterName
+	return fmt.Sprintf("%s-cluster-%s", TestNamePrefix, acctest.RandString(5))
 }
 
 func protoV6ProviderFactoriesInit(ctx context.Context, isVpc bool, providerNames ...string) map[string]func() (tfprotov6.ProviderServer, error) {
@@ -204,7 +230,7 @@ func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() t
 	primary.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		d.Set("region", testAccGetRegion())
 		d.Set("support_vpc", isVpc)
-		return provider.ProviderConfigure(ctx, d)
+		return provider.ConfigureWithHTTPClient(ctx, d, testAccHTTPClient())
 	}
 
 	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
@@ -219,7 +245,7 @@ func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() t
 		func() tfprotov6.ProviderServer {
 			return upgradedSdkProvider
 		},
-		providerserver.NewProtocol6(fwprovider.New(primary)),
+		fwprovider.NewProtocol6(primary),
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, servers...)
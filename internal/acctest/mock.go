@@ -0,0 +1,61 @@
+package acctest
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+// mockEnvVar runs acceptance tests against an in-process mock of the NCLOUD API
+// instead of a real account. Only the services covered by mockncp are supported.
+const mockEnvVar = "NCLOUD_MOCK"
+
+var testAccMockServer *mockncp.Server
+
+// startMockServer points the provider at a mock server when NCLOUD_MOCK is set.
+// It runs before the test providers are configured, so the environment is all
+// ProviderConfigure needs to see.
+func startMockServer() {
+	if os.Getenv(mockEnvVar) == "" {
+		return
+	}
+
+	testAccMockServer = mockncp.NewServer(testAccGetRegion())
+	testAccMockServer.OtherRegions = []string{"JPN"}
+	log.Printf("[INFO] Test: Using mock NCLOUD API at %s", testAccMockServer.URL)
+
+	os.Setenv("NCLOUD_API_GW", testAccMockServer.URL)
+	os.Setenv("NCLOUD_OBS_ENDPOINT", testAccMockServer.URL)
+	os.Setenv("NCLOUD_OBS_PATH_STYLE", "1")
+	for _, k := range credsEnvVars {
+		if os.Getenv(k) == "" {
+			os.Setenv(k, "mock")
+		}
+	}
+}
+
+// GetTestMockServer returns the running mock server, or nil when acceptance tests use a real account.
+func GetTestMockServer() *mockncp.Server {
+	return testAccMockServer
+}
+
+// IsMock reports whether acceptance tests run against the mock server.
+func IsMock() bool {
+	return testAccMockServer != nil
+}
+
+// TestAccPreCheckMock skips the test on the mock server when the server lacks
+// one of the APIs it calls, given as for mockncp.Server.Supports. Tests call
+// it from their PreCheck, and it does nothing against a real account.
+func TestAccPreCheckMock(t *testing.T, apis ...string) {
+	if !IsMock() {
+		return
+	}
+	for _, api := range apis {
+		if !testAccMockServer.Supports(api) {
+			t.Skipf("%s is not supported by the mock server", api)
+		}
+	}
+}
@@ -7,7 +7,5 @@ import (
 )
 
 func GetTestServerName() string {
-	rInt := acctest.RandIntRange(1, 9999)
-	testServerName := fmt.Sprintf("tf-%d-vm", rInt)
-	return testServerName
+	return fmt.Sprintf("%s-vm-%s", TestNamePrefix, acctest.RandString(5))
 }
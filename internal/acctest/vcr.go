@@ -0,0 +1,444 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// vcrModeEnvVar makes tests run with VCRTest record the API calls of a real run
+// ("record") or answer them from the recorded fixtures ("replay").
+// vcrPathEnvVar overrides the fixture directory, relative to the package under test.
+const (
+	vcrModeEnvVar  = "NCLOUD_VCR_MODE"
+	vcrPathEnvVar  = "NCLOUD_VCR_PATH"
+	vcrModeRecord  = "record"
+	vcrModeReplay  = "replay"
+	vcrDefaultPath = "testdata/fixtures"
+	vcrRedacted    = "REDACTED"
+)
+
+// vcrSecretPattern matches the names of request and response fields whose values never reach a fixture
+var vcrSecretPattern = regexp.MustCompile(`(?i)password|privatekey|secret|accesskey|token|kubeconfig`)
+
+var vcrJSONFieldPattern = regexp.MustCompile(`("([^"\\]*)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// vcrResponseHeaders are not recorded since they differ on every call or follow from the redacted body
+var vcrResponseHeaders = []string{"Content-Length", "Date", "Set-Cookie", "X-Amz-Request-Id", "X-Amz-Id-2", "X-Ncp-Trace-Id"}
+
+var (
+	// testAccVCR is shared by every test provider while recording or replaying
+	testAccVCR *vcrTransport
+
+	// testAccVCRLock runs tests with fixtures one at a time, so that the
+	// shared providers send their calls to the fixture of the running test
+	testAccVCRLock sync.Mutex
+
+	testAccRandMu sync.Mutex
+	testAccRand   = map[string]*rand.Rand{}
+)
+
+func vcrMode() string {
+	return os.Getenv(vcrModeEnvVar)
+}
+
+// setupVCR installs the recording or replaying transport when NCLOUD_VCR_MODE is set.
+// Replays need no account, so placeholder credentials are used when none are set.
+func setupVCR() {
+	mode := vcrMode()
+	if mode == "" {
+		return
+	}
+	if mode != vcrModeRecord && mode != vcrModeReplay {
+		log.Fatalf("%s must be %q or %q, got %q", vcrModeEnvVar, vcrModeRecord, vcrModeReplay, mode)
+	}
+	// A fixture recorded against the mock server would only show that the
+	// mock agrees with itself on replay
+	if mode == vcrModeRecord && IsMock() {
+		log.Fatalf("%s=%s needs a real account, unset %s", vcrModeEnvVar, vcrModeRecord, mockEnvVar)
+	}
+
+	testAccVCR = &vcrTransport{mode: mode, next: http.DefaultTransport}
+	log.Printf("[INFO] Test: Using %s mode for API fixtures", mode)
+
+	if mode == vcrModeReplay {
+		for _, k := range credsEnvVars {
+			if os.Getenv(k) == "" {
+				os.Setenv(k, "replay")
+			}
+		}
+	}
+}
+
+// testAccHTTPClient returns the HTTP client of the test providers, nil for the default one
+func testAccHTTPClient() *http.Client {
+	if testAccVCR == nil {
+		return nil
+	}
+	return &http.Client{Transport: testAccVCR}
+}
+
+// VCRTest runs c like resource.ParallelTest. When NCLOUD_VCR_MODE is set, it
+// instead runs c alone, recording its API calls to testdata/fixtures/<test name>.json
+// or replaying them from there. Tests without a fixture are skipped in replay mode.
+//
+// Random values in the configuration must come from RandString and RandIntn,
+// so that a replay sends the same requests as the recorded run.
+func VCRTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if testAccVCR == nil {
+		resource.ParallelTest(t, c)
+		return
+	}
+
+	testAccVCRLock.Lock()
+	defer testAccVCRLock.Unlock()
+
+	path := vcrFixturePath(t)
+	cassette := &cassette{test: t.Name()}
+	if testAccVCR.mode == vcrModeReplay {
+		var err error
+		cassette, err = loadCassette(path, t.Name())
+		if errors.Is(err, os.ErrNotExist) {
+			t.Skipf("no API fixture at %s, record it with %s=%s", path, vcrModeEnvVar, vcrModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("loading API fixture: %s", err)
+		}
+	}
+
+	testAccVCR.use(cassette)
+	defer testAccVCR.use(nil)
+
+	resource.Test(t, c)
+
+	if testAccVCR.mode == vcrModeRecord && !t.Failed() {
+		if err := cassette.save(path); err != nil {
+			t.Fatalf("saving API fixture: %s", err)
+		}
+		log.Printf("[INFO] Test: Recorded %d API calls to %s", len(cassette.Interactions), path)
+	}
+}
+
+// vcrActive reports whether t runs under VCRTest with a fixture loaded
+func vcrActive(t *testing.T) bool {
+	if testAccVCR == nil {
+		return false
+	}
+	c := testAccVCR.current()
+	return c != nil && c.test == t.Name()
+}
+
+func vcrFixturePath(t *testing.T) string {
+	dir := os.Getenv(vcrPathEnvVar)
+	if dir == "" {
+		dir = vcrDefaultPath
+	}
+	return filepath.Join(dir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// RandString returns a random lower case string of length n. While recording or
+// replaying API fixtures, the sequence is the same on every run of t.
+func RandString(t *testing.T, n int) string {
+	if testAccVCR == nil {
+		return acctest.RandString(n)
+	}
+
+	r := testRand(t)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = acctest.CharSetAlpha[r.Intn(len(acctest.CharSetAlpha))]
+	}
+	return string(b)
+}
+
+// RandIntn returns a random int in [0, n), repeatable like RandString.
+func RandIntn(t *testing.T, n int) int {
+	if testAccVCR == nil {
+		return rand.Intn(n)
+	}
+	return testRand(t).Intn(n)
+}
+
+func testRand(t *testing.T) *rand.Rand {
+	testAccRandMu.Lock()
+	defer testAccRandMu.Unlock()
+
+	r, ok := testAccRand[t.Name()]
+	if !ok {
+		h := fnv.New64a()
+		h.Write([]byte(t.Name()))
+		r = rand.New(rand.NewSource(int64(h.Sum64())))
+		testAccRand[t.Name()] = r
+	}
+	return r
+}
+
+// cassette holds the API calls of one test, in the order they were made
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+
+	test string
+	mu   sync.Mutex
+	used []bool
+}
+
+type interaction struct {
+	Request  vcrRequest  `json:"request"`
+	Response vcrResponse `json:"response"`
+}
+
+type vcrRequest struct {
+	Method string  `json:"method"`
+	URL    string  `json:"url"`
+	Body   vcrBody `json:"body,omitempty"`
+}
+
+type vcrResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       vcrBody     `json:"body,omitempty"`
+}
+
+// vcrBody is kept as text when possible and base64 encoded otherwise
+type vcrBody []byte
+
+func (b vcrBody) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *vcrBody) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = vcrBody(s)
+		return nil
+	}
+
+	var encoded map[string]string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	v, err := base64.StdEncoding.DecodeString(encoded["base64"])
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+func loadCassette(path, test string) (*cassette, error) {
+	c := &cassette{test: test}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	c.used = make([]bool, len(c.Interactions))
+
+	return c, nil
+}
+
+func (c *cassette) save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func (c *cassette) add(i *interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, i)
+	c.used = append(c.used, true)
+}
+
+// match returns the first unused call equal to req. Once all of them are used,
+// the last one is repeated, so a waiter may poll more often than when recorded.
+func (c *cassette) match(req vcrRequest) *interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *interaction
+	for n, i := range c.Interactions {
+		if i.Request.Method != req.Method || i.Request.URL != req.URL || !bytes.Equal(i.Request.Body, req.Body) {
+			continue
+		}
+		if !c.used[n] {
+			c.used[n] = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+// vcrTransport records or replays the calls of the current cassette. Without
+// one, calls go to the network while recording and fail while replaying.
+type vcrTransport struct {
+	mode string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *cassette
+}
+
+func (v *vcrTransport) use(c *cassette) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.cassette = c
+}
+
+func (v *vcrTransport) current() *cassette {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.cassette
+}
+
+func (v *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := v.current()
+	if c == nil {
+		if v.mode == vcrModeRecord {
+			return v.next.RoundTrip(req)
+		}
+		return nil, fmt.Errorf("replaying %s %s: no API fixture loaded, run the test with acctest.VCRTest", req.Method, req.URL)
+	}
+
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	recorded := vcrRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Body:   redactBody(req.Header.Get("Content-Type"), body),
+	}
+
+	if v.mode == vcrModeReplay {
+		i := c.match(recorded)
+		if i == nil {
+			return nil, fmt.Errorf("replaying %s %s: no matching call in API fixture of %s", req.Method, req.URL, c.test)
+		}
+		return i.Response.toHTTP(req), nil
+	}
+
+	resp, err := v.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	for _, h := range vcrResponseHeaders {
+		header.Del(h)
+	}
+	c.add(&interaction{
+		Request: recorded,
+		Response: vcrResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       redactBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r vcrResponse) toHTTP(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// readBody reads *body and puts back an unread copy of it
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// redactBody replaces secret values of form encoded and JSON bodies
+func redactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		for k := range form {
+			if vcrSecretPattern.MatchString(k) {
+				form[k] = []string{vcrRedacted}
+			}
+		}
+		return []byte(form.Encode())
+	}
+
+	return vcrJSONFieldPattern.ReplaceAllFunc(body, func(field []byte) []byte {
+		m := vcrJSONFieldPattern.FindSubmatch(field)
+		if !vcrSecretPattern.Match(m[2]) {
+			return field
+		}
+		return append(append([]byte{}, m[1]...), `"`+vcrRedacted+`"`...)
+	})
+}
@@ -0,0 +1,106 @@
+package acctest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVCRTransport_recordReplay(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Date", "Mon, 19 Oct 2026 00:00:00 GMT")
+		io.WriteString(w, `{"createResponse": {"name":"`+r.PostForm.Get("name")+`","privateKey":"-----BEGIN KEY-----\nsecret\n"}}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	post := func(client *http.Client, form url.Values) (string, error) {
+		resp, err := client.PostForm(server.URL+"/vserver/v2/create", form)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+	form := url.Values{"name": {"test"}, "userPassword": {"p@ssw0rd"}}
+
+	recorder := &vcrTransport{mode: vcrModeRecord, next: http.DefaultTransport}
+	recorded := &cassette{test: t.Name()}
+	recorder.use(recorded)
+
+	body, err := post(&http.Client{Transport: recorder}, form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, "secret") {
+		t.Fatalf("recording must not change the response seen by the provider, got %s", body)
+	}
+	if err := recorded.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadCassette(path, t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Interactions) != 1 {
+		t.Fatalf("expected 1 recorded call, got %d", len(loaded.Interactions))
+	}
+	i := loaded.Interactions[0]
+	if strings.Contains(string(i.Request.Body), "p@ssw0rd") || strings.Contains(string(i.Response.Body), "secret") {
+		t.Fatalf("secrets must be redacted, got request %s and response %s", i.Request.Body, i.Response.Body)
+	}
+	if i.Response.Header.Get("Date") != "" {
+		t.Fatalf("Date header must not be recorded")
+	}
+
+	replayer := &vcrTransport{mode: vcrModeReplay}
+	replayer.use(loaded)
+	client := &http.Client{Transport: replayer}
+
+	// a waiter polling more often than recorded gets the last answer again
+	for n := 0; n < 2; n++ {
+		body, err := post(client, form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(body, `"name":"test"`) || !strings.Contains(body, `"privateKey":"REDACTED"`) {
+			t.Fatalf("unexpected replayed response %s", body)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("replay must not reach the server, got %d calls", calls)
+	}
+
+	if _, err := post(client, url.Values{"name": {"other"}}); err == nil {
+		t.Fatalf("expected an error for a call missing from the fixture")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		{"application/x-www-form-urlencoded", "name=db&cloudMysqlUserPassword=secret", "cloudMysqlUserPassword=REDACTED&name=db"},
+		{"application/json", `{"kubeconfig": "apiVersion: v1", "name": "nks"}`, `{"kubeconfig": "REDACTED", "name": "nks"}`},
+		{"application/json", `{"rootPassword":"a\"b","id":"1"}`, `{"rootPassword":"REDACTED","id":"1"}`},
+		{"application/xml", "<Name>bucket</Name>", "<Name>bucket</Name>"},
+	}
+
+	for _, tc := range cases {
+		if actual := string(redactBody(tc.contentType, []byte(tc.body))); actual != tc.expected {
+			t.Errorf("redactBody(%s) = %s, expected %s", tc.body, actual, tc.expected)
+		}
+	}
+}
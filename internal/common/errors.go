@@ -21,3 +21,8 @@ func ErrorRequiredArgOnVpc(name string) error {
 func ErrorRequiredArgOnClassic(name string) error {
 	return fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name)
 }
+
+// ErrorDeletionProtected return error for deleting a resource protected by the given argument
+func ErrorDeletionProtected(name, id, argument string) error {
+	return fmt.Errorf("%s (%s) is protected from deletion. Set `%s = false` and apply the change before destroying it", name, id, argument)
+}
@@ -45,6 +45,7 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
+	resourceSchema.ValidateRawResourceConfigFuncs = nil
 
 	var dataSourceSchema *schema.Resource = convertResourceFieldsToDatasourceFields(resourceSchema)
 
@@ -77,6 +78,7 @@ func GetSingularDataSourceItemSchemaContext(resourceSchema *schema.Resource, add
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
+	resourceSchema.ValidateRawResourceConfigFuncs = nil
 
 	var dataSourceSchema *schema.Resource = convertResourceFieldsToDatasourceFields(resourceSchema)
 
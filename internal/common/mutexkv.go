@@ -0,0 +1,37 @@
+package common
+
+import (
+	"sync"
+)
+
+// MutexKV is a set of mutexes by key, used to serialize API calls that cannot run
+// concurrently on the same object, e.g. user changes on one DB instance.
+type MutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// Lock locks the mutex of key, creating it on first use.
+func (m *MutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex of key.
+func (m *MutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.store == nil {
+		m.store = map[string]*sync.Mutex{}
+	}
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
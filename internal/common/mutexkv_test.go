@@ -0,0 +1,43 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMutexKV(t *testing.T) {
+	var mkv MutexKV
+
+	mkv.Lock("a")
+	// other keys are not blocked
+	done := make(chan struct{})
+	go func() {
+		mkv.Lock("b")
+		mkv.Unlock("b")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("lock of key b blocked by key a")
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		mkv.Lock("a")
+		close(locked)
+		mkv.Unlock("a")
+	}()
+	select {
+	case <-locked:
+		t.Fatal("second lock of key a did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mkv.Unlock("a")
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("lock of key a not released")
+	}
+}
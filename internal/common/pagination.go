@@ -0,0 +1,151 @@
+package common
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// DefaultPageSize is the page size requested from list APIs
+	DefaultPageSize int32 = 100
+
+	// MaxPageWorkers bounds the number of pages fetched at the same time
+	MaxPageWorkers = 4
+)
+
+// PageFetcher requests one page of a list API. pageNo starts at 1. It returns
+// the items of the page and the totalRows reported by the API, which may be nil.
+type PageFetcher[T any] func(pageNo, pageSize int32) ([]T, *int32, error)
+
+// ListAllPages walks every page of a paginated list API and returns the items in
+// page order. The first page is requested alone to learn totalRows; the
+// remaining pages are then fetched by at most MaxPageWorkers goroutines. When
+// the API does not report totalRows, pages are requested one by one until a
+// short page is returned.
+//
+// maxResults > 0 stops fetching once that many items are collected.
+func ListAllPages[T any](maxResults int, fetch PageFetcher[T]) ([]T, error) {
+	pageSize := DefaultPageSize
+	if maxResults > 0 && maxResults < int(pageSize) {
+		pageSize = int32(maxResults)
+	}
+
+	first, totalRows, err := fetch(1, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(first) < int(pageSize) {
+		return LimitResults(first, maxResults), nil
+	}
+
+	if totalRows == nil {
+		return listPagesSequential(first, pageSize, maxResults, fetch)
+	}
+
+	total := int(*totalRows)
+	if maxResults > 0 && maxResults < total {
+		total = maxResults
+	}
+	pageCount := (total + int(pageSize) - 1) / int(pageSize)
+
+	pages := make([][]T, pageCount)
+	pages[0] = first
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	pageNos := make(chan int32)
+	for i := 0; i < MaxPageWorkers && i < pageCount-1; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pageNo := range pageNos {
+				items, _, err := fetch(pageNo, pageSize)
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				pages[pageNo-1] = items
+				mu.Unlock()
+			}
+		}()
+	}
+	for pageNo := int32(2); pageNo <= int32(pageCount); pageNo++ {
+		pageNos <- pageNo
+	}
+	close(pageNos)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var all []T
+	for _, page := range pages {
+		all = append(all, page...)
+	}
+
+	return LimitResults(all, maxResults), nil
+}
+
+func listPagesSequential[T any](all []T, pageSize int32, maxResults int, fetch PageFetcher[T]) ([]T, error) {
+	for pageNo := int32(2); maxResults <= 0 || len(all) < maxResults; pageNo++ {
+		items, _, err := fetch(pageNo, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+
+		if len(items) < int(pageSize) {
+			break
+		}
+	}
+
+	return LimitResults(all, maxResults), nil
+}
+
+// LimitResults truncates items to maxResults when maxResults > 0. It is used
+// directly for list APIs that have no paging parameters.
+func LimitResults[T any](items []T, maxResults int) []T {
+	if maxResults > 0 && len(items) > maxResults {
+		return items[:maxResults]
+	}
+	return items
+}
+
+// MaxResultsSchema is the optional "max_results" argument of plural data sources
+func MaxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Maximum number of items to fetch from the API before filters are applied. All pages are fetched when unset.",
+	}
+}
+
+// GetMaxResults returns the "max_results" argument, or 0 when the data source
+// has no such argument or it is unset.
+func GetMaxResults(d *schema.ResourceData) int {
+	if v, ok := d.GetOk("max_results"); ok {
+		return v.(int)
+	}
+	return 0
+}
+
+// MaxResultsAttribute is the Plugin Framework variant of MaxResultsSchema.
+func MaxResultsAttribute() datasourceschema.Int64Attribute {
+	return datasourceschema.Int64Attribute{
+		Optional:    true,
+		Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		Description: "Maximum number of items to fetch from the API before filters are applied. All pages are fetched when unset.",
+	}
+}
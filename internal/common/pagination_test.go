@@ -0,0 +1,118 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+type fakeListAPI struct {
+	items       []int
+	reportTotal bool
+	failPage    int32
+
+	mu      sync.Mutex
+	calls   int
+	active  int
+	maxSeen int
+}
+
+func (f *fakeListAPI) fetch(pageNo, pageSize int32) ([]int, *int32, error) {
+	f.mu.Lock()
+	f.calls++
+	f.active++
+	if f.active > f.maxSeen {
+		f.maxSeen = f.active
+	}
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.active--
+		f.mu.Unlock()
+	}()
+
+	if pageNo == f.failPage {
+		return nil, nil, errors.New("page failed")
+	}
+
+	start := int(pageNo-1) * int(pageSize)
+	end := start + int(pageSize)
+	if start > len(f.items) {
+		start = len(f.items)
+	}
+	if end > len(f.items) {
+		end = len(f.items)
+	}
+
+	var total *int32
+	if f.reportTotal {
+		n := int32(len(f.items))
+		total = &n
+	}
+	return f.items[start:end], total, nil
+}
+
+func newFakeListAPI(n int, reportTotal bool) *fakeListAPI {
+	f := &fakeListAPI{reportTotal: reportTotal}
+	for i := 0; i < n; i++ {
+		f.items = append(f.items, i)
+	}
+	return f
+}
+
+func TestListAllPages(t *testing.T) {
+	cases := []struct {
+		name        string
+		count       int
+		reportTotal bool
+		maxResults  int
+		want        int
+		wantCalls   int
+	}{
+		{"empty", 0, true, 0, 0, 1},
+		{"single page", 42, true, 0, 42, 1},
+		{"exact page", 100, true, 0, 100, 1},
+		{"many pages", 1050, true, 0, 1050, 11},
+		{"many pages without total", 1050, false, 0, 1050, 11},
+		{"exact pages without total", 300, false, 0, 300, 4},
+		{"max results below page size", 1050, true, 30, 30, 1},
+		{"max results across pages", 1050, true, 250, 250, 3},
+		{"max results without total", 1050, false, 250, 250, 3},
+		{"max results above total", 150, true, 500, 150, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newFakeListAPI(tc.count, tc.reportTotal)
+
+			got, err := ListAllPages(tc.maxResults, api.fetch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != tc.want {
+				t.Fatalf("got %d items, want %d", len(got), tc.want)
+			}
+			for i, v := range got {
+				if v != i {
+					t.Fatalf("item %d is %d, pages out of order", i, v)
+				}
+			}
+			if api.calls != tc.wantCalls {
+				t.Errorf("got %d calls, want %d", api.calls, tc.wantCalls)
+			}
+			if api.maxSeen > MaxPageWorkers {
+				t.Errorf("%d pages fetched at once, want at most %d", api.maxSeen, MaxPageWorkers)
+			}
+		})
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	api := newFakeListAPI(1050, true)
+	api.failPage = 7
+
+	if _, err := ListAllPages(0, api.fetch); err == nil {
+		t.Fatal("expected error from failed page")
+	}
+}
@@ -4,15 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
-func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string) *s3.Client {
+func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string, pathStyle bool, httpClient *http.Client) *s3.Client {
 	var endpoint string
 	if endpointFromEnv != "" {
 		endpoint = endpointFromEnv
@@ -35,6 +37,15 @@ func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string
 
 	newClient := s3.NewFromConfig(cfg, func(o *s3.Options) {
 		o.BaseEndpoint = ncloud.String(endpoint)
+		o.UsePathStyle = pathStyle
+		if httpClient != nil {
+			o.HTTPClient = httpClient
+		}
+		// streamed bodies can't be hashed without TLS, so a local server over plain http gets them
+		// unsigned like the SDK does over https
+		if pathStyle && strings.HasPrefix(endpoint, "http://") {
+			o.APIOptions = append(o.APIOptions, v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware)
+		}
 	})
 
 	return newClient
@@ -2,6 +2,10 @@ package conn
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
@@ -50,6 +54,13 @@ type Config struct {
 	AccessKey string
 	SecretKey string
 	Region    string
+	// APIGateway overrides scheme and host of every API client (e.g. a local mock server)
+	APIGateway string
+	// HTTPClient sends the requests of every API client instead of http.DefaultClient (e.g. to record or replay them)
+	HTTPClient *http.Client
+	// ObsPathStyle addresses Object Storage buckets by path instead of by host, for S3 servers without
+	// bucket subdomains (e.g. a local mock server)
+	ObsPathStyle bool
 }
 
 type NcloudAPIClient struct {
@@ -87,38 +98,197 @@ func (c *Config) Client(site, endpoint string) (*NcloudAPIClient, error) {
 	}
 
 	return &NcloudAPIClient{
-		Server:          server.NewAPIClient(server.NewConfiguration(apiKey)),
-		Autoscaling:     autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		Loadbalancer:    loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		Cdn:             cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		Clouddb:         clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		Vpc:             vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		Vserver:         vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		Vnas:            vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		Vautoscaling:    vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		Vloadbalancer:   vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		Vnks:            vnks.NewAPIClient(vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey)),
-		Sourcecommit:    sourcecommit.NewAPIClient(sourcecommit.NewConfiguration(c.Region, apiKey)),
-		Sourcebuild:     sourcebuild.NewAPIClient((sourcebuild.NewConfiguration(c.Region, apiKey))),
-		Sourcepipeline:  sourcepipeline.NewAPIClient(sourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vsourcedeploy:   vsourcedeploy.NewAPIClient(vsourcedeploy.NewConfiguration(c.Region, apiKey)),
-		Vsourcepipeline: vsourcepipeline.NewAPIClient(vsourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vses:            vses2.NewAPIClient(vses2.NewConfiguration(c.Region, apiKey)),
-		Vcdss:           vcdss.NewAPIClient(vcdss.NewConfiguration(c.Region, apiKey)),
-		Vmysql:          vmysql.NewAPIClient(vmysql.NewConfiguration(apiKey)),
-		Vmongodb:        vmongodb.NewAPIClient(vmongodb.NewConfiguration(apiKey)),
-		Vmssql:          vmssql.NewAPIClient(vmssql.NewConfiguration(apiKey)),
-		Vpostgresql:     vpostgresql.NewAPIClient(vpostgresql.NewConfiguration(apiKey)),
-		Vhadoop:         vhadoop.NewAPIClient(vhadoop.NewConfiguration(apiKey)),
-		Vredis:          vredis.NewAPIClient(vredis.NewConfiguration(apiKey)),
-		ObjectStorage:   NewS3Client(c.Region, apiKey, site, endpoint),
+		Server:          server.NewAPIClient(c.configure(server.NewConfiguration(apiKey))),
+		Autoscaling:     autoscaling.NewAPIClient(c.configure(autoscaling.NewConfiguration(apiKey))),
+		Loadbalancer:    loadbalancer.NewAPIClient(c.configure(loadbalancer.NewConfiguration(apiKey))),
+		Cdn:             cdn.NewAPIClient(c.configure(cdn.NewConfiguration(apiKey))),
+		Clouddb:         clouddb.NewAPIClient(c.configure(clouddb.NewConfiguration(apiKey))),
+		Vpc:             vpc.NewAPIClient(c.configure(vpc.NewConfiguration(apiKey))),
+		Vserver:         vserver.NewAPIClient(c.configure(vserver.NewConfiguration(apiKey))),
+		Vnas:            vnas.NewAPIClient(c.configure(vnas.NewConfiguration(apiKey))),
+		Vautoscaling:    vautoscaling.NewAPIClient(c.configure(vautoscaling.NewConfiguration(apiKey))),
+		Vloadbalancer:   vloadbalancer.NewAPIClient(c.configure(vloadbalancer.NewConfiguration(apiKey))),
+		Vnks:            vnks.NewAPIClient(c.configure(vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey), serviceHost("nks"))),
+		Sourcecommit:    sourcecommit.NewAPIClient(c.configure(sourcecommit.NewConfiguration(c.Region, apiKey), serviceHost("sourcecommit"))),
+		Sourcebuild:     sourcebuild.NewAPIClient(c.configure(sourcebuild.NewConfiguration(c.Region, apiKey), serviceHost("sourcebuild"))),
+		Sourcepipeline:  sourcepipeline.NewAPIClient(c.configure(sourcepipeline.NewConfiguration(c.Region, apiKey), serviceHost("sourcepipeline"))),
+		Vsourcedeploy:   vsourcedeploy.NewAPIClient(c.configure(vsourcedeploy.NewConfiguration(c.Region, apiKey), serviceHost("vpcsourcedeploy"))),
+		Vsourcepipeline: vsourcepipeline.NewAPIClient(c.configure(vsourcepipeline.NewConfiguration(c.Region, apiKey), serviceHost("vpcsourcepipeline"))),
+		Vses:            vses2.NewAPIClient(c.configure(vses2.NewConfiguration(c.Region, apiKey), siteServiceHost("vpcsearchengine"))),
+		Vcdss:           vcdss.NewAPIClient(c.configure(vcdss.NewConfiguration(c.Region, apiKey), siteServiceHost("clouddatastreamingservice"))),
+		Vmysql:          vmysql.NewAPIClient(c.configure(vmysql.NewConfiguration(apiKey))),
+		Vmongodb:        vmongodb.NewAPIClient(c.configure(vmongodb.NewConfiguration(apiKey))),
+		Vmssql:          vmssql.NewAPIClient(c.configure(vmssql.NewConfiguration(apiKey))),
+		Vpostgresql:     vpostgresql.NewAPIClient(c.configure(vpostgresql.NewConfiguration(apiKey))),
+		Vhadoop:         vhadoop.NewAPIClient(c.configure(vhadoop.NewConfiguration(apiKey))),
+		Vredis:          vredis.NewAPIClient(c.configure(vredis.NewConfiguration(apiKey))),
+		ObjectStorage:   NewS3Client(c.Region, apiKey, site, endpoint, c.ObsPathStyle, c.HTTPClient),
 	}, nil
 }
 
+// configure points cfg at APIGateway, keeping the service path of its default base path,
+// and makes it send requests through HTTPClient. host rewrites APIGateway for services
+// served from their own host, as the SDK does with NCLOUD_API_GW.
+func (c *Config) configure(cfg *ncloud.Configuration, host ...func(string) string) *ncloud.Configuration {
+	if c.HTTPClient != nil {
+		cfg.HTTPClient = c.HTTPClient
+	}
+
+	if c.APIGateway == "" {
+		return cfg
+	}
+
+	gateway := strings.TrimSuffix(c.APIGateway, "/")
+	for _, h := range host {
+		gateway = h(gateway)
+	}
+
+	if u, err := url.Parse(cfg.BasePath); err == nil {
+		cfg.BasePath = gateway + u.Path
+	}
+
+	return cfg
+}
+
+// serviceHost replaces the "ncloud" (or "fin-ncloud") host label of a gateway
+// with name, e.g. https://fin-ncloud.apigw.fin-ntruss.com -> https://name.apigw.fin-ntruss.com
+func serviceHost(name string) func(string) string {
+	return func(gateway string) string {
+		gateway = strings.Replace(gateway, "://fin-ncloud.", "://"+name+".", 1)
+		return strings.Replace(gateway, "://ncloud.", "://"+name+".", 1)
+	}
+}
+
+// siteServiceHost is serviceHost keeping the "fin-" prefix of the financial site,
+// e.g. https://fin-ncloud.apigw.fin-ntruss.com -> https://fin-name.apigw.fin-ntruss.com
+func siteServiceHost(name string) func(string) string {
+	return func(gateway string) string {
+		gateway = strings.Replace(gateway, "://fin-ncloud.", "://fin-"+name+".", 1)
+		return strings.Replace(gateway, "://ncloud.", "://"+name+".", 1)
+	}
+}
+
+// SiteAPIGateway returns the API gateway of a site, or "" for the public site
+func SiteAPIGateway(site string) string {
+	switch site {
+	case "gov":
+		return "https://ncloud.apigw.gov-ntruss.com"
+	case "fin":
+		return "https://fin-ncloud.apigw.fin-ntruss.com"
+	}
+	return ""
+}
+
+// SiteServiceGateway returns the gateway of a service served from its own host
+// on the gov and fin sites, e.g. https://nks.apigw.gov-ntruss.com for "nks",
+// or "" for the public site
+func SiteServiceGateway(site, name string) string {
+	gateway := SiteAPIGateway(site)
+	if gateway == "" {
+		return ""
+	}
+	return serviceHost(name)(gateway)
+}
+
 type ProviderConfig struct {
 	Site       string
 	SupportVPC bool
 	RegionCode string
 	RegionNo   string
 	Client     *NcloudAPIClient
+
+	// regions and zoneNos belong to this provider instance, so aliased
+	// providers configured for other sites or regions never see them
+	regions map[string]Region
+	zoneNos sync.Map
+
+	// clientConfig and objectStorageEndpoint rebuild Client for the "region"
+	// argument of resources; regional holds the configs built so far
+	clientConfig          Config
+	objectStorageEndpoint string
+	parent                *ProviderConfig
+	regionalMu            sync.Mutex
+	regional              map[string]*ProviderConfig
+}
+
+// NewClient sets Client from config and keeps config to build the clients of
+// other regions with ForRegion.
+func (c *ProviderConfig) NewClient(config Config, objectStorageEndpoint string) error {
+	client, err := config.Client(c.Site, objectStorageEndpoint)
+	if err != nil {
+		return err
+	}
+
+	c.Client = client
+	c.clientConfig = config
+	c.objectStorageEndpoint = objectStorageEndpoint
+
+	return nil
+}
+
+// ForRegion returns the config of this provider for region, with its own client.
+// An empty region or the region of the provider returns the provider config.
+// Configs are built once per region and shared by every resource of the provider.
+func (c *ProviderConfig) ForRegion(region string) (*ProviderConfig, error) {
+	if c.parent != nil {
+		return c.parent.ForRegion(region)
+	}
+
+	if region == "" || region == c.RegionCode {
+		return c, nil
+	}
+
+	if !c.IsValidRegionCode(region) {
+		return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", region)
+	}
+
+	c.regionalMu.Lock()
+	defer c.regionalMu.Unlock()
+
+	if rc, ok := c.regional[region]; ok {
+		return rc, nil
+	}
+
+	if c.clientConfig.AccessKey == "" {
+		return nil, fmt.Errorf("region `%s` is not available: the provider has no client configuration", region)
+	}
+
+	config := c.clientConfig
+	config.Region = region
+	client, err := config.Client(c.Site, c.objectStorageEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &ProviderConfig{
+		Site:       c.Site,
+		SupportVPC: c.SupportVPC,
+		RegionCode: region,
+		Client:     client,
+		regions:    c.regions,
+		parent:     c,
+	}
+	if !c.SupportVPC {
+		rc.RegionNo = *c.GetRegionNoByCode(region)
+	}
+
+	if c.regional == nil {
+		c.regional = map[string]*ProviderConfig{}
+	}
+	c.regional[region] = rc
+
+	return rc, nil
+}
+
+// CachedZoneNo returns the zone number of code looked up earlier by this provider
+func (c *ProviderConfig) CachedZoneNo(code string) (string, bool) {
+	if v, ok := c.zoneNos.Load(code); ok {
+		return v.(string), true
+	}
+	return "", false
+}
+
+// CacheZoneNo remembers the zone number of code for this provider
+func (c *ProviderConfig) CacheZoneNo(code, zoneNo string) {
+	c.zoneNos.Store(code, zoneNo)
 }
@@ -0,0 +1,57 @@
+package conn
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/sourcecommit"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestConfigConfigure(t *testing.T) {
+	t.Setenv("NCLOUD_API_GW", "")
+	apiKey := &ncloud.APIKey{AccessKey: "a", SecretKey: "s"}
+
+	cases := []struct {
+		site string
+		cfg  func() *ncloud.Configuration
+		host []func(string) string
+		want string
+	}{
+		{"public", func() *ncloud.Configuration { return vserver.NewConfiguration(apiKey) }, nil, "https://ncloud.apigw.ntruss.com/vserver/v2"},
+		{"gov", func() *ncloud.Configuration { return vserver.NewConfiguration(apiKey) }, nil, "https://ncloud.apigw.gov-ntruss.com/vserver/v2"},
+		{"fin", func() *ncloud.Configuration { return vserver.NewConfiguration(apiKey) }, nil, "https://fin-ncloud.apigw.fin-ntruss.com/vserver/v2"},
+		{"gov", func() *ncloud.Configuration { return vcdss.NewConfiguration("KRS", apiKey) }, []func(string) string{siteServiceHost("clouddatastreamingservice")}, "https://clouddatastreamingservice.apigw.gov-ntruss.com/api/krs-v1"},
+		{"fin", func() *ncloud.Configuration { return vcdss.NewConfiguration("FKR", apiKey) }, []func(string) string{siteServiceHost("clouddatastreamingservice")}, "https://fin-clouddatastreamingservice.apigw.fin-ntruss.com/api/v1"},
+		{"fin", func() *ncloud.Configuration { return sourcecommit.NewConfiguration("FKR", apiKey) }, []func(string) string{serviceHost("sourcecommit")}, "https://sourcecommit.apigw.fin-ntruss.com/api/v1"},
+		{"fin", func() *ncloud.Configuration { return vnks.NewConfiguration("FKR", apiKey) }, []func(string) string{serviceHost("nks")}, "https://nks.apigw.fin-ntruss.com/nks/v2"},
+	}
+
+	for _, tc := range cases {
+		c := &Config{APIGateway: SiteAPIGateway(tc.site)}
+		if got := c.configure(tc.cfg(), tc.host...).BasePath; got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.site, got, tc.want)
+		}
+	}
+}
+
+func TestNewS3ClientPathStyle(t *testing.T) {
+	apiKey := &ncloud.APIKey{AccessKey: "a", SecretKey: "s"}
+
+	cases := []struct {
+		endpoint  string
+		pathStyle bool
+	}{
+		{"", false},
+		{"https://kr.object.private.ncloudstorage.com", false},
+		{"http://127.0.0.1:8080", true},
+	}
+
+	for _, tc := range cases {
+		if got := NewS3Client("KR", apiKey, "public", tc.endpoint, tc.pathStyle, nil).Options().UsePathStyle; got != tc.pathStyle {
+			t.Errorf("%q: got UsePathStyle %t, want %t", tc.endpoint, got, tc.pathStyle)
+		}
+	}
+}
@@ -2,8 +2,8 @@ package conn
 
 import (
 	"fmt"
-	"os"
-	"sync"
+	"regexp"
+	"strings"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
@@ -17,11 +17,25 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = sync.Map{}
+// importRegionPattern matches the region code suffix of an import ID
+var importRegionPattern = regexp.MustCompile(`^[A-Z]+$`)
 
-func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+// SplitImportRegion splits an import ID of the form "<id>@<region>", which
+// imports a resource from a region other than the provider's. IDs without a
+// region code suffix are returned as they are, with an empty region.
+func SplitImportRegion(importID string) (id, region string) {
+	i := strings.LastIndex(importID, "@")
+	if i < 1 || !importRegionPattern.MatchString(importID[i+1:]) {
+		return importID, ""
+	}
+	return importID[:i], importID[i+1:]
+}
+
+// ParseRegionNoParameter returns the classic region number of the "region"
+// argument, falling back to the region of the provider.
+func ParseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := GetRegionNoByCode(regionCode.(string))
+		regionNo := config.GetRegionNoByCode(regionCode.(string))
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -29,20 +43,18 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := GetRegionNoByCode(regionCode)
-		if regionNo == nil {
-			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
-		}
-		return regionNo, nil
+	if config.RegionNo != "" {
+		return &config.RegionNo, nil
 	}
 
 	return nil, nil
 }
 
-func GetRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode.Load(code); ok {
-		return region.(Region).RegionNo
+// GetRegionNoByCode returns the classic region number of code, or nil when the
+// region is unknown to this provider
+func (c *ProviderConfig) GetRegionNoByCode(code string) *string {
+	if region, ok := c.regions[code]; ok {
+		return region.RegionNo
 	}
 	return nil
 }
@@ -65,30 +77,34 @@ func GetRegionByCode(client *NcloudAPIClient, code string) (*server.Region, erro
 	return filteredRegion, nil
 }
 
-func SetRegionCache(client *NcloudAPIClient, supportVPC bool) error {
+// LoadRegions looks up the regions available to Client. It is called once while
+// configuring the provider; every provider instance keeps its own list.
+func (c *ProviderConfig) LoadRegions() error {
 	var regionList []*Region
 	var err error
-	if supportVPC {
-		regionList, err = getVpcRegionList(client)
+	if c.SupportVPC {
+		regionList, err = getVpcRegionList(c.Client)
 	} else {
-		regionList, err = getClassicRegionList(client)
+		regionList, err = getClassicRegionList(c.Client)
 	}
 
 	if err != nil {
 		return err
 	}
 
+	regions := make(map[string]Region, len(regionList))
 	for _, r := range regionList {
 		region := Region{
 			RegionCode: r.RegionCode,
 			RegionName: r.RegionName,
 		}
-		if !supportVPC {
+		if !c.SupportVPC {
 			region.RegionNo = r.RegionNo
 		}
 
-		regionCacheByCode.Store(*region.RegionCode, region)
+		regions[*region.RegionCode] = region
 	}
+	c.regions = regions
 
 	return nil
 }
@@ -129,7 +145,7 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func IsValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode.Load(code)
+func (c *ProviderConfig) IsValidRegionCode(code string) bool {
+	_, ok := c.regions[code]
 	return ok
 }
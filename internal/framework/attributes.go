@@ -2,6 +2,7 @@ package framework
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
@@ -14,3 +15,15 @@ func IDAttribute() schema.StringAttribute {
 		},
 	}
 }
+
+// DeletionProtectionAttribute is checked by the provider before a resource
+// is deleted. It has to be turned off and applied before the resource can be
+// destroyed.
+func DeletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "default: false",
+	}
+}
@@ -0,0 +1,155 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// ConnectionInfo is where clients reach a DB instance
+type ConnectionInfo struct {
+	Host string
+	Port int32
+}
+
+// ConnectionInfoFunc looks up the connection info of the DB instance no. It
+// returns nil when the instance does not exist.
+type ConnectionInfoFunc func(ctx context.Context, config *conn.ProviderConfig, no string) (*ConnectionInfo, error)
+
+var (
+	_ ephemeral.EphemeralResource              = &connectionInfoEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectionInfoEphemeralResource{}
+)
+
+// NewConnectionInfoEphemeralResource returns the ephemeral resource
+// <provider>_<name>_connection_info. It combines the private domain and port of
+// an instance with the credentials given in its configuration into a URI with
+// the given scheme, so that they can configure other providers without being
+// stored in state.
+func NewConnectionInfoEphemeralResource(name, scheme string, lookup ConnectionInfoFunc) func() ephemeral.EphemeralResource {
+	return func() ephemeral.EphemeralResource {
+		return &connectionInfoEphemeralResource{
+			name:   name,
+			scheme: scheme,
+			lookup: lookup,
+		}
+	}
+}
+
+type connectionInfoEphemeralResource struct {
+	name   string
+	scheme string
+	lookup ConnectionInfoFunc
+	config *conn.ProviderConfig
+}
+
+func (e *connectionInfoEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + e.name + "_connection_info"
+}
+
+func (e *connectionInfoEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance number.",
+			},
+			"user_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "User name to put in `uri`.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to put in `uri`.",
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"port": schema.Int64Attribute{
+				Computed: true,
+			},
+			"uri": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *connectionInfoEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *connectionInfoEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectionInfoEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := e.lookup(ctx, e.config, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if info == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("%s instance %s not found", e.name, data.ID.ValueString()))
+		return
+	}
+
+	data.Host = types.StringValue(info.Host)
+	data.Port = types.Int64Value(int64(info.Port))
+	data.URI = types.StringValue(connectionURI(e.scheme, info, data.UserName, data.Password))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// connectionURI leaves out whichever of the credentials is not given
+func connectionURI(scheme string, info *ConnectionInfo, userName, password types.String) string {
+	u := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(info.Host, strconv.Itoa(int(info.Port))),
+	}
+
+	switch {
+	case !password.IsNull():
+		u.User = url.UserPassword(userName.ValueString(), password.ValueString())
+	case !userName.IsNull():
+		u.User = url.User(userName.ValueString())
+	}
+
+	return u.String()
+}
+
+type connectionInfoEphemeralResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	UserName types.String `tfsdk:"user_name"`
+	Password types.String `tfsdk:"password"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	URI      types.String `tfsdk:"uri"`
+}
@@ -0,0 +1,22 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NullAttributes sets every attribute of a list result resource to null, so
+// that a resource model can be read from it, refreshed from the API output and
+// set back like in a Read.
+func NullAttributes(ctx context.Context, resource *tfsdk.Resource) {
+	typ := resource.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, t := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(t, nil)
+	}
+
+	resource.Raw = tftypes.NewValue(typ, attributes)
+}
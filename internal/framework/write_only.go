@@ -0,0 +1,53 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlyAttribute is the write-only form of a secret argument. Terraform
+// sends its value only in the configuration, so it is never stored in the
+// plan or the state. It needs Terraform 1.11 or later.
+func WriteOnlyAttribute(description string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		WriteOnly:   true,
+		Sensitive:   true,
+		Validators:  validators,
+		Description: description,
+	}
+}
+
+// WriteOnlyVersionAttribute tracks a write-only argument in state. Terraform
+// cannot tell when a write-only value changes, so the secret is only sent
+// again when this version changes.
+func WriteOnlyVersionAttribute(writeOnly string, planModifiers ...planmodifier.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnly)),
+		},
+		PlanModifiers: planModifiers,
+		Description:   "Change this value to send `" + writeOnly + "` again.",
+	}
+}
+
+// SecretFromConfig returns the write-only argument at p when it is set in the
+// configuration, or value otherwise.
+func SecretFromConfig(ctx context.Context, config tfsdk.Config, p path.Path, value types.String) (types.String, diag.Diagnostics) {
+	var writeOnly types.String
+	diags := config.GetAttribute(ctx, p, &writeOnly)
+	if diags.HasError() || writeOnly.IsNull() || writeOnly.IsUnknown() {
+		return value, diags
+	}
+
+	return writeOnly, diags
+}
@@ -0,0 +1,57 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+// provider functions are only found through required_providers
+const testAccProviderFunctionsConfig = `
+terraform {
+  required_providers {
+    ncloud = {
+      source = "hashicorp/ncloud"
+    }
+  }
+}
+`
+
+func TestAccProviderFunctions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderFunctionsConfig + `
+output "parts" {
+  value = provider::ncloud::parse_id("12345:67890")
+}
+
+output "cpu" {
+  value = provider::ncloud::product_code_spec("SVR.VSVR.HICPU.C002.M004.NET.SSD.B050.G002").cpu_count
+}
+
+output "subnet" {
+  value = provider::ncloud::subnet_for_zone("10.0.0.0/16", "KR-2", 1, 9, 1)
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("cpu", "2"),
+					resource.TestCheckOutput("subnet", "10.0.128.64/26"),
+				),
+			},
+			{
+				Config: testAccProviderFunctionsConfig + `
+output "subnet" {
+  value = provider::ncloud::subnet_for_zone("8.8.0.0/16", "KR-1", 1, 9, 1)
+}
+`,
+				ExpectError: regexp.MustCompile(`not within 10\.0\.0\.0/8`),
+			},
+		},
+	})
+}
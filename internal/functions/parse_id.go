@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultIDSeparator joins the parts of most composite resource IDs, e.g.
+// "<route_table_no>:<subnet_no>" or "<cluster_uuid>:<node_pool_name>"
+const DefaultIDSeparator = ":"
+
+var _ function.Function = &parseIDFunction{}
+
+func NewParseIDFunction() function.Function {
+	return &parseIDFunction{}
+}
+
+type parseIDFunction struct{}
+
+func (f *parseIDFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+func (f *parseIDFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a composite resource ID into its parts",
+		Description: "Splits the ID of resources such as rules, attachments and node pools into its parts. The parts are separated by \":\" unless a separator is given, e.g. \"|\" for ncloud_cdss_acl.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Composite resource ID",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "separator",
+			Description: "Separator of the parts. Default: \":\"",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var separators []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id, &separators))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(separators) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "at most one separator can be given")
+		return
+	}
+
+	separator := DefaultIDSeparator
+	if len(separators) == 1 {
+		separator = separators[0]
+	}
+
+	parts, err := ParseID(id, separator)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parts))
+}
+
+// ParseID splits a composite ID into at least two non-empty parts
+func ParseID(id, separator string) ([]string, error) {
+	if separator == "" {
+		return nil, fmt.Errorf("separator must not be empty")
+	}
+
+	parts := strings.Split(id, separator)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("%q is not a composite ID separated by %q", id, separator)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("%q has an empty part", id)
+		}
+	}
+
+	return parts, nil
+}
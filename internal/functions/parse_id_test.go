@@ -0,0 +1,89 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/functions"
+)
+
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+
+	var result attr.Value
+	switch r := def.Definition.Return.(type) {
+	case function.ListReturn:
+		result = types.ListUnknown(r.ElementType)
+	case function.ObjectReturn:
+		result = types.ObjectUnknown(r.AttributeTypes)
+	case function.StringReturn:
+		result = types.StringUnknown()
+	default:
+		t.Fatalf("unexpected return type %T", r)
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func separators(values ...string) attr.Value {
+	elemTypes := make([]attr.Type, len(values))
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elemTypes[i] = types.StringType
+		elems[i] = types.StringValue(v)
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+func TestParseIDFunction(t *testing.T) {
+	cases := []struct {
+		name      string
+		id        string
+		separator []string
+		want      []string
+		wantErr   bool
+	}{
+		{"default separator", "1234:5678", nil, []string{"1234", "5678"}, false},
+		{"three parts", "cluster-uuid:pool:node", nil, []string{"cluster-uuid", "pool", "node"}, false},
+		{"custom separator", "TOPIC|orders|User:alice", []string{"|"}, []string{"TOPIC", "orders", "User:alice"}, false},
+		{"not composite", "1234", nil, nil, true},
+		{"empty part", "1234:", nil, nil, true},
+		{"empty separator", "1234:5678", []string{""}, nil, true},
+		{"two separators", "1234:5678", []string{":", "|"}, nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := runFunction(t, functions.NewParseIDFunction(), types.StringValue(tc.id), separators(tc.separator...))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			elems := make([]attr.Value, len(tc.want))
+			for i, v := range tc.want {
+				elems[i] = types.StringValue(v)
+			}
+			if want := types.ListValueMust(types.StringType, elems); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &productCodeSpecFunction{}
+
+var productCodeSpecAttributeTypes = map[string]attr.Type{
+	"product_type":               types.StringType,
+	"cpu_count":                  types.Int64Type,
+	"memory_size_gb":             types.Int64Type,
+	"disk_type":                  types.StringType,
+	"base_block_storage_size_gb": types.Int64Type,
+	"generation":                 types.StringType,
+}
+
+// specCodeMemoryPerCPU is the memory (GB) per vCPU of each server spec type
+var specCodeMemoryPerCPU = map[string]struct {
+	productType  string
+	memoryPerCPU int64
+}{
+	"c": {"HICPU", 2},
+	"s": {"STAND", 4},
+	"m": {"HIMEM", 8},
+}
+
+var (
+	productCodeNumberRegexp = regexp.MustCompile(`^([CMBG])(\d+)$`)
+	specCodeRegexp          = regexp.MustCompile(`^([a-z]+)(\d+)-g(\d+)(?:-([sh])(\d+))?$`)
+)
+
+func NewProductCodeSpecFunction() function.Function {
+	return &productCodeSpecFunction{}
+}
+
+type productCodeSpecFunction struct{}
+
+// ProductCodeSpec is the specification decoded from a product or spec code.
+// Fields the code does not carry stay null.
+type ProductCodeSpec struct {
+	ProductType            types.String `tfsdk:"product_type"`
+	CpuCount               types.Int64  `tfsdk:"cpu_count"`
+	MemorySizeGb           types.Int64  `tfsdk:"memory_size_gb"`
+	DiskType               types.String `tfsdk:"disk_type"`
+	BaseBlockStorageSizeGb types.Int64  `tfsdk:"base_block_storage_size_gb"`
+	Generation             types.String `tfsdk:"generation"`
+}
+
+func (f *productCodeSpecFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "product_code_spec"
+}
+
+func (f *productCodeSpecFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode the specification of a server product or spec code",
+		Description: "Decodes the product type, CPU count, memory, disk and generation of a server_product_code (e.g. \"SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002\") or server_spec_code (e.g. \"s2-g3\").",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "code",
+				Description: "server_product_code or server_spec_code",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: productCodeSpecAttributeTypes,
+		},
+	}
+}
+
+func (f *productCodeSpecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	spec, err := ParseProductCode(code)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, spec))
+}
+
+// ParseProductCode decodes a server product code such as
+// "SVR.VSVR.HICPU.C002.M004.NET.SSD.B050.G002" or a server spec code such as
+// "c2-g3" or "s2-g2-s50".
+func ParseProductCode(code string) (*ProductCodeSpec, error) {
+	if strings.HasPrefix(code, "SVR.") {
+		return parseServerProductCode(code)
+	}
+	return parseServerSpecCode(code)
+}
+
+func parseServerProductCode(code string) (*ProductCodeSpec, error) {
+	spec := newProductCodeSpec()
+
+	parts := strings.Split(code, ".")
+	for i, part := range parts {
+		if m := productCodeNumberRegexp.FindStringSubmatch(part); m != nil {
+			n, _ := strconv.ParseInt(m[2], 10, 64)
+			switch m[1] {
+			case "C":
+				spec.CpuCount = types.Int64Value(n)
+				// the product type comes right before the CPU count, e.g. "STAND.C002"
+				if i > 1 {
+					spec.ProductType = types.StringValue(parts[i-1])
+				}
+			case "M":
+				spec.MemorySizeGb = types.Int64Value(n)
+			case "B":
+				spec.BaseBlockStorageSizeGb = types.Int64Value(n)
+			case "G":
+				spec.Generation = types.StringValue(fmt.Sprintf("G%d", n))
+			}
+			continue
+		}
+
+		if part == "SSD" || part == "HDD" {
+			spec.DiskType = types.StringValue(part)
+		}
+	}
+
+	if spec.CpuCount.IsNull() || spec.MemorySizeGb.IsNull() {
+		return nil, fmt.Errorf("%q is not a server product code: no CPU count or memory size", code)
+	}
+
+	return spec, nil
+}
+
+func parseServerSpecCode(code string) (*ProductCodeSpec, error) {
+	m := specCodeRegexp.FindStringSubmatch(code)
+	if m == nil {
+		return nil, fmt.Errorf("%q is neither a server product code (SVR.*) nor a server spec code (e.g. s2-g3)", code)
+	}
+
+	spec := newProductCodeSpec()
+
+	cpu, _ := strconv.ParseInt(m[2], 10, 64)
+	spec.CpuCount = types.Int64Value(cpu)
+
+	if t, ok := specCodeMemoryPerCPU[m[1]]; ok {
+		spec.ProductType = types.StringValue(t.productType)
+		spec.MemorySizeGb = types.Int64Value(cpu * t.memoryPerCPU)
+	}
+
+	generation, _ := strconv.ParseInt(m[3], 10, 64)
+	spec.Generation = types.StringValue(fmt.Sprintf("G%d", generation))
+
+	if m[4] != "" {
+		spec.DiskType = types.StringValue(map[string]string{"s": "SSD", "h": "HDD"}[m[4]])
+		size, _ := strconv.ParseInt(m[5], 10, 64)
+		spec.BaseBlockStorageSizeGb = types.Int64Value(size)
+	}
+
+	return spec, nil
+}
+
+func newProductCodeSpec() *ProductCodeSpec {
+	return &ProductCodeSpec{
+		ProductType:            types.StringNull(),
+		CpuCount:               types.Int64Null(),
+		MemorySizeGb:           types.Int64Null(),
+		DiskType:               types.StringNull(),
+		BaseBlockStorageSizeGb: types.Int64Null(),
+		Generation:             types.StringNull(),
+	}
+}
@@ -0,0 +1,83 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/functions"
+)
+
+func TestParseProductCode(t *testing.T) {
+	null := types.Int64Null()
+	cases := []struct {
+		code        string
+		productType string
+		cpu, memory types.Int64
+		diskType    string
+		storage     types.Int64
+		generation  string
+	}{
+		{"SVR.VSVR.HICPU.C002.M004.NET.SSD.B050.G002", "HICPU", types.Int64Value(2), types.Int64Value(4), "SSD", types.Int64Value(50), "G2"},
+		{"SVR.VSVR.STAND.C004.M016.NET.HDD.B050.G002", "STAND", types.Int64Value(4), types.Int64Value(16), "HDD", types.Int64Value(50), "G2"},
+		{"SVR.VCHDP.MSTDT.STAND.C004.M016.NET.HDD.B050.G002", "STAND", types.Int64Value(4), types.Int64Value(16), "HDD", types.Int64Value(50), "G2"},
+		{"SVR.VNKS.STAND.C008.M032.G003", "STAND", types.Int64Value(8), types.Int64Value(32), "", null, "G3"},
+		{"s2-g3", "STAND", types.Int64Value(2), types.Int64Value(8), "", null, "G3"},
+		{"c4-g3", "HICPU", types.Int64Value(4), types.Int64Value(8), "", null, "G3"},
+		{"m2-g3", "HIMEM", types.Int64Value(2), types.Int64Value(16), "", null, "G3"},
+		{"s2-g2-s50", "STAND", types.Int64Value(2), types.Int64Value(8), "SSD", types.Int64Value(50), "G2"},
+		{"c2-g2-h50", "HICPU", types.Int64Value(2), types.Int64Value(4), "HDD", types.Int64Value(50), "G2"},
+		{"x2-g3", "", types.Int64Value(2), null, "", null, "G3"},
+	}
+
+	optional := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+
+	for _, tc := range cases {
+		spec, err := functions.ParseProductCode(tc.code)
+		if err != nil {
+			t.Errorf("%s: %s", tc.code, err)
+			continue
+		}
+
+		want := functions.ProductCodeSpec{
+			ProductType:            optional(tc.productType),
+			CpuCount:               tc.cpu,
+			MemorySizeGb:           tc.memory,
+			DiskType:               optional(tc.diskType),
+			BaseBlockStorageSizeGb: tc.storage,
+			Generation:             optional(tc.generation),
+		}
+		if *spec != want {
+			t.Errorf("%s: got %+v, want %+v", tc.code, *spec, want)
+		}
+	}
+}
+
+func TestParseProductCodeInvalid(t *testing.T) {
+	for _, code := range []string{"", "SVR.VSVR.STAND.NET.SSD", "s2", "S2-G3", "standard"} {
+		if _, err := functions.ParseProductCode(code); err == nil {
+			t.Errorf("%q: expected error", code)
+		}
+	}
+}
+
+func TestProductCodeSpecFunction(t *testing.T) {
+	got, err := runFunction(t, functions.NewProductCodeSpecFunction(), types.StringValue("SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs := got.(types.Object).Attributes()
+	if attrs["cpu_count"].(types.Int64).ValueInt64() != 2 || attrs["memory_size_gb"].(types.Int64).ValueInt64() != 8 {
+		t.Errorf("unexpected result %s", got)
+	}
+
+	if _, err := runFunction(t, functions.NewProductCodeSpecFunction(), types.StringValue("nope")); err == nil {
+		t.Error("expected error for invalid code")
+	}
+}
@@ -0,0 +1,189 @@
+package functions
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+const (
+	minVpcPrefixLength    = 16
+	maxSubnetPrefixLength = 28
+)
+
+// regionZones lists the zones of each region in order. A zone takes the zone
+// range of its index in the list, so KR-1 and SGN-4 both take the first one.
+// New zones must be appended, so that existing zones keep their range.
+var regionZones = map[string][]string{
+	"KR":   {"KR-1", "KR-2"},
+	"KRS":  {"KRS-1", "KRS-2"},
+	"FKR":  {"FKR-1", "FKR-2"},
+	"SGN":  {"SGN-4", "SGN-5"},
+	"JPN":  {"JPN-4", "JPN-5"},
+	"USWN": {"USWN-5"},
+}
+
+// privateBlocks are the ranges VPC and subnet CIDR blocks must be within
+var privateBlocks = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+var _ function.Function = &subnetForZoneFunction{}
+
+func NewSubnetForZoneFunction() function.Function {
+	return &subnetForZoneFunction{}
+}
+
+type subnetForZoneFunction struct{}
+
+func (f *subnetForZoneFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_for_zone"
+}
+
+func (f *subnetForZoneFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Carve a subnet CIDR block for a zone out of a VPC CIDR block",
+		Description: fmt.Sprintf("Splits vpc_cidr into 2^zone_bits equal zone ranges and returns subnet netnum of the range of zone, "+
+			"newbits longer than the zone range, like cidrsubnet. Each zone takes the range of its index within its region, "+
+			"e.g. the first range for \"KR-1\" and \"SGN-4\" and the second for \"KR-2\" and \"SGN-5\". "+
+			"Blocks must be within 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16 and the subnet between /%d and /%d.",
+			minVpcPrefixLength, maxSubnetPrefixLength),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "vpc_cidr",
+				Description: "CIDR block of the VPC",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "Zone code, e.g. \"KR-1\"",
+			},
+			function.Int64Parameter{
+				Name:        "zone_bits",
+				Description: "Number of bits added to the prefix length of vpc_cidr to split it into zone ranges, e.g. 1 for the two zones of KR",
+			},
+			function.Int64Parameter{
+				Name:        "newbits",
+				Description: "Number of bits added to the prefix length of the zone range",
+			},
+			function.Int64Parameter{
+				Name:        "netnum",
+				Description: "Index of the subnet within the zone range",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *subnetForZoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCidr, zone string
+	var zoneBits, newbits, netnum int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &vpcCidr, &zone, &zoneBits, &newbits, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	index, err := zoneIndex(zone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	subnet, err := SubnetForZone(vpcCidr, index, int(zoneBits), int(newbits), netnum)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, subnet))
+}
+
+// SubnetForZone splits vpcCidr into 2^zoneBits zone ranges and returns subnet
+// netnum, newbits longer than zone range zoneIndex (0-based).
+func SubnetForZone(vpcCidr string, zoneIndex int, zoneBits int, newbits int, netnum int64) (string, error) {
+	if err := verify.ValidateCIDRBlock(vpcCidr); err != nil {
+		return "", err
+	}
+
+	_, vpc, _ := net.ParseCIDR(vpcCidr)
+	if vpc.IP.To4() == nil {
+		return "", fmt.Errorf("%q is not an IPv4 CIDR block", vpcCidr)
+	}
+	if !isPrivateBlock(vpc) {
+		return "", fmt.Errorf("%q is not within %s", vpcCidr, strings.Join(privateBlocks, ", "))
+	}
+
+	vpcPrefix, _ := vpc.Mask.Size()
+	if vpcPrefix < minVpcPrefixLength {
+		return "", fmt.Errorf("%q is larger than /%d", vpcCidr, minVpcPrefixLength)
+	}
+
+	if zoneBits < 0 || newbits < 0 {
+		return "", fmt.Errorf("zone_bits %d and newbits %d must not be negative", zoneBits, newbits)
+	}
+	if zoneIndex < 0 || zoneIndex >= 1<<zoneBits {
+		return "", fmt.Errorf("zone index %d does not fit in %d zone bits", zoneIndex, zoneBits)
+	}
+
+	prefix := vpcPrefix + zoneBits + newbits
+	if prefix > maxSubnetPrefixLength {
+		return "", fmt.Errorf("subnet /%d of %q is smaller than /%d", prefix, vpcCidr, maxSubnetPrefixLength)
+	}
+	if netnum < 0 || netnum >= 1<<newbits {
+		return "", fmt.Errorf("netnum %d does not fit in %d bits", netnum, newbits)
+	}
+
+	base := binary.BigEndian.Uint32(vpc.IP.To4())
+	index := uint32(zoneIndex)<<newbits | uint32(netnum)
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, base|index<<(32-prefix))
+
+	subnet := fmt.Sprintf("%s/%d", ip, prefix)
+	if err := verify.ValidateCIDRBlock(subnet); err != nil {
+		return "", err
+	}
+
+	return subnet, nil
+}
+
+// zoneIndex returns the index of a zone code within its region, e.g. 1 for "SGN-5"
+func zoneIndex(zone string) (int, error) {
+	region, _, ok := strings.Cut(zone, "-")
+	if !ok {
+		return 0, fmt.Errorf("%q is not a zone code such as KR-1", zone)
+	}
+
+	for i, z := range regionZones[region] {
+		if z == zone {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("zone %q is not supported, expected one of %s", zone, strings.Join(supportedZones(), ", "))
+}
+
+func supportedZones() []string {
+	var zones []string
+	for _, z := range regionZones {
+		zones = append(zones, z...)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+func isPrivateBlock(block *net.IPNet) bool {
+	prefix, _ := block.Mask.Size()
+	for _, cidr := range privateBlocks {
+		_, private, _ := net.ParseCIDR(cidr)
+		privatePrefix, _ := private.Mask.Size()
+		if private.Contains(block.IP) && prefix >= privatePrefix {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/functions"
+)
+
+func TestSubnetForZone(t *testing.T) {
+	cases := []struct {
+		vpc      string
+		zone     int
+		zoneBits int
+		newbits  int
+		netnum   int64
+		want     string
+		wantErr  bool
+	}{
+		{"10.0.0.0/16", 0, 1, 7, 0, "10.0.0.0/24", false},
+		{"10.0.0.0/16", 0, 1, 7, 1, "10.0.1.0/24", false},
+		{"10.0.0.0/16", 1, 1, 7, 0, "10.0.128.0/24", false},
+		{"10.0.0.0/16", 1, 1, 7, 127, "10.0.255.0/24", false},
+		{"10.0.0.0/16", 1, 2, 6, 0, "10.0.64.0/24", false},
+		{"10.0.0.0/16", 3, 2, 0, 0, "10.0.192.0/18", false},
+		{"10.0.0.0/16", 0, 0, 8, 5, "10.0.5.0/24", false},
+		{"192.168.0.0/24", 1, 1, 3, 3, "192.168.0.176/28", false},
+		{"172.16.0.0/16", 0, 1, 3, 1, "172.16.16.0/20", false},
+		{"10.0.0.1/16", 0, 1, 7, 0, "", true},    // not a network address
+		{"8.8.0.0/16", 0, 1, 7, 0, "", true},     // public range
+		{"10.0.0.0/8", 0, 1, 7, 0, "", true},     // larger than /16
+		{"10.0.0.0/16", 2, 1, 7, 0, "", true},    // no such zone range
+		{"10.0.0.0/16", 1, 0, 8, 0, "", true},    // a single zone range
+		{"10.0.0.0/16", -1, 1, 7, 0, "", true},   // zone ranges start at 0
+		{"10.0.0.0/16", 0, -1, 7, 0, "", true},   // negative zone bits
+		{"10.0.0.0/16", 0, 1, 12, 0, "", true},   // smaller than /28
+		{"10.0.0.0/16", 0, 1, 7, 128, "", true},  // netnum out of range
+		{"fd00::/16", 0, 1, 7, 0, "", true},      // IPv6
+		{"192.168.0.0/26", 0, 1, 2, 0, "", true}, // /29
+	}
+
+	for _, tc := range cases {
+		got, err := functions.SubnetForZone(tc.vpc, tc.zone, tc.zoneBits, tc.newbits, tc.netnum)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("SubnetForZone(%s, %d, %d, %d, %d): expected error, got %s", tc.vpc, tc.zone, tc.zoneBits, tc.newbits, tc.netnum, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SubnetForZone(%s, %d, %d, %d, %d): %s", tc.vpc, tc.zone, tc.zoneBits, tc.newbits, tc.netnum, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("SubnetForZone(%s, %d, %d, %d, %d) = %s, want %s", tc.vpc, tc.zone, tc.zoneBits, tc.newbits, tc.netnum, got, tc.want)
+		}
+	}
+}
+
+func TestSubnetForZoneFunction(t *testing.T) {
+	cases := []struct {
+		zone string
+		want string
+	}{
+		{"KR-1", "10.0.1.0/24"},
+		{"KR-2", "10.0.129.0/24"},
+		{"SGN-4", "10.0.1.0/24"},
+		{"SGN-5", "10.0.129.0/24"},
+		{"JPN-4", "10.0.1.0/24"},
+		{"JPN-5", "10.0.129.0/24"},
+		{"USWN-5", "10.0.1.0/24"},
+		{"FKR-2", "10.0.129.0/24"},
+	}
+
+	for _, tc := range cases {
+		got, err := runFunction(t, functions.NewSubnetForZoneFunction(),
+			types.StringValue("10.0.0.0/16"), types.StringValue(tc.zone), types.Int64Value(1), types.Int64Value(7), types.Int64Value(1))
+		if err != nil {
+			t.Fatalf("%s: %s", tc.zone, err)
+		}
+		if want := types.StringValue(tc.want); !got.Equal(want) {
+			t.Errorf("%s: got %s, want %s", tc.zone, got, want)
+		}
+	}
+
+	for _, zone := range []string{"KR", "KR-3", "SGN-1", "XX-1"} {
+		if _, err := runFunction(t, functions.NewSubnetForZoneFunction(),
+			types.StringValue("10.0.0.0/16"), types.StringValue(zone), types.Int64Value(1), types.Int64Value(7), types.Int64Value(1)); err == nil {
+			t.Errorf("%s: expected error for unsupported zone", zone)
+		}
+	}
+}
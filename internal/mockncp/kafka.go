@@ -0,0 +1,550 @@
+package mockncp
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+const (
+	kafkaBrokerID = 1
+
+	kafkaErrUnknownTopicOrPartition  int16 = 3
+	kafkaErrTopicAlreadyExists       int16 = 36
+	kafkaErrInvalidPartitions        int16 = 37
+	kafkaErrInvalidReplicationFactor int16 = 38
+)
+
+// kafkaAPIKeys are the requests KafkaBroker answers.
+var kafkaAPIKeys = []int16{
+	3,  // Metadata
+	18, // ApiVersions
+	19, // CreateTopics
+	20, // DeleteTopics
+	29, // DescribeACLs
+	30, // CreateACLs
+	31, // DeleteACLs
+	32, // DescribeConfigs
+	33, // AlterConfigs
+	37, // CreatePartitions
+}
+
+// kafkaTopicDefaults are reported by DescribeConfigs for configs not set on a topic.
+var kafkaTopicDefaults = map[string]string{
+	"cleanup.policy": "delete",
+	"retention.ms":   "604800000",
+}
+
+// KafkaBroker is a single node stand-in for the Kafka protocol, as reached
+// through the endpoints of a CDSS cluster. It answers the admin requests used
+// to manage topics, topic configs and ACLs, and keeps them in memory.
+type KafkaBroker struct {
+	listener net.Listener
+	host     string
+	port     int32
+
+	mu     sync.Mutex
+	topics map[string]*mockKafkaTopic
+	acls   []kmsg.CreateACLsRequestCreation
+}
+
+type mockKafkaTopic struct {
+	partitions        int32
+	replicationFactor int16
+	configs           map[string]string
+}
+
+// NewKafkaBroker starts a broker on a local port. Callers should Close it when done.
+func NewKafkaBroker() *KafkaBroker {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("mockncp: failed to listen: " + err.Error())
+	}
+
+	addr := l.Addr().(*net.TCPAddr)
+	b := &KafkaBroker{
+		listener: l,
+		host:     addr.IP.String(),
+		port:     int32(addr.Port),
+		topics:   map[string]*mockKafkaTopic{},
+	}
+	go b.accept()
+	return b
+}
+
+// Addr returns the host:port to use as a bootstrap server.
+func (b *KafkaBroker) Addr() string {
+	return net.JoinHostPort(b.host, strconv.Itoa(int(b.port)))
+}
+
+func (b *KafkaBroker) Close() {
+	b.listener.Close()
+}
+
+// RemoveTopic deletes a topic behind the provider's back.
+func (b *KafkaBroker) RemoveTopic(name string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.topics[name]; !ok {
+		return false
+	}
+	delete(b.topics, name)
+	return true
+}
+
+// HasTopic reports whether the topic exists.
+func (b *KafkaBroker) HasTopic(name string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.topics[name]
+	return ok
+}
+
+// ACLCount returns the number of ACL entries.
+func (b *KafkaBroker) ACLCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.acls)
+}
+
+func (b *KafkaBroker) accept() {
+	for {
+		c, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.serve(c)
+	}
+}
+
+func (b *KafkaBroker) serve(c net.Conn) {
+	defer c.Close()
+
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(c, size[:]); err != nil {
+			return
+		}
+		msg := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(c, msg); err != nil {
+			return
+		}
+
+		req, correlationID, err := readKafkaRequest(msg)
+		if err != nil {
+			log.Printf("[WARN] mockncp: kafka: %s", err)
+			return
+		}
+
+		b.mu.Lock()
+		resp := b.handle(req)
+		b.mu.Unlock()
+
+		out := binary.BigEndian.AppendUint32(make([]byte, 4), uint32(correlationID))
+		if resp.IsFlexible() && req.Key() != 18 {
+			out = append(out, 0) // no tagged fields
+		}
+		out = resp.AppendTo(out)
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+		if _, err := c.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+// readKafkaRequest parses the request header and body of msg.
+func readKafkaRequest(msg []byte) (kmsg.Request, int32, error) {
+	if len(msg) < 10 {
+		return nil, 0, errors.New("short request header")
+	}
+	key := int16(binary.BigEndian.Uint16(msg))
+	version := int16(binary.BigEndian.Uint16(msg[2:]))
+	correlationID := int32(binary.BigEndian.Uint32(msg[4:]))
+	clientIDLen := int16(binary.BigEndian.Uint16(msg[8:]))
+	body := msg[10:]
+	if clientIDLen > 0 {
+		if len(body) < int(clientIDLen) {
+			return nil, 0, errors.New("short client id")
+		}
+		body = body[clientIDLen:]
+	}
+
+	req := kmsg.RequestForKey(key)
+	if req == nil || !containsInt16(kafkaAPIKeys, key) {
+		return nil, 0, errors.New("unsupported request " + kmsg.NameForKey(key))
+	}
+	req.SetVersion(version)
+
+	if req.IsFlexible() {
+		numTags, n := binary.Uvarint(body)
+		if n <= 0 || numTags != 0 {
+			return nil, 0, errors.New("unsupported request header tags")
+		}
+		body = body[n:]
+	}
+
+	if err := req.ReadFrom(body); err != nil {
+		return nil, 0, err
+	}
+	return req, correlationID, nil
+}
+
+func (b *KafkaBroker) handle(req kmsg.Request) kmsg.Response {
+	switch req := req.(type) {
+	case *kmsg.ApiVersionsRequest:
+		return b.apiVersions(req)
+	case *kmsg.MetadataRequest:
+		return b.metadata(req)
+	case *kmsg.CreateTopicsRequest:
+		return b.createTopics(req)
+	case *kmsg.DeleteTopicsRequest:
+		return b.deleteTopics(req)
+	case *kmsg.CreatePartitionsRequest:
+		return b.createPartitions(req)
+	case *kmsg.DescribeConfigsRequest:
+		return b.describeConfigs(req)
+	case *kmsg.AlterConfigsRequest:
+		return b.alterConfigs(req)
+	case *kmsg.CreateACLsRequest:
+		return b.createACLs(req)
+	case *kmsg.DescribeACLsRequest:
+		return b.describeACLs(req)
+	case *kmsg.DeleteACLsRequest:
+		return b.deleteACLs(req)
+	}
+	panic("mockncp: unhandled kafka request " + kmsg.NameForKey(req.Key()))
+}
+
+func (b *KafkaBroker) apiVersions(req *kmsg.ApiVersionsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.ApiVersionsResponse)
+	for _, key := range kafkaAPIKeys {
+		k := kmsg.NewApiVersionsResponseApiKey()
+		k.ApiKey = key
+		k.MaxVersion = kmsg.RequestForKey(key).MaxVersion()
+		resp.ApiKeys = append(resp.ApiKeys, k)
+	}
+	return resp
+}
+
+func (b *KafkaBroker) metadata(req *kmsg.MetadataRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.MetadataResponse)
+
+	broker := kmsg.NewMetadataResponseBroker()
+	broker.NodeID = kafkaBrokerID
+	broker.Host = b.host
+	broker.Port = b.port
+	resp.Brokers = []kmsg.MetadataResponseBroker{broker}
+	resp.ControllerID = kafkaBrokerID
+	resp.ClusterID = kmsg.StringPtr("mock-cdss")
+
+	var names []string
+	if req.Topics == nil {
+		for name := range b.topics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	} else {
+		for _, t := range req.Topics {
+			if t.Topic != nil {
+				names = append(names, *t.Topic)
+			}
+		}
+	}
+
+	for _, name := range names {
+		t := kmsg.NewMetadataResponseTopic()
+		t.Topic = kmsg.StringPtr(name)
+
+		topic, ok := b.topics[name]
+		if !ok {
+			t.ErrorCode = kafkaErrUnknownTopicOrPartition
+			resp.Topics = append(resp.Topics, t)
+			continue
+		}
+
+		var replicas []int32
+		for i := int16(0); i < topic.replicationFactor; i++ {
+			replicas = append(replicas, kafkaBrokerID+int32(i))
+		}
+		for i := int32(0); i < topic.partitions; i++ {
+			p := kmsg.NewMetadataResponseTopicPartition()
+			p.Partition = i
+			p.Leader = kafkaBrokerID
+			p.Replicas = replicas
+			p.ISR = replicas
+			t.Partitions = append(t.Partitions, p)
+		}
+		resp.Topics = append(resp.Topics, t)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) createTopics(req *kmsg.CreateTopicsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.CreateTopicsResponse)
+
+	for _, t := range req.Topics {
+		r := kmsg.NewCreateTopicsResponseTopic()
+		r.Topic = t.Topic
+
+		switch {
+		case b.topics[t.Topic] != nil:
+			r.ErrorCode = kafkaErrTopicAlreadyExists
+			r.ErrorMessage = kmsg.StringPtr("Topic '" + t.Topic + "' already exists.")
+		case t.NumPartitions < 1:
+			r.ErrorCode = kafkaErrInvalidPartitions
+			r.ErrorMessage = kmsg.StringPtr("Number of partitions must be larger than 0.")
+		case t.ReplicationFactor < 1:
+			r.ErrorCode = kafkaErrInvalidReplicationFactor
+			r.ErrorMessage = kmsg.StringPtr("Replication factor must be larger than 0.")
+		case !req.ValidateOnly:
+			topic := &mockKafkaTopic{
+				partitions:        t.NumPartitions,
+				replicationFactor: t.ReplicationFactor,
+				configs:           map[string]string{},
+			}
+			for _, c := range t.Configs {
+				if c.Value != nil {
+					topic.configs[c.Name] = *c.Value
+				}
+			}
+			b.topics[t.Topic] = topic
+		}
+
+		resp.Topics = append(resp.Topics, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) deleteTopics(req *kmsg.DeleteTopicsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.DeleteTopicsResponse)
+
+	names := req.TopicNames
+	if req.Version >= 6 {
+		names = nil
+		for _, t := range req.Topics {
+			if t.Topic != nil {
+				names = append(names, *t.Topic)
+			}
+		}
+	}
+
+	for _, name := range names {
+		r := kmsg.NewDeleteTopicsResponseTopic()
+		r.Topic = kmsg.StringPtr(name)
+		if _, ok := b.topics[name]; ok {
+			delete(b.topics, name)
+		} else {
+			r.ErrorCode = kafkaErrUnknownTopicOrPartition
+		}
+		resp.Topics = append(resp.Topics, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) createPartitions(req *kmsg.CreatePartitionsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.CreatePartitionsResponse)
+
+	for _, t := range req.Topics {
+		r := kmsg.NewCreatePartitionsResponseTopic()
+		r.Topic = t.Topic
+
+		topic, ok := b.topics[t.Topic]
+		switch {
+		case !ok:
+			r.ErrorCode = kafkaErrUnknownTopicOrPartition
+		case t.Count <= topic.partitions:
+			r.ErrorCode = kafkaErrInvalidPartitions
+			r.ErrorMessage = kmsg.StringPtr("Topic currently has " + strconv.Itoa(int(topic.partitions)) + " partitions, which is higher than the requested " + strconv.Itoa(int(t.Count)) + ".")
+		case !req.ValidateOnly:
+			topic.partitions = t.Count
+		}
+
+		resp.Topics = append(resp.Topics, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) describeConfigs(req *kmsg.DescribeConfigsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.DescribeConfigsResponse)
+
+	for _, res := range req.Resources {
+		r := kmsg.NewDescribeConfigsResponseResource()
+		r.ResourceType = res.ResourceType
+		r.ResourceName = res.ResourceName
+
+		topic, ok := b.topics[res.ResourceName]
+		if res.ResourceType != kmsg.ConfigResourceTypeTopic || !ok {
+			r.ErrorCode = kafkaErrUnknownTopicOrPartition
+			resp.Resources = append(resp.Resources, r)
+			continue
+		}
+
+		values := map[string]string{}
+		for k, v := range kafkaTopicDefaults {
+			values[k] = v
+		}
+		for k, v := range topic.configs {
+			values[k] = v
+		}
+
+		names := make([]string, 0, len(values))
+		for k := range values {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			c := kmsg.NewDescribeConfigsResponseResourceConfig()
+			c.Name = name
+			c.Value = kmsg.StringPtr(values[name])
+			if _, ok := topic.configs[name]; ok {
+				c.Source = kmsg.ConfigSourceDynamicTopicConfig
+			} else {
+				c.IsDefault = true
+				c.Source = kmsg.ConfigSourceDefaultConfig
+			}
+			r.Configs = append(r.Configs, c)
+		}
+
+		resp.Resources = append(resp.Resources, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) alterConfigs(req *kmsg.AlterConfigsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.AlterConfigsResponse)
+
+	for _, res := range req.Resources {
+		r := kmsg.NewAlterConfigsResponseResource()
+		r.ResourceType = res.ResourceType
+		r.ResourceName = res.ResourceName
+
+		topic, ok := b.topics[res.ResourceName]
+		if res.ResourceType != kmsg.ConfigResourceTypeTopic || !ok {
+			r.ErrorCode = kafkaErrUnknownTopicOrPartition
+		} else if !req.ValidateOnly {
+			// AlterConfigs replaces every config of the resource.
+			topic.configs = map[string]string{}
+			for _, c := range res.Configs {
+				if c.Value != nil {
+					topic.configs[c.Name] = *c.Value
+				}
+			}
+		}
+
+		resp.Resources = append(resp.Resources, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) createACLs(req *kmsg.CreateACLsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.CreateACLsResponse)
+
+	for _, c := range req.Creations {
+		exists := false
+		for _, acl := range b.acls {
+			exists = exists || kafkaACLMatches(acl, c.ResourceType, &c.ResourceName, c.ResourcePatternType, &c.Principal, &c.Host, c.Operation, c.PermissionType)
+		}
+		if !exists {
+			b.acls = append(b.acls, c)
+		}
+		resp.Results = append(resp.Results, kmsg.NewCreateACLsResponseResult())
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) describeACLs(req *kmsg.DescribeACLsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.DescribeACLsResponse)
+
+	for _, acl := range b.acls {
+		if !kafkaACLMatches(acl, req.ResourceType, req.ResourceName, req.ResourcePatternType, req.Principal, req.Host, req.Operation, req.PermissionType) {
+			continue
+		}
+
+		r := kmsg.NewDescribeACLsResponseResource()
+		r.ResourceType = acl.ResourceType
+		r.ResourceName = acl.ResourceName
+		r.ResourcePatternType = acl.ResourcePatternType
+
+		a := kmsg.NewDescribeACLsResponseResourceACL()
+		a.Principal = acl.Principal
+		a.Host = acl.Host
+		a.Operation = acl.Operation
+		a.PermissionType = acl.PermissionType
+		r.ACLs = []kmsg.DescribeACLsResponseResourceACL{a}
+
+		resp.Resources = append(resp.Resources, r)
+	}
+
+	return resp
+}
+
+func (b *KafkaBroker) deleteACLs(req *kmsg.DeleteACLsRequest) kmsg.Response {
+	resp := req.ResponseKind().(*kmsg.DeleteACLsResponse)
+
+	for _, f := range req.Filters {
+		r := kmsg.NewDeleteACLsResponseResult()
+
+		kept := b.acls[:0]
+		for _, acl := range b.acls {
+			if !kafkaACLMatches(acl, f.ResourceType, f.ResourceName, f.ResourcePatternType, f.Principal, f.Host, f.Operation, f.PermissionType) {
+				kept = append(kept, acl)
+				continue
+			}
+
+			m := kmsg.NewDeleteACLsResponseResultMatchingACL()
+			m.ResourceType = acl.ResourceType
+			m.ResourceName = acl.ResourceName
+			m.ResourcePatternType = acl.ResourcePatternType
+			m.Principal = acl.Principal
+			m.Host = acl.Host
+			m.Operation = acl.Operation
+			m.PermissionType = acl.PermissionType
+			r.MatchingACLs = append(r.MatchingACLs, m)
+		}
+		b.acls = kept
+
+		resp.Results = append(resp.Results, r)
+	}
+
+	return resp
+}
+
+// kafkaACLMatches applies an ACL filter, where nil strings and the ANY
+// enum value match everything.
+func kafkaACLMatches(acl kmsg.CreateACLsRequestCreation, resourceType kmsg.ACLResourceType, resourceName *string,
+	patternType kmsg.ACLResourcePatternType, principal, host *string, operation kmsg.ACLOperation, permissionType kmsg.ACLPermissionType) bool {
+	return (resourceType == kmsg.ACLResourceTypeAny || resourceType == acl.ResourceType) &&
+		(resourceName == nil || *resourceName == acl.ResourceName) &&
+		(patternType == kmsg.ACLResourcePatternTypeAny || patternType == kmsg.ACLResourcePatternTypeMatch || patternType == acl.ResourcePatternType) &&
+		(principal == nil || *principal == acl.Principal) &&
+		(host == nil || *host == acl.Host) &&
+		(operation == kmsg.ACLOperationAny || operation == acl.Operation) &&
+		(permissionType == kmsg.ACLPermissionTypeAny || permissionType == acl.PermissionType)
+}
+
+func containsInt16(s []int16, v int16) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
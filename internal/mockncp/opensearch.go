@@ -0,0 +1,251 @@
+package mockncp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OpenSearchUser and OpenSearchPassword are the credentials OpenSearch accepts.
+const (
+	OpenSearchUser     = "admin"
+	OpenSearchPassword = "Admin1234!"
+)
+
+// OpenSearch is a stand-in for the REST API of the OpenSearch cluster behind
+// an SES cluster. It answers the snapshot repository and Index State
+// Management (ISM) policy APIs and keeps them in memory.
+type OpenSearch struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	repositories map[string]json.RawMessage
+	policies     map[string]*mockISMPolicy
+	seqNo        int64
+}
+
+type mockISMPolicy struct {
+	policy map[string]interface{}
+	seqNo  int64
+}
+
+// NewOpenSearch starts a server on a local port. Callers should Close it when done.
+func NewOpenSearch() *OpenSearch {
+	o := &OpenSearch{
+		repositories: map[string]json.RawMessage{},
+		policies:     map[string]*mockISMPolicy{},
+	}
+	o.server = httptest.NewServer(http.HandlerFunc(o.serveHTTP))
+	return o
+}
+
+// URL returns the endpoint of the cluster, e.g. http://127.0.0.1:9200.
+func (o *OpenSearch) URL() string {
+	return o.server.URL
+}
+
+func (o *OpenSearch) Close() {
+	o.server.Close()
+}
+
+// RemoveSnapshotRepository deletes a repository behind the provider's back.
+func (o *OpenSearch) RemoveSnapshotRepository(name string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.repositories[name]; !ok {
+		return false
+	}
+	delete(o.repositories, name)
+	return true
+}
+
+// HasSnapshotRepository reports whether the repository exists.
+func (o *OpenSearch) HasSnapshotRepository(name string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, ok := o.repositories[name]
+	return ok
+}
+
+// RemoveISMPolicy deletes a policy behind the provider's back.
+func (o *OpenSearch) RemoveISMPolicy(id string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.policies[id]; !ok {
+		return false
+	}
+	delete(o.policies, id)
+	return true
+}
+
+// HasISMPolicy reports whether the policy exists.
+func (o *OpenSearch) HasISMPolicy(id string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, ok := o.policies[id]
+	return ok
+}
+
+func (o *OpenSearch) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if user, password, ok := r.BasicAuth(); !ok || user != OpenSearchUser || password != OpenSearchPassword {
+		writeOpenSearchError(w, http.StatusUnauthorized, "security_exception", "missing authentication credentials")
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case strings.HasPrefix(path, "_snapshot/"):
+		o.serveSnapshotRepository(w, r, strings.TrimPrefix(path, "_snapshot/"))
+	case strings.HasPrefix(path, "_plugins/_ism/policies/"):
+		o.serveISMPolicy(w, r, strings.TrimPrefix(path, "_plugins/_ism/policies/"))
+	default:
+		writeOpenSearchError(w, http.StatusBadRequest, "illegal_argument_exception", "unsupported path "+r.URL.Path)
+	}
+}
+
+func (o *OpenSearch) serveSnapshotRepository(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet:
+		repo, ok := o.repositories[name]
+		if !ok {
+			writeOpenSearchError(w, http.StatusNotFound, "repository_missing_exception", "["+name+"] missing")
+			return
+		}
+		writeOpenSearchJSON(w, map[string]json.RawMessage{name: repo})
+	case http.MethodPut:
+		var repo struct {
+			Type     string            `json:"type"`
+			Settings map[string]string `json:"settings"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&repo); err != nil {
+			writeOpenSearchError(w, http.StatusBadRequest, "parse_exception", err.Error())
+			return
+		}
+		if repo.Type != "s3" || repo.Settings["bucket"] == "" {
+			writeOpenSearchError(w, http.StatusInternalServerError, "repository_exception", "["+name+"] an s3 repository requires a bucket")
+			return
+		}
+		o.repositories[name], _ = json.Marshal(repo)
+		writeOpenSearchJSON(w, map[string]bool{"acknowledged": true})
+	case http.MethodDelete:
+		if _, ok := o.repositories[name]; !ok {
+			writeOpenSearchError(w, http.StatusNotFound, "repository_missing_exception", "["+name+"] missing")
+			return
+		}
+		delete(o.repositories, name)
+		writeOpenSearchJSON(w, map[string]bool{"acknowledged": true})
+	default:
+		writeOpenSearchError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+func (o *OpenSearch) serveISMPolicy(w http.ResponseWriter, r *http.Request, id string) {
+	existing, ok := o.policies[id]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !ok {
+			writeOpenSearchError(w, http.StatusNotFound, "status_exception", "Policy not found")
+			return
+		}
+		writeOpenSearchJSON(w, ismPolicyResponse(id, existing))
+	case http.MethodPut:
+		// Like OpenSearch, an existing policy is only replaced given the
+		// sequence number and primary term it was read with.
+		if ok || r.URL.Query().Has("if_seq_no") {
+			if !ok || r.URL.Query().Get("if_seq_no") != strconv.FormatInt(existing.seqNo, 10) || r.URL.Query().Get("if_primary_term") != "1" {
+				writeOpenSearchError(w, http.StatusConflict, "version_conflict_engine_exception", "["+id+"]: version conflict")
+				return
+			}
+		}
+
+		var body struct {
+			Policy map[string]interface{} `json:"policy"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Policy == nil {
+			writeOpenSearchError(w, http.StatusBadRequest, "parse_exception", "policy is required")
+			return
+		}
+
+		o.seqNo++
+		p := &mockISMPolicy{policy: withISMDefaults(id, body.Policy), seqNo: o.seqNo}
+		o.policies[id] = p
+		status := http.StatusCreated
+		if ok {
+			status = http.StatusOK
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(ismPolicyResponse(id, p))
+	case http.MethodDelete:
+		if !ok {
+			writeOpenSearchError(w, http.StatusNotFound, "status_exception", "Policy not found")
+			return
+		}
+		delete(o.policies, id)
+		writeOpenSearchJSON(w, map[string]string{"_id": id, "result": "deleted"})
+	default:
+		writeOpenSearchError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+// withISMDefaults adds the fields OpenSearch fills in when storing a policy.
+func withISMDefaults(id string, policy map[string]interface{}) map[string]interface{} {
+	policy["policy_id"] = id
+	policy["last_updated_time"] = time.Now().UnixMilli()
+	policy["schema_version"] = 17
+	if _, ok := policy["error_notification"]; !ok {
+		policy["error_notification"] = nil
+	}
+
+	states, _ := policy["states"].([]interface{})
+	for _, s := range states {
+		state, _ := s.(map[string]interface{})
+		actions, _ := state["actions"].([]interface{})
+		for _, a := range actions {
+			action, _ := a.(map[string]interface{})
+			if _, ok := action["retry"]; !ok && action != nil {
+				action["retry"] = map[string]interface{}{"count": 3, "backoff": "exponential", "delay": "1m"}
+			}
+		}
+	}
+	return policy
+}
+
+func ismPolicyResponse(id string, p *mockISMPolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"_id":           id,
+		"_version":      p.seqNo,
+		"_seq_no":       p.seqNo,
+		"_primary_term": 1,
+		"policy":        p.policy,
+	}
+}
+
+func writeOpenSearchJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeOpenSearchError(w http.ResponseWriter, status int, errorType, reason string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]string{
+			"type":   errorType,
+			"reason": reason,
+		},
+		"status": status,
+	})
+}
@@ -0,0 +1,462 @@
+package mockncp
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Namespace    = "http://s3.amazonaws.com/doc/2006-03-01/"
+	s3Owner        = "mock-owner"
+	allUsersURI    = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authUsersURI   = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	defaultS3ACL   = "private"
+	s3ContentType  = "binary/octet-stream"
+	s3StreamingSig = "STREAMING-"
+)
+
+// objectStorage is a path-style S3 stand-in. It is guarded by Server.mu.
+type objectStorage struct {
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	name    string
+	created time.Time
+	acl     string
+	objects map[string]*object
+}
+
+type object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	acl          string
+	lastModified time.Time
+	metadata     map[string]string
+}
+
+func newObjectStorage() *objectStorage {
+	return &objectStorage{buckets: map[string]*bucket{}}
+}
+
+func (o *objectStorage) removeBucket(name string) bool {
+	if _, ok := o.buckets[name]; !ok {
+		return false
+	}
+	delete(o.buckets, name)
+	return true
+}
+
+func (o *objectStorage) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucketName, key, _ := strings.Cut(path, "/")
+	_, isACL := r.URL.Query()["acl"]
+
+	switch {
+	case bucketName == "" && r.Method == http.MethodGet:
+		o.listBuckets(w)
+	case key == "" && isACL:
+		o.bucketACL(w, r, bucketName)
+	case key == "":
+		o.bucket(w, r, bucketName)
+	case isACL:
+		o.objectACL(w, r, bucketName, key)
+	default:
+		o.object(w, r, bucketName, key)
+	}
+}
+
+func (o *objectStorage) listBuckets(w http.ResponseWriter) {
+	type bucketXML struct {
+		Name         string
+		CreationDate string
+	}
+	out := struct {
+		XMLName xml.Name    `xml:"ListAllMyBucketsResult"`
+		Xmlns   string      `xml:"xmlns,attr"`
+		Owner   ownerXML    `xml:"Owner"`
+		Buckets []bucketXML `xml:"Buckets>Bucket"`
+	}{Xmlns: s3Namespace, Owner: ownerXML{ID: s3Owner, DisplayName: s3Owner}}
+
+	for _, name := range o.bucketNames() {
+		b := o.buckets[name]
+		out.Buckets = append(out.Buckets, bucketXML{Name: b.name, CreationDate: b.created.Format(time.RFC3339)})
+	}
+
+	writeXML(w, http.StatusOK, out)
+}
+
+func (o *objectStorage) bucketNames() []string {
+	var names []string
+	for name := range o.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (o *objectStorage) bucket(w http.ResponseWriter, r *http.Request, name string) {
+	b, exists := o.buckets[name]
+
+	switch r.Method {
+	case http.MethodPut:
+		if exists {
+			writeS3Error(w, http.StatusConflict, "BucketAlreadyOwnedByYou", name)
+			return
+		}
+		o.buckets[name] = &bucket{
+			name:    name,
+			created: time.Now().UTC(),
+			acl:     aclFromHeader(r),
+			objects: map[string]*object{},
+		}
+		w.Header().Set("Location", "/"+name)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		if !exists {
+			writeS3Error(w, http.StatusNotFound, "NoSuchBucket", name)
+			return
+		}
+		if len(b.objects) > 0 {
+			writeS3Error(w, http.StatusConflict, "BucketNotEmpty", name)
+			return
+		}
+		delete(o.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if !exists {
+			writeS3Error(w, http.StatusNotFound, "NoSuchBucket", name)
+			return
+		}
+		o.listObjects(w, r, b)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", name)
+	}
+}
+
+func (o *objectStorage) listObjects(w http.ResponseWriter, r *http.Request, b *bucket) {
+	type contentXML struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+		StorageClass string
+	}
+	prefix := r.URL.Query().Get("prefix")
+	out := struct {
+		XMLName     xml.Name     `xml:"ListBucketResult"`
+		Xmlns       string       `xml:"xmlns,attr"`
+		Name        string       `xml:"Name"`
+		Prefix      string       `xml:"Prefix"`
+		KeyCount    int          `xml:"KeyCount"`
+		MaxKeys     int          `xml:"MaxKeys"`
+		IsTruncated bool         `xml:"IsTruncated"`
+		Contents    []contentXML `xml:"Contents"`
+	}{Xmlns: s3Namespace, Name: b.name, Prefix: prefix, MaxKeys: 1000}
+
+	var keys []string
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		obj := b.objects[key]
+		out.Contents = append(out.Contents, contentXML{
+			Key:          key,
+			LastModified: obj.lastModified.Format(time.RFC3339),
+			ETag:         obj.etag,
+			Size:         len(obj.body),
+			StorageClass: "STANDARD",
+		})
+	}
+	out.KeyCount = len(out.Contents)
+
+	writeXML(w, http.StatusOK, out)
+}
+
+func (o *objectStorage) object(w http.ResponseWriter, r *http.Request, bucketName, key string) {
+	b, ok := o.buckets[bucketName]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", bucketName)
+		return
+	}
+	obj, exists := b.objects[key]
+
+	switch r.Method {
+	case http.MethodPut:
+		if src := r.Header.Get("x-amz-copy-source"); src != "" {
+			o.copyObject(w, r, b, key, src)
+			return
+		}
+
+		body, err := readS3Body(r)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+
+		obj = newObject(body, r)
+		b.objects[key] = obj
+		w.Header().Set("ETag", obj.etag)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		if !exists {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", key)
+			return
+		}
+
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.body)))
+		w.Header().Set("ETag", obj.etag)
+		w.Header().Set("Last-Modified", obj.lastModified.Format(http.TimeFormat))
+		for k, v := range obj.metadata {
+			w.Header().Set("x-amz-meta-"+k, v)
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(obj.body)
+		}
+	case http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", key)
+	}
+}
+
+func (o *objectStorage) copyObject(w http.ResponseWriter, r *http.Request, dst *bucket, key, source string) {
+	source, _ = url.PathUnescape(source)
+	srcBucket, srcKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+
+	b, ok := o.buckets[srcBucket]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", srcBucket)
+		return
+	}
+	src, ok := b.objects[srcKey]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchKey", srcKey)
+		return
+	}
+
+	obj := *src
+	obj.lastModified = time.Now().UTC()
+	obj.acl = aclFromHeader(r)
+	if r.Header.Get("x-amz-metadata-directive") == "REPLACE" {
+		obj.contentType = contentTypeFromHeader(r)
+		obj.metadata = metadataFromHeader(r)
+	}
+	dst.objects[key] = &obj
+
+	writeXML(w, http.StatusOK, struct {
+		XMLName      xml.Name `xml:"CopyObjectResult"`
+		LastModified string
+		ETag         string
+	}{LastModified: obj.lastModified.Format(time.RFC3339), ETag: obj.etag})
+}
+
+func (o *objectStorage) bucketACL(w http.ResponseWriter, r *http.Request, name string) {
+	b, ok := o.buckets[name]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", name)
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		b.acl = aclFromHeader(r)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	writeXML(w, http.StatusOK, accessControlPolicy(b.acl))
+}
+
+func (o *objectStorage) objectACL(w http.ResponseWriter, r *http.Request, bucketName, key string) {
+	b, ok := o.buckets[bucketName]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", bucketName)
+		return
+	}
+	obj, ok := b.objects[key]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchKey", key)
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		obj.acl = aclFromHeader(r)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	writeXML(w, http.StatusOK, accessControlPolicy(obj.acl))
+}
+
+func newObject(body []byte, r *http.Request) *object {
+	sum := md5.Sum(body)
+	return &object{
+		body:         body,
+		contentType:  contentTypeFromHeader(r),
+		etag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		acl:          aclFromHeader(r),
+		lastModified: time.Now().UTC(),
+		metadata:     metadataFromHeader(r),
+	}
+}
+
+// readS3Body returns the payload of r, decoding aws-chunked uploads
+func readS3Body(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(r.Header.Get("x-amz-content-sha256"), s3StreamingSig) &&
+		!strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return body, nil
+	}
+
+	var out bytes.Buffer
+	reader := bufio.NewReader(bytes.NewReader(body))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("malformed chunk header: %w", err)
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed chunk size %q", sizeHex)
+		}
+		if size == 0 {
+			return out.Bytes(), nil
+		}
+		if _, err := io.CopyN(&out, reader, size); err != nil {
+			return nil, err
+		}
+		// trailing CRLF of the chunk
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func aclFromHeader(r *http.Request) string {
+	if acl := r.Header.Get("x-amz-acl"); acl != "" {
+		return acl
+	}
+	return defaultS3ACL
+}
+
+func contentTypeFromHeader(r *http.Request) string {
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		return ct
+	}
+	return s3ContentType
+}
+
+func metadataFromHeader(r *http.Request) map[string]string {
+	metadata := map[string]string{}
+	for k := range r.Header {
+		lower := strings.ToLower(k)
+		if strings.HasPrefix(lower, "x-amz-meta-") {
+			metadata[strings.TrimPrefix(lower, "x-amz-meta-")] = r.Header.Get(k)
+		}
+	}
+	return metadata
+}
+
+type ownerXML struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+type granteeXML struct {
+	XMLNS       string `xml:"xmlns:xsi,attr"`
+	Type        string `xml:"xsi:type,attr"`
+	ID          string `xml:"ID,omitempty"`
+	DisplayName string `xml:"DisplayName,omitempty"`
+	URI         string `xml:"URI,omitempty"`
+}
+
+type grantXML struct {
+	Grantee    granteeXML `xml:"Grantee"`
+	Permission string     `xml:"Permission"`
+}
+
+type accessControlPolicyXML struct {
+	XMLName xml.Name   `xml:"AccessControlPolicy"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	Owner   ownerXML   `xml:"Owner"`
+	Grants  []grantXML `xml:"AccessControlList>Grant"`
+}
+
+func accessControlPolicy(acl string) accessControlPolicyXML {
+	group := func(uri, permission string) grantXML {
+		return grantXML{
+			Grantee:    granteeXML{XMLNS: "http://www.w3.org/2001/XMLSchema-instance", Type: "Group", URI: uri},
+			Permission: permission,
+		}
+	}
+
+	policy := accessControlPolicyXML{
+		Xmlns: s3Namespace,
+		Owner: ownerXML{ID: s3Owner, DisplayName: s3Owner},
+		Grants: []grantXML{{
+			Grantee:    granteeXML{XMLNS: "http://www.w3.org/2001/XMLSchema-instance", Type: "CanonicalUser", ID: s3Owner, DisplayName: s3Owner},
+			Permission: "FULL_CONTROL",
+		}},
+	}
+
+	switch acl {
+	case "public-read":
+		policy.Grants = append(policy.Grants, group(allUsersURI, "READ"))
+	case "public-read-write":
+		policy.Grants = append(policy.Grants, group(allUsersURI, "READ"), group(allUsersURI, "WRITE"))
+	case "authenticated-read":
+		policy.Grants = append(policy.Grants, group(authUsersURI, "READ"))
+	}
+
+	return policy
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(v)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code, resource string) {
+	writeXML(w, status, struct {
+		XMLName  xml.Name `xml:"Error"`
+		Code     string
+		Message  string
+		Resource string
+	}{Code: code, Message: code, Resource: resource})
+}
@@ -0,0 +1,423 @@
+// Package mockncp provides an in-memory stand-in for the NCLOUD API gateway,
+// Object Storage, the Kafka brokers of a CDSS cluster and the OpenSearch API
+// of an SES cluster, so that SDK clients and acceptance tests can run without
+// a live account.
+//
+// Only the subset of vserver, vpc, vloadbalancer, vmysql, vredis and Object
+// Storage operations used by the provider is implemented. Every resource
+// reaches its final state immediately, so waiters return on the first poll.
+package mockncp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kinds of objects kept by the server. They are used with Remove to simulate
+// out-of-band changes (drift) in tests.
+const (
+	KindVpc                  = "vpc"
+	KindSubnet               = "subnet"
+	KindNetworkAcl           = "networkAcl"
+	KindRouteTable           = "routeTable"
+	KindAccessControlGroup   = "accessControlGroup"
+	KindLoginKey             = "loginKey"
+	KindInitScript           = "initScript"
+	KindServerInstance       = "serverInstance"
+	KindBlockStorage         = "blockStorage"
+	KindNetworkInterface     = "networkInterface"
+	KindTargetGroup          = "targetGroup"
+	KindMysqlInstance        = "mysqlInstance"
+	KindMysqlUser            = "mysqlUser"
+	KindNasVolume            = "nasVolume"
+	KindRedisConfigGroup     = "redisConfigGroup"
+	KindObjectStorageBucket  = "bucket"
+	signatureHeader          = "x-ncp-apigw-signature-v1"
+	returnCodeSuccess        = "0"
+	returnCodeNotFound       = "3000"
+	returnCodeInvalidRequest = "1000"
+	returnCodeDuplicate      = "1200"
+)
+
+type handlerFunc func(p params) (result, error)
+
+type Server struct {
+	*httptest.Server
+
+	// RegionCode and RegionNo describe the region returned first by getRegionList
+	RegionCode string
+	RegionNo   string
+	// OtherRegions are region codes returned by getRegionList after RegionCode
+	OtherRegions []string
+
+	mu       sync.Mutex
+	seq      int
+	store    map[string]map[string]interface{}
+	handlers map[string]handlerFunc
+	s3       *objectStorage
+}
+
+// NewServer starts a mock server. Callers should Close it when done.
+func NewServer(regionCode string) *Server {
+	s := &Server{
+		RegionCode: regionCode,
+		RegionNo:   "1",
+		seq:        1000,
+		store:      map[string]map[string]interface{}{},
+		handlers:   map[string]handlerFunc{},
+		s3:         newObjectStorage(),
+	}
+
+	s.registerVserver()
+	s.registerVpc()
+	s.registerVloadbalancer()
+	s.registerVmysql()
+	s.registerVredis()
+	s.registerVnas()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Remove deletes an object of the given kind behind the provider's back.
+// It returns false when there was nothing to delete.
+func (s *Server) Remove(kind, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if kind == KindObjectStorageBucket {
+		return s.s3.removeBucket(id)
+	}
+
+	if _, ok := s.store[kind][id]; !ok {
+		return false
+	}
+	delete(s.store[kind], id)
+	return true
+}
+
+// Count returns the number of objects of the given kind.
+func (s *Server) Count(kind string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if kind == KindObjectStorageBucket {
+		return len(s.s3.buckets)
+	}
+	return len(s.store[kind])
+}
+
+// Supports reports whether the server implements an API, given as
+// "{service}/{action}" for a single call or "{service}" for any call of
+// the service. Other calls fail with "is not supported by mock server".
+func (s *Server) Supports(api string) bool {
+	if _, ok := s.handlers[api]; ok {
+		return true
+	}
+	if strings.Contains(api, "/") {
+		return false
+	}
+	for k := range s.handlers {
+		if strings.HasPrefix(k, api+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get(signatureHeader) == "" {
+		s.s3.serveHTTP(w, r)
+		return
+	}
+
+	// {service}/{version}/{action}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) != 3 {
+		writeError(w, &apiError{http.StatusNotFound, returnCodeInvalidRequest, fmt.Sprintf("unknown path %s", r.URL.Path)})
+		return
+	}
+	service, action := parts[0], parts[2]
+
+	handler, ok := s.handlers[service+"/"+action]
+	if !ok {
+		writeError(w, &apiError{http.StatusNotFound, returnCodeInvalidRequest, fmt.Sprintf("%s/%s is not supported by mock server", service, action)})
+		return
+	}
+
+	p, err := readParams(r)
+	if err != nil {
+		writeError(w, &apiError{http.StatusBadRequest, returnCodeInvalidRequest, err.Error()})
+		return
+	}
+
+	res, err := handler(p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	paginate(res, p)
+
+	res["returnCode"] = returnCodeSuccess
+	res["returnMessage"] = "success"
+	res["requestId"] = strconv.Itoa(s.nextSeq())
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, &apiError{http.StatusInternalServerError, "9999", err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	// The SDK strips the outer object by slicing at the second '{', so keep
+	// a space after the colon to leave valid JSON behind.
+	fmt.Fprintf(w, `{"%sResponse": %s}`, action, body)
+}
+
+func (s *Server) nextSeq() int {
+	s.seq++
+	return s.seq
+}
+
+func (s *Server) nextID() string {
+	return strconv.Itoa(s.nextSeq())
+}
+
+func (s *Server) put(kind, id string, v interface{}) {
+	if s.store[kind] == nil {
+		s.store[kind] = map[string]interface{}{}
+	}
+	s.store[kind][id] = v
+}
+
+func (s *Server) get(kind, id string) (interface{}, bool) {
+	v, ok := s.store[kind][id]
+	return v, ok
+}
+
+func (s *Server) delete(kind, id string) bool {
+	if _, ok := s.store[kind][id]; !ok {
+		return false
+	}
+	delete(s.store[kind], id)
+	return true
+}
+
+// list returns objects of kind in creation order, keeping those for which keep returns true
+func (s *Server) list(kind string, keep func(v interface{}) bool) []interface{} {
+	ids := make([]string, 0, len(s.store[kind]))
+	for id := range s.store[kind] {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		if a != b {
+			return a < b
+		}
+		return ids[i] < ids[j]
+	})
+
+	out := []interface{}{}
+	for _, id := range ids {
+		if v := s.store[kind][id]; keep == nil || keep(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+type result map[string]interface{}
+
+func listResult(key string, items []interface{}) result {
+	return result{
+		"totalRows": len(items),
+		key:         items,
+	}
+}
+
+// paginate cuts a list result down to the page selected by pageNo and pageSize.
+// Pages start at 1 and totalRows keeps counting every item, as the API does.
+func paginate(res result, p params) {
+	pageSize, err := strconv.Atoi(p.get("pageSize"))
+	if err != nil || pageSize <= 0 {
+		return
+	}
+	if _, ok := res["totalRows"]; !ok {
+		return
+	}
+	pageNo, _ := strconv.Atoi(p.get("pageNo"))
+	if pageNo < 1 {
+		pageNo = 1
+	}
+
+	for key, v := range res {
+		items, ok := v.([]interface{})
+		if !ok {
+			continue
+		}
+		start := (pageNo - 1) * pageSize
+		if start > len(items) {
+			start = len(items)
+		}
+		end := start + pageSize
+		if end > len(items) {
+			end = len(items)
+		}
+		res[key] = items[start:end]
+	}
+}
+
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func notFound(format string, a ...interface{}) error {
+	return &apiError{http.StatusBadRequest, returnCodeNotFound, fmt.Sprintf(format, a...)}
+}
+
+func invalidRequest(format string, a ...interface{}) error {
+	return &apiError{http.StatusBadRequest, returnCodeInvalidRequest, fmt.Sprintf(format, a...)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{http.StatusInternalServerError, "9999", err.Error()}
+	}
+
+	log.Printf("[DEBUG] mockncp error: %s", e)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"responseError": map[string]string{
+			"returnCode":    e.code,
+			"returnMessage": e.message,
+		},
+	})
+}
+
+// params holds the form values of an API call. List arguments arrive as
+// name.1, name.2, ...
+type params url.Values
+
+func readParams(r *http.Request) (params, error) {
+	v := r.URL.Query()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range form {
+		v[k] = append(v[k], vs...)
+	}
+
+	return params(v), nil
+}
+
+func (p params) get(key string) string {
+	return url.Values(p).Get(key)
+}
+
+func (p params) getOr(key, def string) string {
+	if v := p.get(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func (p params) ptr(key string) *string {
+	if v := p.get(key); v != "" {
+		return &v
+	}
+	return nil
+}
+
+func (p params) bool(key string) *bool {
+	v := p.get(key) == "true"
+	return &v
+}
+
+func (p params) int32(key string, def int32) *int32 {
+	if n, err := strconv.Atoi(p.get(key)); err == nil {
+		v := int32(n)
+		return &v
+	}
+	return &def
+}
+
+func (p params) list(key string) []string {
+	var out []string
+	for i := 1; ; i++ {
+		v, ok := p[fmt.Sprintf("%s.%d", key, i)]
+		if !ok {
+			return out
+		}
+		out = append(out, v[0])
+	}
+}
+
+// matches reports whether value passes an optional list filter
+func (p params) matches(key string, value *string) bool {
+	filter := p.list(key)
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if value != nil && *value == f {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesOne reports whether value passes an optional single value filter
+func (p params) matchesOne(key string, value *string) bool {
+	f := p.get(key)
+	return f == "" || (value != nil && *value == f)
+}
+
+func str(v string) *string {
+	return &v
+}
+
+func ncloudBool(v bool) *bool {
+	return &v
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func now() *string {
+	return str(time.Now().UTC().Format("2006-01-02T15:04:05-0700"))
+}
@@ -0,0 +1,844 @@
+package mockncp_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider"
+)
+
+func newTestClient(t *testing.T) (*mockncp.Server, *conn.NcloudAPIClient) {
+	t.Helper()
+
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	config := conn.Config{
+		AccessKey:  "mock-access-key",
+		SecretKey:  "mock-secret-key",
+		Region:     "KR",
+		APIGateway: srv.URL,
+		// the mock serves buckets by path only
+		ObsPathStyle: true,
+	}
+	client, err := config.Client("", srv.URL)
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return srv, client
+}
+
+func createTestVpc(t *testing.T, client *conn.NcloudAPIClient) *vpc.Vpc {
+	t.Helper()
+
+	resp, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    ncloud.String("KR"),
+		VpcName:       ncloud.String("tf-mock-vpc"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("CreateVpc: %s", err)
+	}
+	if len(resp.VpcList) != 1 {
+		t.Fatalf("CreateVpc returned %d vpcs", len(resp.VpcList))
+	}
+
+	return resp.VpcList[0]
+}
+
+func TestServer_regionCache(t *testing.T) {
+	_, client := newTestClient(t)
+
+	for _, supportVPC := range []bool{true, false} {
+		config := &conn.ProviderConfig{SupportVPC: supportVPC, Client: client}
+		if err := config.LoadRegions(); err != nil {
+			t.Fatalf("LoadRegions(%t): %s", supportVPC, err)
+		}
+		if !config.IsValidRegionCode("KR") {
+			t.Fatalf("region KR is not loaded (support_vpc = %t)", supportVPC)
+		}
+		if config.IsValidRegionCode("JPN") {
+			t.Fatalf("region JPN should not be loaded (support_vpc = %t)", supportVPC)
+		}
+	}
+
+	classic := &conn.ProviderConfig{Client: client}
+	if err := classic.LoadRegions(); err != nil {
+		t.Fatalf("LoadRegions: %s", err)
+	}
+	if no := classic.GetRegionNoByCode("KR"); no == nil || *no != "1" {
+		t.Fatalf("unexpected classic region no: %v", no)
+	}
+}
+
+func TestServer_providerConfigure(t *testing.T) {
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	t.Setenv("NCLOUD_API_GW", srv.URL)
+	t.Setenv("NCLOUD_OBS_ENDPOINT", srv.URL)
+	t.Setenv("NCLOUD_OBS_PATH_STYLE", "1")
+	t.Setenv("NCLOUD_ACCESS_KEY", "mock-access-key")
+	t.Setenv("NCLOUD_SECRET_KEY", "mock-secret-key")
+	t.Setenv("NCLOUD_REGION", "KR")
+	t.Setenv("NCLOUD_SUPPORT_VPC", "true")
+
+	p := provider.New(context.Background())
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	config := p.Meta().(*conn.ProviderConfig)
+	if !config.SupportVPC || config.RegionCode != "KR" {
+		t.Fatalf("unexpected provider config: %+v", config)
+	}
+
+	createTestVpc(t, config.Client)
+	if n := srv.Count(mockncp.KindVpc); n != 1 {
+		t.Fatalf("expected the provider client to reach the mock server, got %d vpcs", n)
+	}
+}
+
+// redirectTransport sends every request to srv and records the hosts the
+// provider asked for
+type redirectTransport struct {
+	srv *mockncp.Server
+
+	mu    sync.Mutex
+	hosts map[string]bool
+}
+
+func (rt *redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.hosts[r.URL.Host] = true
+	rt.mu.Unlock()
+
+	target, _ := url.Parse(rt.srv.URL)
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host, r.Host = target.Scheme, target.Host, target.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestServer_providerConfigureAliases(t *testing.T) {
+	t.Setenv("NCLOUD_API_GW", "")
+	t.Setenv("NCLOUD_OBS_ENDPOINT", "")
+	t.Setenv("NCLOUD_REGION", "")
+	t.Setenv("NCLOUD_SITE", "")
+	t.Setenv("NCLOUD_SUPPORT_VPC", "")
+
+	aliases := []struct {
+		region     string
+		regionNo   string
+		site       string
+		supportVPC bool
+		host       string
+	}{
+		{"KR", "1", "public", true, "ncloud.apigw.ntruss.com"},
+		{"JPN", "7", "public", false, "ncloud.apigw.ntruss.com"},
+		{"KR", "1", "gov", true, "ncloud.apigw.gov-ntruss.com"},
+		{"FKR", "11", "fin", true, "fin-ncloud.apigw.fin-ntruss.com"},
+	}
+
+	type configured struct {
+		config    *conn.ProviderConfig
+		transport *redirectTransport
+		srv       *mockncp.Server
+	}
+	results := make([]configured, len(aliases))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(aliases))
+	for i, a := range aliases {
+		srv := mockncp.NewServer(a.region)
+		srv.RegionNo = a.regionNo
+		t.Cleanup(srv.Close)
+
+		rt := &redirectTransport{srv: srv, hosts: map[string]bool{}}
+		httpClient := &http.Client{Transport: rt}
+
+		p := provider.New(context.Background())
+		p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return provider.ConfigureWithHTTPClient(ctx, d, httpClient)
+		}
+
+		raw := map[string]interface{}{
+			"access_key":  "mock-access-key",
+			"secret_key":  "mock-secret-key",
+			"region":      a.region,
+			"site":        a.site,
+			"support_vpc": a.supportVPC,
+		}
+
+		wg.Add(1)
+		go func(i int, srv *mockncp.Server) {
+			defer wg.Done()
+			if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+				errs <- fmt.Errorf("configuring alias %d: %v", i, diags)
+				return
+			}
+			results[i] = configured{p.Meta().(*conn.ProviderConfig), rt, srv}
+		}(i, srv)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	for i, a := range aliases {
+		r := results[i]
+		if r.config.RegionCode != a.region {
+			t.Errorf("alias %d: region %q, want %q", i, r.config.RegionCode, a.region)
+		}
+		if !a.supportVPC && r.config.RegionNo != a.regionNo {
+			t.Errorf("alias %d: region no %q, want %q", i, r.config.RegionNo, a.regionNo)
+		}
+		for j, other := range aliases {
+			if other.region != a.region && r.config.IsValidRegionCode(other.region) {
+				t.Errorf("alias %d: sees region %s of alias %d", i, other.region, j)
+			}
+		}
+		if !r.transport.hosts[a.host] {
+			t.Errorf("alias %d: no request to %s, got %v", i, a.host, r.transport.hosts)
+		}
+		for host := range r.transport.hosts {
+			if strings.HasPrefix(host, "ncloud.") || strings.HasPrefix(host, "fin-ncloud.") {
+				if host != a.host {
+					t.Errorf("alias %d: request to %s, want only %s", i, host, a.host)
+				}
+			}
+		}
+	}
+
+	if v := os.Getenv("NCLOUD_REGION"); v != "" {
+		t.Errorf("NCLOUD_REGION was set to %q", v)
+	}
+	if v := os.Getenv("NCLOUD_API_GW"); v != "" {
+		t.Errorf("NCLOUD_API_GW was set to %q", v)
+	}
+
+	// each alias keeps talking to its own endpoint
+	kr := results[0]
+	createTestVpc(t, kr.config.Client)
+	if n := kr.srv.Count(mockncp.KindVpc); n != 1 {
+		t.Fatalf("expected 1 vpc on the KR server, got %d", n)
+	}
+	if n := results[2].srv.Count(mockncp.KindVpc); n != 0 {
+		t.Fatalf("expected no vpc on the gov server, got %d", n)
+	}
+}
+
+func TestServer_providerForRegion(t *testing.T) {
+	srv := mockncp.NewServer("KR")
+	srv.OtherRegions = []string{"JPN"}
+	t.Cleanup(srv.Close)
+
+	t.Setenv("NCLOUD_API_GW", srv.URL)
+	t.Setenv("NCLOUD_OBS_ENDPOINT", srv.URL)
+	t.Setenv("NCLOUD_OBS_PATH_STYLE", "1")
+
+	for _, supportVPC := range []bool{true, false} {
+		p := provider.New(context.Background())
+		raw := map[string]interface{}{
+			"access_key":  "mock-access-key",
+			"secret_key":  "mock-secret-key",
+			"region":      "KR",
+			"support_vpc": supportVPC,
+		}
+		if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+			t.Fatalf("configuring provider: %v", diags)
+		}
+		config := p.Meta().(*conn.ProviderConfig)
+
+		if c, err := config.ForRegion(""); err != nil || c != config {
+			t.Fatalf("ForRegion(\"\") = %p, %v, want the provider config", c, err)
+		}
+		if c, err := config.ForRegion("KR"); err != nil || c != config {
+			t.Fatalf("ForRegion(KR) = %p, %v, want the provider config", c, err)
+		}
+
+		jpn, err := config.ForRegion("JPN")
+		if err != nil {
+			t.Fatalf("ForRegion(JPN): %s", err)
+		}
+		if jpn.RegionCode != "JPN" || jpn.Client == config.Client {
+			t.Fatalf("unexpected JPN config: %+v", jpn)
+		}
+		if !supportVPC && jpn.RegionNo != "100" {
+			t.Errorf("JPN region no %q, want 100", jpn.RegionNo)
+		}
+		if again, _ := config.ForRegion("JPN"); again != jpn {
+			t.Errorf("ForRegion(JPN) built a second config")
+		}
+		if back, _ := jpn.ForRegion("KR"); back != config {
+			t.Errorf("ForRegion(KR) on the JPN config did not return the provider config")
+		}
+
+		if _, err := config.ForRegion("SGN"); err == nil {
+			t.Errorf("ForRegion(SGN) succeeded for a region the provider does not know")
+		}
+	}
+
+	// the JPN client sends its region with every request
+	config := conn.Config{AccessKey: "a", SecretKey: "s", APIGateway: srv.URL}
+	pc := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := pc.NewClient(config, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if err := pc.LoadRegions(); err != nil {
+		t.Fatal(err)
+	}
+	jpn, err := pc.ForRegion("JPN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := jpn.Client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    &jpn.RegionCode,
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("CreateVpc: %s", err)
+	}
+	if got := ncloud.StringValue(resp.VpcList[0].RegionCode); got != "JPN" {
+		t.Fatalf("vpc created in %s, want JPN", got)
+	}
+
+	list, err := pc.Client.Vpc.V2Api.GetVpcList(&vpc.GetVpcListRequest{RegionCode: &pc.RegionCode})
+	if err != nil {
+		t.Fatalf("GetVpcList: %s", err)
+	}
+	if len(list.VpcList) != 0 {
+		t.Fatalf("KR lists %d vpcs of JPN", len(list.VpcList))
+	}
+}
+
+func TestServer_vpc(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	v := createTestVpc(t, client)
+	if *v.VpcStatus.Code != "RUN" {
+		t.Fatalf("unexpected vpc status %s", *v.VpcStatus.Code)
+	}
+
+	acls, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: v.VpcNo})
+	if err != nil {
+		t.Fatalf("GetNetworkAclList: %s", err)
+	}
+	if len(acls.NetworkAclList) != 1 || !*acls.NetworkAclList[0].IsDefault {
+		t.Fatalf("expected a default network acl, got %d", len(acls.NetworkAclList))
+	}
+
+	acgs, err := client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{VpcNo: v.VpcNo})
+	if err != nil {
+		t.Fatalf("GetAccessControlGroupList: %s", err)
+	}
+	if len(acgs.AccessControlGroupList) != 1 || !*acgs.AccessControlGroupList[0].IsDefault {
+		t.Fatalf("expected a default access control group, got %d", len(acgs.AccessControlGroupList))
+	}
+
+	tables, err := client.Vpc.V2Api.GetRouteTableList(&vpc.GetRouteTableListRequest{VpcNo: v.VpcNo, SupportedSubnetTypeCode: ncloud.String("PUBLIC")})
+	if err != nil {
+		t.Fatalf("GetRouteTableList: %s", err)
+	}
+	if len(tables.RouteTableList) != 1 {
+		t.Fatalf("expected one default public route table, got %d", len(tables.RouteTableList))
+	}
+
+	subnet, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          v.VpcNo,
+		SubnetName:     ncloud.String("tf-mock-subnet"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		ZoneCode:       ncloud.String("KR-1"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PUBLIC"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSubnet: %s", err)
+	}
+	subnetNo := subnet.SubnetList[0].SubnetNo
+
+	detail, err := client.Vpc.V2Api.GetSubnetDetail(&vpc.GetSubnetDetailRequest{SubnetNo: subnetNo})
+	if err != nil {
+		t.Fatalf("GetSubnetDetail: %s", err)
+	}
+	if len(detail.SubnetList) != 1 || *detail.SubnetList[0].Subnet != "10.0.1.0/24" || *detail.SubnetList[0].SubnetType.Code != "PUBLIC" {
+		t.Fatalf("unexpected subnet detail: %s", common.MarshalUncheckedString(detail.SubnetList))
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: v.VpcNo}); err == nil {
+		t.Fatal("expected an error deleting a vpc with subnets")
+	}
+
+	// drift: the subnet disappears behind the provider's back
+	if !srv.Remove(mockncp.KindSubnet, *subnetNo) {
+		t.Fatal("Remove returned false for an existing subnet")
+	}
+	detail, err = client.Vpc.V2Api.GetSubnetDetail(&vpc.GetSubnetDetailRequest{SubnetNo: subnetNo})
+	if err != nil {
+		t.Fatalf("GetSubnetDetail: %s", err)
+	}
+	if len(detail.SubnetList) != 0 {
+		t.Fatalf("expected removed subnet to be gone, got %d", len(detail.SubnetList))
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: v.VpcNo}); err != nil {
+		t.Fatalf("DeleteVpc: %s", err)
+	}
+	for _, kind := range []string{mockncp.KindVpc, mockncp.KindNetworkAcl, mockncp.KindRouteTable, mockncp.KindAccessControlGroup} {
+		if n := srv.Count(kind); n != 0 {
+			t.Fatalf("expected no %s left after deleting vpc, got %d", kind, n)
+		}
+	}
+}
+
+func TestServer_notFoundError(t *testing.T) {
+	_, client := newTestClient(t)
+
+	_, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: ncloud.String("1")})
+	if err == nil {
+		t.Fatal("expected an error deleting a missing vpc")
+	}
+
+	commonErr, err := common.GetCommonErrorBody(err)
+	if err != nil {
+		t.Fatalf("error body can't be parsed: %s", err)
+	}
+	if commonErr.ReturnCode != "3000" {
+		t.Fatalf("unexpected return code %s", commonErr.ReturnCode)
+	}
+
+	_, err = client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(&vmysql.GetCloudMysqlInstanceDetailRequest{CloudMysqlInstanceNo: ncloud.String("1")})
+	if err == nil || !strings.Contains(err.Error(), "5001017") {
+		t.Fatalf("expected a 5001017 error for a missing mysql instance, got %v", err)
+	}
+}
+
+func TestServer_supports(t *testing.T) {
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	cases := map[string]bool{
+		"vpc":                              true,
+		"vpc/createVpc":                    true,
+		"vpc/createVpcPeeringInstance":     false,
+		"vmongodb":                         false,
+		"vmongodb/getCloudMongoDbInstance": false,
+		"vp":                               false,
+	}
+	for api, expected := range cases {
+		if got := srv.Supports(api); got != expected {
+			t.Errorf("Supports(%q) = %t, expected %t", api, got, expected)
+		}
+	}
+}
+
+func TestServer_loginKey(t *testing.T) {
+	_, client := newTestClient(t)
+
+	created, err := client.Vserver.V2Api.CreateLoginKey(&vserver.CreateLoginKeyRequest{KeyName: ncloud.String("tf-mock-key")})
+	if err != nil {
+		t.Fatalf("CreateLoginKey: %s", err)
+	}
+	if !strings.Contains(ncloud.StringValue(created.PrivateKey), "PRIVATE KEY") {
+		t.Fatalf("unexpected private key %q", ncloud.StringValue(created.PrivateKey))
+	}
+
+	if _, err := client.Vserver.V2Api.CreateLoginKey(&vserver.CreateLoginKeyRequest{KeyName: ncloud.String("tf-mock-key")}); err == nil {
+		t.Fatal("expected an error creating a duplicate login key")
+	}
+
+	list, err := client.Vserver.V2Api.GetLoginKeyList(&vserver.GetLoginKeyListRequest{KeyName: ncloud.String("tf-mock-key")})
+	if err != nil {
+		t.Fatalf("GetLoginKeyList: %s", err)
+	}
+	if len(list.LoginKeyList) != 1 || ncloud.StringValue(list.LoginKeyList[0].Fingerprint) == "" {
+		t.Fatalf("unexpected login key list: %s", common.MarshalUncheckedString(list.LoginKeyList))
+	}
+
+	if _, err := client.Vserver.V2Api.DeleteLoginKeys(&vserver.DeleteLoginKeysRequest{KeyNameList: []*string{ncloud.String("tf-mock-key")}}); err != nil {
+		t.Fatalf("DeleteLoginKeys: %s", err)
+	}
+}
+
+func TestServer_serverInstance(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	v := createTestVpc(t, client)
+	acls, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: v.VpcNo})
+	if err != nil {
+		t.Fatalf("GetNetworkAclList: %s", err)
+	}
+	subnet, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          v.VpcNo,
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		ZoneCode:       ncloud.String("KR-2"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PUBLIC"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSubnet: %s", err)
+	}
+	subnetNo := subnet.SubnetList[0].SubnetNo
+
+	created, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		ServerImageProductCode: ncloud.String("SW.VSVR.OS.LNX64.ROCKY.0810.B050"),
+		ServerProductCode:      ncloud.String("SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"),
+		VpcNo:                  v.VpcNo,
+		SubnetNo:               subnetNo,
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{
+			{NetworkInterfaceOrder: ncloud.Int32(0)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateServerInstances: %s", err)
+	}
+	instance := created.ServerInstanceList[0]
+	if ncloud.Int32Value(instance.CpuCount) != 2 || ncloud.Int64Value(instance.MemorySize) != 8*1024*1024*1024 || ncloud.StringValue(instance.ZoneCode) != "KR-2" {
+		t.Fatalf("unexpected server instance: %s", common.MarshalUncheckedString(instance))
+	}
+
+	ni, err := client.Vserver.V2Api.GetNetworkInterfaceDetail(&vserver.GetNetworkInterfaceDetailRequest{NetworkInterfaceNo: instance.NetworkInterfaceNoList[0]})
+	if err != nil {
+		t.Fatalf("GetNetworkInterfaceDetail: %s", err)
+	}
+	if len(ni.NetworkInterfaceList) != 1 || ncloud.StringValue(ni.NetworkInterfaceList[0].DeviceName) != "eth0" || ncloud.StringValue(ni.NetworkInterfaceList[0].Ip) == "" {
+		t.Fatalf("unexpected network interface: %s", common.MarshalUncheckedString(ni.NetworkInterfaceList))
+	}
+
+	storage, err := client.Vserver.V2Api.CreateBlockStorageInstance(&vserver.CreateBlockStorageInstanceRequest{
+		BlockStorageSize: ncloud.Int32(10),
+		ServerInstanceNo: instance.ServerInstanceNo,
+	})
+	if err != nil {
+		t.Fatalf("CreateBlockStorageInstance: %s", err)
+	}
+	storageNo := storage.BlockStorageInstanceList[0].BlockStorageInstanceNo
+
+	if _, err := client.Vserver.V2Api.ChangeBlockStorageVolumeSize(&vserver.ChangeBlockStorageVolumeSizeRequest{BlockStorageInstanceNo: storageNo, BlockStorageSize: ncloud.Int32(20)}); err != nil {
+		t.Fatalf("ChangeBlockStorageVolumeSize: %s", err)
+	}
+	if _, err := client.Vserver.V2Api.ChangeBlockStorageVolumeSize(&vserver.ChangeBlockStorageVolumeSizeRequest{BlockStorageInstanceNo: storageNo, BlockStorageSize: ncloud.Int32(10)}); err == nil {
+		t.Fatal("expected an error shrinking a block storage")
+	}
+
+	storages, err := client.Vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{ServerInstanceNo: instance.ServerInstanceNo})
+	if err != nil {
+		t.Fatalf("GetBlockStorageInstanceList: %s", err)
+	}
+	if len(storages.BlockStorageInstanceList) != 2 {
+		t.Fatalf("expected basic and additional block storage, got %d", len(storages.BlockStorageInstanceList))
+	}
+
+	if _, err := client.Vserver.V2Api.SetProtectServerTermination(&vserver.SetProtectServerTerminationRequest{ServerInstanceNo: instance.ServerInstanceNo, IsProtectServerTermination: ncloud.Bool(true)}); err != nil {
+		t.Fatalf("SetProtectServerTermination: %s", err)
+	}
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: []*string{instance.ServerInstanceNo}}); err == nil {
+		t.Fatal("expected an error terminating a protected server")
+	}
+	if _, err := client.Vserver.V2Api.SetProtectServerTermination(&vserver.SetProtectServerTerminationRequest{ServerInstanceNo: instance.ServerInstanceNo, IsProtectServerTermination: ncloud.Bool(false)}); err != nil {
+		t.Fatalf("SetProtectServerTermination: %s", err)
+	}
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: []*string{instance.ServerInstanceNo}}); err != nil {
+		t.Fatalf("TerminateServerInstances: %s", err)
+	}
+
+	// the basic storage and the default interface go with the server, the
+	// additional storage stays behind detached
+	if n := srv.Count(mockncp.KindNetworkInterface); n != 0 {
+		t.Fatalf("expected no network interface left, got %d", n)
+	}
+	detail, err := client.Vserver.V2Api.GetBlockStorageInstanceDetail(&vserver.GetBlockStorageInstanceDetailRequest{BlockStorageInstanceNo: storageNo})
+	if err != nil {
+		t.Fatalf("GetBlockStorageInstanceDetail: %s", err)
+	}
+	if srv.Count(mockncp.KindBlockStorage) != 1 || ncloud.StringValue(detail.BlockStorageInstanceList[0].BlockStorageInstanceStatusName) != "detached" {
+		t.Fatalf("unexpected block storage: %s", common.MarshalUncheckedString(detail.BlockStorageInstanceList))
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	_, client := newTestClient(t)
+
+	for i := 0; i < 230; i++ {
+		if _, err := client.Vserver.V2Api.CreateLoginKey(&vserver.CreateLoginKeyRequest{KeyName: ncloud.String(fmt.Sprintf("tf-mock-key-%03d", i))}); err != nil {
+			t.Fatalf("CreateLoginKey: %s", err)
+		}
+	}
+
+	page, err := client.Vserver.V2Api.GetLoginKeyList(&vserver.GetLoginKeyListRequest{PageNo: ncloud.Int32(3), PageSize: ncloud.Int32(100)})
+	if err != nil {
+		t.Fatalf("GetLoginKeyList: %s", err)
+	}
+	if len(page.LoginKeyList) != 30 || ncloud.Int32Value(page.TotalRows) != 230 {
+		t.Fatalf("got %d keys of %d on page 3, want 30 of 230", len(page.LoginKeyList), ncloud.Int32Value(page.TotalRows))
+	}
+
+	keys, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vserver.LoginKey, *int32, error) {
+		resp, err := client.Vserver.V2Api.GetLoginKeyList(&vserver.GetLoginKeyListRequest{PageNo: ncloud.Int32(pageNo), PageSize: ncloud.Int32(pageSize)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.LoginKeyList, resp.TotalRows, nil
+	})
+	if err != nil {
+		t.Fatalf("ListAllPages: %s", err)
+	}
+	if len(keys) != 230 {
+		t.Fatalf("got %d keys, want 230", len(keys))
+	}
+	for i, k := range keys {
+		if want := fmt.Sprintf("tf-mock-key-%03d", i); ncloud.StringValue(k.KeyName) != want {
+			t.Fatalf("key %d is %s, want %s", i, ncloud.StringValue(k.KeyName), want)
+		}
+	}
+}
+
+func TestServer_targetGroup(t *testing.T) {
+	_, client := newTestClient(t)
+	v := createTestVpc(t, client)
+
+	created, err := client.Vloadbalancer.V2Api.CreateTargetGroup(&vloadbalancer.CreateTargetGroupRequest{
+		VpcNo:                       v.VpcNo,
+		TargetGroupName:             ncloud.String("tf-mock-tg"),
+		TargetTypeCode:              ncloud.String("VSVR"),
+		TargetGroupProtocolTypeCode: ncloud.String("HTTP"),
+		TargetGroupPort:             ncloud.Int32(8080),
+	})
+	if err != nil {
+		t.Fatalf("CreateTargetGroup: %s", err)
+	}
+	tg := created.TargetGroupList[0]
+	if *tg.HealthCheckPort != 8080 || *tg.HealthCheckUrlPath != "/" {
+		t.Fatalf("unexpected health check defaults: %s", common.MarshalUncheckedString(tg))
+	}
+
+	if _, err := client.Vloadbalancer.V2Api.ChangeTargetGroupConfiguration(&vloadbalancer.ChangeTargetGroupConfigurationRequest{
+		TargetGroupNo:     tg.TargetGroupNo,
+		AlgorithmTypeCode: ncloud.String("LC"),
+		UseStickySession:  ncloud.Bool(true),
+	}); err != nil {
+		t.Fatalf("ChangeTargetGroupConfiguration: %s", err)
+	}
+
+	list, err := client.Vloadbalancer.V2Api.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{TargetGroupNoList: []*string{tg.TargetGroupNo}})
+	if err != nil {
+		t.Fatalf("GetTargetGroupList: %s", err)
+	}
+	if len(list.TargetGroupList) != 1 || *list.TargetGroupList[0].AlgorithmType.Code != "LC" || !*list.TargetGroupList[0].UseStickySession {
+		t.Fatalf("unexpected target group: %s", common.MarshalUncheckedString(list.TargetGroupList))
+	}
+
+	if _, err := client.Vloadbalancer.V2Api.DeleteTargetGroups(&vloadbalancer.DeleteTargetGroupsRequest{TargetGroupNoList: []*string{tg.TargetGroupNo}}); err != nil {
+		t.Fatalf("DeleteTargetGroups: %s", err)
+	}
+}
+
+func TestServer_mysql(t *testing.T) {
+	srv, client := newTestClient(t)
+	v := createTestVpc(t, client)
+
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: v.VpcNo})
+	subnet, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:        v.VpcNo,
+		Subnet:       ncloud.String("10.0.2.0/24"),
+		NetworkAclNo: acls.NetworkAclList[0].NetworkAclNo,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubnet: %s", err)
+	}
+
+	created, err := client.Vmysql.V2Api.CreateCloudMysqlInstance(&vmysql.CreateCloudMysqlInstanceRequest{
+		VpcNo:                      v.VpcNo,
+		SubnetNo:                   subnet.SubnetList[0].SubnetNo,
+		CloudMysqlServiceName:      ncloud.String("tf-mock-mysql"),
+		CloudMysqlServerNamePrefix: ncloud.String("tf-mock"),
+		CloudMysqlUserName:         ncloud.String("testuser"),
+		CloudMysqlUserPassword:     ncloud.String("t123456789!"),
+		HostIp:                     ncloud.String("%"),
+		CloudMysqlDatabaseName:     ncloud.String("testdb"),
+		IsHa:                       ncloud.Bool(false),
+	})
+	if err != nil {
+		t.Fatalf("CreateCloudMysqlInstance: %s", err)
+	}
+	no := created.CloudMysqlInstanceList[0].CloudMysqlInstanceNo
+
+	detail, err := client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(&vmysql.GetCloudMysqlInstanceDetailRequest{CloudMysqlInstanceNo: no})
+	if err != nil {
+		t.Fatalf("GetCloudMysqlInstanceDetail: %s", err)
+	}
+	instance := detail.CloudMysqlInstanceList[0]
+	if *instance.CloudMysqlInstanceStatus.Code != "CREAT" || *instance.CloudMysqlInstanceOperation.Code != "NULL" {
+		t.Fatalf("mysql instance is not running: %s", common.MarshalUncheckedString(instance))
+	}
+	if len(instance.CloudMysqlServerInstanceList) != 1 || *instance.CloudMysqlServerInstanceList[0].CloudMysqlServerName != "tf-mock-001" {
+		t.Fatalf("unexpected mysql servers: %s", common.MarshalUncheckedString(instance.CloudMysqlServerInstanceList))
+	}
+
+	backups, err := client.Vmysql.V2Api.GetCloudMysqlBackupDetailList(&vmysql.GetCloudMysqlBackupDetailListRequest{CloudMysqlInstanceNo: no})
+	if err != nil {
+		t.Fatalf("GetCloudMysqlBackupDetailList: %s", err)
+	}
+	if len(backups.CloudMysqlBackupDetailList) != 1 || backups.CloudMysqlBackupDetailList[0].FileName == nil {
+		t.Fatalf("unexpected mysql backups: %s", common.MarshalUncheckedString(backups.CloudMysqlBackupDetailList))
+	}
+
+	users, err := client.Vmysql.V2Api.GetCloudMysqlUserList(&vmysql.GetCloudMysqlUserListRequest{CloudMysqlInstanceNo: no})
+	if err != nil {
+		t.Fatalf("GetCloudMysqlUserList: %s", err)
+	}
+	if len(users.CloudMysqlUserList) != 1 || *users.CloudMysqlUserList[0].UserName != "testuser" || *users.CloudMysqlUserList[0].HostIp != "%" {
+		t.Fatalf("unexpected mysql users: %s", common.MarshalUncheckedString(users.CloudMysqlUserList))
+	}
+
+	if _, err := client.Vmysql.V2Api.ChangeCloudMysqlUserList(&vmysql.ChangeCloudMysqlUserListRequest{
+		CloudMysqlInstanceNo: no,
+		CloudMysqlUserList: []*vmysql.CloudMysqlUserParameter{
+			{Name: ncloud.String("testuser"), HostIp: ncloud.String("%"), Password: ncloud.String("t987654321!"), Authority: ncloud.String("DDL")},
+		},
+	}); err != nil {
+		t.Fatalf("ChangeCloudMysqlUserList: %s", err)
+	}
+
+	if _, err := client.Vmysql.V2Api.ChangeCloudMysqlUserList(&vmysql.ChangeCloudMysqlUserListRequest{
+		CloudMysqlInstanceNo: no,
+		CloudMysqlUserList: []*vmysql.CloudMysqlUserParameter{
+			{Name: ncloud.String("nobody"), HostIp: ncloud.String("%"), Password: ncloud.String("t987654321!"), Authority: ncloud.String("READ")},
+		},
+	}); err == nil {
+		t.Fatal("expected an error when changing a missing mysql user")
+	}
+
+	if _, err := client.Vmysql.V2Api.DeleteCloudMysqlInstance(&vmysql.DeleteCloudMysqlInstanceRequest{CloudMysqlInstanceNo: no}); err != nil {
+		t.Fatalf("DeleteCloudMysqlInstance: %s", err)
+	}
+	if n := srv.Count(mockncp.KindMysqlUser); n != 0 {
+		t.Fatalf("expected mysql users to be removed with their instance, got %d", n)
+	}
+}
+
+func TestServer_redisConfigGroup(t *testing.T) {
+	_, client := newTestClient(t)
+
+	for _, name := range []string{"tf-mock", "tf-mock-second"} {
+		if _, err := client.Vredis.V2Api.CreateCloudRedisConfigGroup(&vredis.CreateCloudRedisConfigGroupRequest{
+			ConfigGroupName:   ncloud.String(name),
+			CloudRedisVersion: ncloud.String("7.0.13-simple"),
+		}); err != nil {
+			t.Fatalf("CreateCloudRedisConfigGroup: %s", err)
+		}
+	}
+
+	list, err := client.Vredis.V2Api.GetCloudRedisConfigGroupList(&vredis.GetCloudRedisConfigGroupListRequest{ConfigGroupName: ncloud.String("tf-mock")})
+	if err != nil {
+		t.Fatalf("GetCloudRedisConfigGroupList: %s", err)
+	}
+	// names are matched by prefix, like the real API
+	if len(list.CloudRedisConfigGroupList) != 2 || *list.CloudRedisConfigGroupList[0].ConfigGroupStatusName != "running" {
+		t.Fatalf("unexpected config groups: %s", common.MarshalUncheckedString(list.CloudRedisConfigGroupList))
+	}
+
+	if _, err := client.Vredis.V2Api.DeleteCloudRedisConfigGroup(&vredis.DeleteCloudRedisConfigGroupRequest{ConfigGroupNo: list.CloudRedisConfigGroupList[0].ConfigGroupNo}); err != nil {
+		t.Fatalf("DeleteCloudRedisConfigGroup: %s", err)
+	}
+}
+
+func TestServer_objectStorage(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+	s3client := client.ObjectStorage
+
+	if _, err := s3client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String("tf-mock-bucket")}); err != nil {
+		t.Fatalf("CreateBucket: %s", err)
+	}
+
+	buckets, err := s3client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		t.Fatalf("ListBuckets: %s", err)
+	}
+	if len(buckets.Buckets) != 1 || *buckets.Buckets[0].Name != "tf-mock-bucket" || buckets.Buckets[0].CreationDate == nil {
+		t.Fatalf("unexpected buckets: %s", common.MarshalUncheckedString(buckets.Buckets))
+	}
+
+	if _, err := s3client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String("tf-mock-bucket"),
+		Key:         aws.String("dir/hello.txt"),
+		Body:        strings.NewReader("hello"),
+		ContentType: aws.String("text/plain"),
+	}); err != nil {
+		t.Fatalf("PutObject: %s", err)
+	}
+
+	if _, err := s3client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String("tf-mock-bucket"),
+		Key:        aws.String("copy.txt"),
+		CopySource: aws.String("tf-mock-bucket/dir/hello.txt"),
+	}); err != nil {
+		t.Fatalf("CopyObject: %s", err)
+	}
+
+	obj, err := s3client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String("tf-mock-bucket"), Key: aws.String("copy.txt")})
+	if err != nil {
+		t.Fatalf("GetObject: %s", err)
+	}
+	body, _ := io.ReadAll(obj.Body)
+	obj.Body.Close()
+	if string(body) != "hello" || aws.ToString(obj.ContentType) != "text/plain" {
+		t.Fatalf("unexpected object %q (%s)", body, aws.ToString(obj.ContentType))
+	}
+
+	if _, err := s3client.PutObjectAcl(ctx, &s3.PutObjectAclInput{
+		Bucket: aws.String("tf-mock-bucket"),
+		Key:    aws.String("copy.txt"),
+		ACL:    s3types.ObjectCannedACLPublicRead,
+	}); err != nil {
+		t.Fatalf("PutObjectAcl: %s", err)
+	}
+	acl, err := s3client.GetObjectAcl(ctx, &s3.GetObjectAclInput{Bucket: aws.String("tf-mock-bucket"), Key: aws.String("copy.txt")})
+	if err != nil {
+		t.Fatalf("GetObjectAcl: %s", err)
+	}
+	if len(acl.Grants) != 2 || acl.Grants[1].Permission != s3types.PermissionRead {
+		t.Fatalf("unexpected grants: %s", common.MarshalUncheckedString(acl.Grants))
+	}
+
+	if _, err := s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String("tf-mock-bucket")}); err == nil {
+		t.Fatal("expected an error deleting a non-empty bucket")
+	}
+
+	for _, key := range []string{"dir/hello.txt", "copy.txt"} {
+		if _, err := s3client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String("tf-mock-bucket"), Key: aws.String(key)}); err != nil {
+			t.Fatalf("DeleteObject: %s", err)
+		}
+	}
+	if _, err := s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String("tf-mock-bucket")}); err != nil {
+		t.Fatalf("DeleteBucket: %s", err)
+	}
+	if n := srv.Count(mockncp.KindObjectStorageBucket); n != 0 {
+		t.Fatalf("expected no buckets, got %d", n)
+	}
+}
@@ -0,0 +1,145 @@
+package mockncp
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+)
+
+func vloadbalancerCode(code string) *vloadbalancer.CommonCode {
+	return &vloadbalancer.CommonCode{Code: str(code), CodeName: str(code)}
+}
+
+// targetGroupOutput keeps an empty targetNoList in responses, like the real API
+type targetGroupOutput struct {
+	*vloadbalancer.TargetGroup
+	TargetNoList []*string `json:"targetNoList"`
+}
+
+func targetGroupList(items ...interface{}) []interface{} {
+	out := make([]interface{}, 0, len(items))
+	for _, i := range items {
+		tg := i.(*vloadbalancer.TargetGroup)
+		out = append(out, targetGroupOutput{TargetGroup: tg, TargetNoList: append([]*string{}, tg.TargetNoList...)})
+	}
+	return out
+}
+
+func (s *Server) registerVloadbalancer() {
+	h := s.handlers
+
+	h["vloadbalancer/createTargetGroup"] = s.createTargetGroup
+	h["vloadbalancer/getTargetGroupList"] = s.getTargetGroupList
+	h["vloadbalancer/getTargetGroupDetail"] = s.getTargetGroupList
+	h["vloadbalancer/changeTargetGroupConfiguration"] = s.changeTargetGroupConfiguration
+	h["vloadbalancer/changeTargetGroupHealthCheckConfiguration"] = s.changeTargetGroupHealthCheckConfiguration
+	h["vloadbalancer/deleteTargetGroups"] = s.deleteTargetGroups
+	h["vloadbalancer/getTargetList"] = func(p params) (result, error) {
+		return listResult("targetList", nil), nil
+	}
+}
+
+func (s *Server) createTargetGroup(p params) (result, error) {
+	vpcNo := p.get("vpcNo")
+	if _, ok := s.get(KindVpc, vpcNo); !ok {
+		return nil, notFound("vpc %s not found", vpcNo)
+	}
+
+	protocol := p.getOr("targetGroupProtocolTypeCode", "TCP")
+	healthCheckProtocol := protocol
+	if protocol == "PROXY_TCP" {
+		healthCheckProtocol = "TCP"
+	}
+	healthCheckProtocol = p.getOr("healthCheckProtocolTypeCode", healthCheckProtocol)
+
+	port := p.int32("targetGroupPort", 80)
+
+	no := s.nextID()
+	tg := &vloadbalancer.TargetGroup{
+		TargetGroupNo:            str(no),
+		TargetGroupName:          str(p.getOr("targetGroupName", "tg-"+no)),
+		TargetType:               vloadbalancerCode(p.getOr("targetTypeCode", "VSVR")),
+		VpcNo:                    str(vpcNo),
+		TargetGroupProtocolType:  vloadbalancerCode(protocol),
+		TargetGroupPort:          port,
+		TargetGroupDescription:   str(p.get("targetGroupDescription")),
+		UseStickySession:         ncloudBool(false),
+		UseProxyProtocol:         ncloudBool(false),
+		AlgorithmType:            vloadbalancerCode("RR"),
+		CreateDate:               now(),
+		RegionCode:               str(s.RegionCode),
+		HealthCheckProtocolType:  vloadbalancerCode(healthCheckProtocol),
+		HealthCheckPort:          p.int32("healthCheckPort", *port),
+		HealthCheckCycle:         p.int32("healthCheckCycle", 30),
+		HealthCheckUpThreshold:   p.int32("healthCheckUpThreshold", 2),
+		HealthCheckDownThreshold: p.int32("healthCheckDownThreshold", 2),
+		TargetNoList:             []*string{},
+	}
+	if healthCheckProtocol == "HTTP" || healthCheckProtocol == "HTTPS" {
+		tg.HealthCheckUrlPath = str(p.getOr("healthCheckUrlPath", "/"))
+		tg.HealthCheckHttpMethodType = vloadbalancerCode(p.getOr("healthCheckHttpMethodTypeCode", "GET"))
+	}
+	s.put(KindTargetGroup, no, tg)
+
+	return listResult("targetGroupList", targetGroupList(tg)), nil
+}
+
+func (s *Server) getTargetGroupList(p params) (result, error) {
+	no := p.get("targetGroupNo")
+	items := s.list(KindTargetGroup, func(i interface{}) bool {
+		v := i.(*vloadbalancer.TargetGroup)
+		return (no == "" || *v.TargetGroupNo == no) &&
+			p.matches("targetGroupNoList", v.TargetGroupNo) &&
+			p.matchesOne("vpcNo", v.VpcNo) &&
+			p.matchesOne("targetTypeCode", v.TargetType.Code)
+	})
+	return listResult("targetGroupList", targetGroupList(items...)), nil
+}
+
+func (s *Server) changeTargetGroupConfiguration(p params) (result, error) {
+	no := p.get("targetGroupNo")
+	v, ok := s.get(KindTargetGroup, no)
+	if !ok {
+		return nil, notFound("target group %s not found", no)
+	}
+
+	tg := v.(*vloadbalancer.TargetGroup)
+	tg.AlgorithmType = vloadbalancerCode(p.getOr("algorithmTypeCode", *tg.AlgorithmType.Code))
+	if p.get("useStickySession") != "" {
+		tg.UseStickySession = p.bool("useStickySession")
+	}
+	if p.get("useProxyProtocol") != "" {
+		tg.UseProxyProtocol = p.bool("useProxyProtocol")
+	}
+
+	return listResult("targetGroupList", targetGroupList(tg)), nil
+}
+
+func (s *Server) changeTargetGroupHealthCheckConfiguration(p params) (result, error) {
+	no := p.get("targetGroupNo")
+	v, ok := s.get(KindTargetGroup, no)
+	if !ok {
+		return nil, notFound("target group %s not found", no)
+	}
+
+	tg := v.(*vloadbalancer.TargetGroup)
+	tg.HealthCheckPort = p.int32("healthCheckPort", *tg.HealthCheckPort)
+	tg.HealthCheckCycle = p.int32("healthCheckCycle", *tg.HealthCheckCycle)
+	tg.HealthCheckUpThreshold = p.int32("healthCheckUpThreshold", *tg.HealthCheckUpThreshold)
+	tg.HealthCheckDownThreshold = p.int32("healthCheckDownThreshold", *tg.HealthCheckDownThreshold)
+	if path := p.ptr("healthCheckUrlPath"); path != nil {
+		tg.HealthCheckUrlPath = path
+	}
+	if method := p.get("healthCheckHttpMethodTypeCode"); method != "" {
+		tg.HealthCheckHttpMethodType = vloadbalancerCode(method)
+	}
+
+	return listResult("targetGroupList", targetGroupList(tg)), nil
+}
+
+func (s *Server) deleteTargetGroups(p params) (result, error) {
+	for _, no := range p.list("targetGroupNoList") {
+		if !s.delete(KindTargetGroup, no) {
+			return nil, notFound("target group %s not found", no)
+		}
+	}
+	return listResult("targetGroupList", nil), nil
+}
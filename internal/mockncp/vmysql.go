@@ -0,0 +1,293 @@
+package mockncp
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+// returnCodeMysqlNotFound is returned by the real API when an instance is missing or already deleted
+const returnCodeMysqlNotFound = "5001017"
+
+var engineVersionPattern = regexp.MustCompile(`\d+\.\d+(\.\d+)?`)
+
+func vmysqlCode(code string) *vmysql.CommonCode {
+	return &vmysql.CommonCode{Code: str(code), CodeName: str(code)}
+}
+
+func (s *Server) registerVmysql() {
+	h := s.handlers
+
+	h["vmysql/createCloudMysqlInstance"] = s.createCloudMysqlInstance
+	h["vmysql/getCloudMysqlInstanceList"] = s.getCloudMysqlInstanceList
+	h["vmysql/getCloudMysqlInstanceDetail"] = s.getCloudMysqlInstanceDetail
+	h["vmysql/deleteCloudMysqlInstance"] = s.deleteCloudMysqlInstance
+	h["vmysql/getCloudMysqlBackupDetailList"] = s.getCloudMysqlBackupDetailList
+	h["vmysql/getCloudMysqlUserList"] = s.getCloudMysqlUserList
+	h["vmysql/addCloudMysqlUserList"] = s.addCloudMysqlUserList
+	h["vmysql/changeCloudMysqlUserList"] = s.changeCloudMysqlUserList
+	h["vmysql/deleteCloudMysqlUserList"] = s.deleteCloudMysqlUserList
+}
+
+func (s *Server) createCloudMysqlInstance(p params) (result, error) {
+	subnetNo := p.get("subnetNo")
+	v, ok := s.get(KindSubnet, subnetNo)
+	if !ok {
+		return nil, notFound("subnet %s not found", subnetNo)
+	}
+	subnet := v.(*vpc.Subnet)
+
+	for _, key := range []string{"cloudMysqlServiceName", "cloudMysqlServerNamePrefix", "cloudMysqlUserName", "cloudMysqlUserPassword", "hostIp", "cloudMysqlDatabaseName"} {
+		if p.get(key) == "" {
+			return nil, invalidRequest("%s is required", key)
+		}
+	}
+
+	version := engineVersionPattern.FindString(p.get("engineVersionCode"))
+	if version == "" {
+		version = "8.0.36"
+	}
+
+	isHa := p.get("isHa") != "false"
+	isPublic := *subnet.SubnetType.Code == "PUBLIC"
+	no := s.nextID()
+	prefix := p.get("cloudMysqlServerNamePrefix")
+
+	instance := &vmysql.CloudMysqlInstance{
+		CloudMysqlInstanceNo:         str(no),
+		CloudMysqlServiceName:        str(p.get("cloudMysqlServiceName")),
+		CloudMysqlInstanceStatusName: str("running"),
+		CloudMysqlInstanceStatus:     vmysqlCode("CREAT"),
+		CloudMysqlInstanceOperation:  vmysqlCode("NULL"),
+		CloudMysqlImageProductCode:   str(p.getOr("cloudMysqlImageProductCode", "SW.VMYSL.OS.LNX64.ROCKY.0810.MYSQL.B050")),
+		EngineVersion:                str("MySQL " + version),
+		License:                      vmysqlCode("GPL"),
+		CloudMysqlPort:               p.int32("cloudMysqlPort", 3306),
+		IsHa:                         &isHa,
+		IsMultiZone:                  p.bool("isMultiZone"),
+		GenerationCode:               str("G2"),
+		IsBackup:                     ncloudBool(isHa || p.get("isBackup") != "false"),
+		BackupFileRetentionPeriod:    p.int32("backupFileRetentionPeriod", 1),
+		BackupTime:                   str(p.getOr("backupTime", "02:00")),
+		CreateDate:                   now(),
+		AccessControlGroupNoList:     []*string{str(s.nextID())},
+		CloudMysqlConfigList:         []*string{},
+	}
+
+	roles := []string{"M"}
+	if isHa {
+		roles = append(roles, "H")
+	}
+	for i, role := range roles {
+		serverNo := s.nextID()
+		name := fmt.Sprintf("%s-%03d", prefix, i+1)
+		server := &vmysql.CloudMysqlServerInstance{
+			CloudMysqlServerInstanceNo:         str(serverNo),
+			CloudMysqlServerName:               str(name),
+			CloudMysqlServerRole:               vmysqlCode(role),
+			CloudMysqlServerInstanceStatusName: str("running"),
+			CloudMysqlServerInstanceStatus:     vmysqlCode("RUN"),
+			CloudMysqlServerInstanceOperation:  vmysqlCode("NULL"),
+			CloudMysqlProductCode:              str(p.getOr("cloudMysqlProductCode", "SVR.VDBAS.STAND.C002.M008.NET.SSD.B050.G002")),
+			RegionCode:                         str(s.RegionCode),
+			ZoneCode:                           subnet.ZoneCode,
+			VpcNo:                              subnet.VpcNo,
+			SubnetNo:                           subnet.SubnetNo,
+			IsPublicSubnet:                     &isPublic,
+			PrivateDomain:                      str(name + ".private.mock.ncloud"),
+			PrivateIp:                          str(fmt.Sprintf("10.0.0.%d", i+6)),
+			DataStorageType:                    vmysqlCode(p.getOr("dataStorageTypeCode", "SSD")),
+			IsStorageEncryption:                p.bool("isStorageEncryption"),
+			DataStorageSize:                    int64Ptr(10 * 1024 * 1024 * 1024),
+			CpuCount:                           p.int32("cpuCount", 2),
+			MemorySize:                         int64Ptr(8 * 1024 * 1024 * 1024),
+			Uptime:                             now(),
+			CreateDate:                         now(),
+		}
+		if isPublic {
+			server.PublicDomain = str(name + ".public.mock.ncloud")
+		}
+		instance.CloudMysqlServerInstanceList = append(instance.CloudMysqlServerInstanceList, server)
+	}
+
+	s.put(KindMysqlInstance, no, instance)
+	s.put(KindMysqlUser, mysqlUserID(no, p.get("cloudMysqlUserName")), &mysqlUser{
+		CloudMysqlUser: vmysql.CloudMysqlUser{
+			UserName:            str(p.get("cloudMysqlUserName")),
+			HostIp:              str(p.get("hostIp")),
+			Authority:           str("DDL"),
+			IsSystemTableAccess: ncloudBool(true),
+		},
+		instanceNo: no,
+		password:   p.get("cloudMysqlUserPassword"),
+	})
+
+	return listResult("cloudMysqlInstanceList", []interface{}{instance}), nil
+}
+
+func (s *Server) getCloudMysqlInstanceList(p params) (result, error) {
+	items := s.list(KindMysqlInstance, func(i interface{}) bool {
+		v := i.(*vmysql.CloudMysqlInstance)
+		return p.matches("cloudMysqlInstanceNoList", v.CloudMysqlInstanceNo) &&
+			p.matchesOne("cloudMysqlServiceName", v.CloudMysqlServiceName) &&
+			p.matchesOne("vpcNo", v.CloudMysqlServerInstanceList[0].VpcNo) &&
+			p.matchesOne("subnetNo", v.CloudMysqlServerInstanceList[0].SubnetNo)
+	})
+	return listResult("cloudMysqlInstanceList", items), nil
+}
+
+func (s *Server) getCloudMysqlInstanceDetail(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	v, ok := s.get(KindMysqlInstance, no)
+	if !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+	return listResult("cloudMysqlInstanceList", []interface{}{v}), nil
+}
+
+func (s *Server) deleteCloudMysqlInstance(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	if !s.delete(KindMysqlInstance, no) {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+	for _, v := range s.mysqlUsers(no) {
+		s.delete(KindMysqlUser, mysqlUserID(no, *v.UserName))
+	}
+	return listResult("cloudMysqlInstanceList", nil), nil
+}
+
+// getCloudMysqlBackupDetailList reports a single full backup taken at creation for instances with backups enabled
+func (s *Server) getCloudMysqlBackupDetailList(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	v, ok := s.get(KindMysqlInstance, no)
+	if !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+	instance := v.(*vmysql.CloudMysqlInstance)
+
+	var items []interface{}
+	if *instance.IsBackup {
+		items = append(items, &vmysql.CloudMysqlBackupDetail{
+			FileName:        str(fmt.Sprintf("%s_full_backup.xb", no)),
+			StartTime:       instance.CreateDate,
+			EndTime:         instance.CreateDate,
+			BackupSize:      int64Ptr(1024 * 1024),
+			DataStorageSize: int64Ptr(10 * 1024 * 1024 * 1024),
+		})
+	}
+	return listResult("cloudMysqlBackupDetailList", items), nil
+}
+
+// mysqlUser keeps the password and owning instance of a user next to what the API returns
+type mysqlUser struct {
+	vmysql.CloudMysqlUser
+	instanceNo string
+	password   string
+}
+
+func mysqlUserID(instanceNo, name string) string {
+	return instanceNo + ":" + name
+}
+
+func (s *Server) mysqlUsers(instanceNo string) []*mysqlUser {
+	var users []*mysqlUser
+	for _, v := range s.list(KindMysqlUser, func(i interface{}) bool { return i.(*mysqlUser).instanceNo == instanceNo }) {
+		users = append(users, v.(*mysqlUser))
+	}
+	return users
+}
+
+func (s *Server) getCloudMysqlUserList(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	if _, ok := s.get(KindMysqlInstance, no); !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+
+	// The API returns the newest user first
+	users := s.mysqlUsers(no)
+	var items []interface{}
+	for i := len(users) - 1; i >= 0; i-- {
+		items = append(items, &users[i].CloudMysqlUser)
+	}
+	return listResult("cloudMysqlUserList", items), nil
+}
+
+// mysqlUserParams reads the indexed cloudMysqlUserList.N.* parameters
+func mysqlUserParams(p params) []map[string]string {
+	var out []map[string]string
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("cloudMysqlUserList.%d.", i)
+		if p.get(prefix+"name") == "" {
+			return out
+		}
+		m := map[string]string{}
+		for _, key := range []string{"name", "hostIp", "password", "authority", "isSystemTableAccess"} {
+			m[key] = p.get(prefix + key)
+		}
+		out = append(out, m)
+	}
+}
+
+func (s *Server) addCloudMysqlUserList(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	if _, ok := s.get(KindMysqlInstance, no); !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+
+	users := mysqlUserParams(p)
+	for _, u := range users {
+		if _, ok := s.get(KindMysqlUser, mysqlUserID(no, u["name"])); ok {
+			return nil, &apiError{400, returnCodeDuplicate, fmt.Sprintf("mysql user %s already exists", u["name"])}
+		}
+	}
+	for _, u := range users {
+		s.put(KindMysqlUser, mysqlUserID(no, u["name"]), &mysqlUser{
+			CloudMysqlUser: vmysql.CloudMysqlUser{
+				UserName:            str(u["name"]),
+				HostIp:              str(u["hostIp"]),
+				Authority:           str(u["authority"]),
+				IsSystemTableAccess: ncloudBool(u["isSystemTableAccess"] != "false"),
+			},
+			instanceNo: no,
+			password:   u["password"],
+		})
+	}
+	return result{}, nil
+}
+
+func (s *Server) changeCloudMysqlUserList(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	if _, ok := s.get(KindMysqlInstance, no); !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+
+	users := mysqlUserParams(p)
+	for _, u := range users {
+		if _, ok := s.get(KindMysqlUser, mysqlUserID(no, u["name"])); !ok {
+			return nil, notFound("mysql user %s not found", u["name"])
+		}
+	}
+	for _, u := range users {
+		v, _ := s.get(KindMysqlUser, mysqlUserID(no, u["name"]))
+		user := v.(*mysqlUser)
+		user.HostIp = str(u["hostIp"])
+		user.Authority = str(u["authority"])
+		user.IsSystemTableAccess = ncloudBool(u["isSystemTableAccess"] != "false")
+		user.password = u["password"]
+	}
+	return result{}, nil
+}
+
+func (s *Server) deleteCloudMysqlUserList(p params) (result, error) {
+	no := p.get("cloudMysqlInstanceNo")
+	if _, ok := s.get(KindMysqlInstance, no); !ok {
+		return nil, &apiError{400, returnCodeMysqlNotFound, fmt.Sprintf("mysql instance %s not found", no)}
+	}
+
+	for _, u := range mysqlUserParams(p) {
+		s.delete(KindMysqlUser, mysqlUserID(no, u["name"]))
+	}
+	return result{}, nil
+}
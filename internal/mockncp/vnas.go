@@ -0,0 +1,109 @@
+package mockncp
+
+import (
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+)
+
+func vnasCode(code string) *vnas.CommonCode {
+	return &vnas.CommonCode{Code: str(code), CodeName: str(code)}
+}
+
+// nasVolumeOutput keeps the server list of a volume in responses when it is
+// empty, as the API does. The SDK type drops it.
+type nasVolumeOutput struct {
+	*vnas.NasVolumeInstance
+	NasVolumeServerInstanceNoList []*string `json:"nasVolumeServerInstanceNoList"`
+}
+
+func nasVolumeResult(items []interface{}) result {
+	out := []interface{}{}
+	for _, i := range items {
+		v := i.(*vnas.NasVolumeInstance)
+		out = append(out, &nasVolumeOutput{v, v.NasVolumeServerInstanceNoList})
+	}
+	return listResult("nasVolumeInstanceList", out)
+}
+
+func (s *Server) registerVnas() {
+	h := s.handlers
+
+	h["vnas/createNasVolumeInstance"] = s.createNasVolumeInstance
+	h["vnas/getNasVolumeInstanceList"] = s.getNasVolumeInstanceList
+	h["vnas/getNasVolumeInstanceDetail"] = s.getNasVolumeInstanceDetail
+	h["vnas/deleteNasVolumeInstances"] = s.deleteNasVolumeInstances
+}
+
+func (s *Server) createNasVolumeInstance(p params) (result, error) {
+	for _, key := range []string{"volumeName", "volumeSize", "volumeAllotmentProtocolTypeCode"} {
+		if p.get(key) == "" {
+			return nil, invalidRequest("%s is required", key)
+		}
+	}
+	size, err := strconv.ParseInt(p.get("volumeSize"), 10, 64)
+	if err != nil {
+		return nil, invalidRequest("volumeSize must be a number")
+	}
+
+	// Sizes are requested in GB and returned in bytes
+	no := s.nextID()
+	volume := &vnas.NasVolumeInstance{
+		NasVolumeInstanceNo:           str(no),
+		NasVolumeInstanceStatus:       vnasCode("CREAT"),
+		NasVolumeInstanceOperation:    vnasCode("NULL"),
+		NasVolumeInstanceStatusName:   str("created"),
+		CreateDate:                    now(),
+		NasVolumeDescription:          str(p.get("nasVolumeDescription")),
+		MountInformation:              str("169.254.0.1:/n000000_" + p.get("volumeName")),
+		VolumeAllotmentProtocolType:   vnasCode(p.get("volumeAllotmentProtocolTypeCode")),
+		VolumeName:                    str("n000000_" + p.get("volumeName")),
+		VolumeTotalSize:               int64Ptr(size * gigabyte),
+		VolumeSize:                    int64Ptr(size * gigabyte),
+		SnapshotVolumeSize:            int64Ptr(0),
+		IsSnapshotConfiguration:       ncloudBool(false),
+		IsEventConfiguration:          ncloudBool(false),
+		RegionCode:                    str(s.RegionCode),
+		ZoneCode:                      str(p.getOr("zoneCode", s.RegionCode+"-1")),
+		NasVolumeServerInstanceNoList: []*string{},
+		IsEncryptedVolume:             p.bool("isEncryptedVolume"),
+		IsReturnProtection:            p.bool("isReturnProtection"),
+	}
+	s.put(KindNasVolume, no, volume)
+
+	return nasVolumeResult([]interface{}{volume}), nil
+}
+
+func (s *Server) getNasVolumeInstanceList(p params) (result, error) {
+	items := s.list(KindNasVolume, func(i interface{}) bool {
+		v := i.(*vnas.NasVolumeInstance)
+		return p.matches("nasVolumeInstanceNoList", v.NasVolumeInstanceNo) &&
+			p.matchesOne("volumeName", v.VolumeName)
+	})
+	return nasVolumeResult(items), nil
+}
+
+// getNasVolumeInstanceDetail returns an empty list for a missing volume, as the API does
+func (s *Server) getNasVolumeInstanceDetail(p params) (result, error) {
+	var items []interface{}
+	if v, ok := s.get(KindNasVolume, p.get("nasVolumeInstanceNo")); ok {
+		items = append(items, v)
+	}
+	return nasVolumeResult(items), nil
+}
+
+func (s *Server) deleteNasVolumeInstances(p params) (result, error) {
+	var deleted []interface{}
+	for _, no := range p.list("nasVolumeInstanceNoList") {
+		v, ok := s.get(KindNasVolume, no)
+		if !ok {
+			return nil, notFound("nas volume %s not found", no)
+		}
+		if *v.(*vnas.NasVolumeInstance).IsReturnProtection {
+			return nil, invalidRequest("nas volume %s is protected from return", no)
+		}
+		s.delete(KindNasVolume, no)
+		deleted = append(deleted, v)
+	}
+	return nasVolumeResult(deleted), nil
+}
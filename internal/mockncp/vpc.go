@@ -0,0 +1,304 @@
+package mockncp
+
+import (
+	"net"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func vpcCode(code string) *vpc.CommonCode {
+	return &vpc.CommonCode{Code: str(code), CodeName: str(code)}
+}
+
+func (s *Server) registerVpc() {
+	h := s.handlers
+
+	h["vpc/createVpc"] = s.createVpc
+	h["vpc/getVpcList"] = s.getVpcList
+	h["vpc/getVpcDetail"] = s.getVpcList
+	h["vpc/deleteVpc"] = s.deleteVpc
+
+	h["vpc/createSubnet"] = s.createSubnet
+	h["vpc/getSubnetList"] = s.getSubnetList
+	h["vpc/getSubnetDetail"] = s.getSubnetList
+	h["vpc/setSubnetNetworkAcl"] = s.setSubnetNetworkAcl
+	h["vpc/deleteSubnet"] = s.deleteSubnet
+
+	h["vpc/createNetworkAcl"] = s.createNetworkAcl
+	h["vpc/getNetworkAclList"] = s.getNetworkAclList
+	h["vpc/getNetworkAclDetail"] = s.getNetworkAclList
+	h["vpc/setNetworkAclDescription"] = s.setNetworkAclDescription
+	h["vpc/deleteNetworkAcl"] = s.deleteNetworkAcl
+	h["vpc/getNetworkAclRuleList"] = func(p params) (result, error) {
+		return listResult("networkAclRuleList", nil), nil
+	}
+
+	h["vpc/getRouteTableList"] = s.getRouteTableList
+	h["vpc/getRouteTableDetail"] = s.getRouteTableList
+	h["vpc/getRouteList"] = func(p params) (result, error) {
+		return listResult("routeList", nil), nil
+	}
+}
+
+func (s *Server) createVpc(p params) (result, error) {
+	cidr := p.get("ipv4CidrBlock")
+	if cidr == "" {
+		return nil, invalidRequest("ipv4CidrBlock is required")
+	}
+
+	no := s.nextID()
+	name := p.getOr("vpcName", "vpc-"+no)
+	v := &vpc.Vpc{
+		VpcNo:         str(no),
+		VpcName:       str(name),
+		Ipv4CidrBlock: str(cidr),
+		VpcStatus:     vpcCode("RUN"),
+		RegionCode:    str(p.getOr("regionCode", s.RegionCode)),
+		CreateDate:    now(),
+	}
+	s.put(KindVpc, no, v)
+
+	// Defaults created along with every VPC
+	aclNo := s.nextID()
+	s.put(KindNetworkAcl, aclNo, &vpc.NetworkAcl{
+		NetworkAclNo:          str(aclNo),
+		NetworkAclName:        str(name + "-default-network-acl"),
+		NetworkAclDescription: str(""),
+		VpcNo:                 str(no),
+		NetworkAclStatus:      vpcCode("RUN"),
+		CreateDate:            now(),
+		IsDefault:             ncloudBool(true),
+	})
+
+	acgNo := s.nextID()
+	s.put(KindAccessControlGroup, acgNo, &vserver.AccessControlGroup{
+		AccessControlGroupNo:          str(acgNo),
+		AccessControlGroupName:        str(name + "-default-acg"),
+		IsDefault:                     ncloudBool(true),
+		VpcNo:                         str(no),
+		AccessControlGroupStatus:      vserverCode("RUN"),
+		AccessControlGroupDescription: str(""),
+	})
+
+	for _, subnetType := range []string{"PUBLIC", "PRIVATE"} {
+		rtNo := s.nextID()
+		s.put(KindRouteTable, rtNo, &vpc.RouteTable{
+			RouteTableNo:          str(rtNo),
+			RouteTableName:        str(name + "-default-" + subnetType + "-table"),
+			RouteTableDescription: str(""),
+			RegionCode:            str(s.RegionCode),
+			VpcNo:                 str(no),
+			SupportedSubnetType:   vpcCode(subnetType),
+			IsDefault:             ncloudBool(true),
+			RouteTableStatus:      vpcCode("RUN"),
+		})
+	}
+
+	return listResult("vpcList", []interface{}{v}), nil
+}
+
+func (s *Server) getVpcList(p params) (result, error) {
+	no := p.get("vpcNo")
+	items := s.list(KindVpc, func(i interface{}) bool {
+		v := i.(*vpc.Vpc)
+		return (no == "" || *v.VpcNo == no) &&
+			p.matches("vpcNoList", v.VpcNo) &&
+			p.matchesOne("vpcName", v.VpcName) &&
+			p.matchesOne("vpcStatusCode", v.VpcStatus.Code) &&
+			p.matchesOne("regionCode", v.RegionCode)
+	})
+	return listResult("vpcList", items), nil
+}
+
+func (s *Server) deleteVpc(p params) (result, error) {
+	no := p.get("vpcNo")
+	v, ok := s.get(KindVpc, no)
+	if !ok {
+		return nil, notFound("vpc %s not found", no)
+	}
+
+	inVpc := func(vpcNo *string) bool {
+		return vpcNo != nil && *vpcNo == no
+	}
+
+	if len(s.list(KindSubnet, func(i interface{}) bool { return inVpc(i.(*vpc.Subnet).VpcNo) })) > 0 {
+		return nil, invalidRequest("vpc %s still has subnets", no)
+	}
+
+	for _, i := range s.list(KindNetworkAcl, func(i interface{}) bool { return inVpc(i.(*vpc.NetworkAcl).VpcNo) }) {
+		s.delete(KindNetworkAcl, *i.(*vpc.NetworkAcl).NetworkAclNo)
+	}
+	for _, i := range s.list(KindRouteTable, func(i interface{}) bool { return inVpc(i.(*vpc.RouteTable).VpcNo) }) {
+		s.delete(KindRouteTable, *i.(*vpc.RouteTable).RouteTableNo)
+	}
+	for _, i := range s.list(KindAccessControlGroup, func(i interface{}) bool { return inVpc(i.(*vserver.AccessControlGroup).VpcNo) }) {
+		s.delete(KindAccessControlGroup, *i.(*vserver.AccessControlGroup).AccessControlGroupNo)
+	}
+	s.delete(KindVpc, no)
+
+	return listResult("vpcList", []interface{}{v}), nil
+}
+
+func (s *Server) createSubnet(p params) (result, error) {
+	vpcNo := p.get("vpcNo")
+	v, ok := s.get(KindVpc, vpcNo)
+	if !ok {
+		return nil, notFound("vpc %s not found", vpcNo)
+	}
+	if !cidrContains(*v.(*vpc.Vpc).Ipv4CidrBlock, p.get("subnet")) {
+		return nil, invalidRequest("The subnet must belong to the IPv4 CIDR of the specified VPC.")
+	}
+	aclNo := p.get("networkAclNo")
+	if _, ok := s.get(KindNetworkAcl, aclNo); !ok {
+		return nil, notFound("network acl %s not found", aclNo)
+	}
+
+	no := s.nextID()
+	subnet := &vpc.Subnet{
+		SubnetNo:     str(no),
+		VpcNo:        str(vpcNo),
+		ZoneCode:     str(p.getOr("zoneCode", s.RegionCode+"-1")),
+		SubnetName:   str(p.getOr("subnetName", "subnet-"+no)),
+		Subnet:       str(p.get("subnet")),
+		SubnetStatus: vpcCode("RUN"),
+		CreateDate:   now(),
+		SubnetType:   vpcCode(p.getOr("subnetTypeCode", "PRIVATE")),
+		UsageType:    vpcCode(p.getOr("usageTypeCode", "GEN")),
+		NetworkAclNo: str(aclNo),
+	}
+	s.put(KindSubnet, no, subnet)
+
+	return listResult("subnetList", []interface{}{subnet}), nil
+}
+
+func (s *Server) getSubnetList(p params) (result, error) {
+	no := p.get("subnetNo")
+	items := s.list(KindSubnet, func(i interface{}) bool {
+		v := i.(*vpc.Subnet)
+		return (no == "" || *v.SubnetNo == no) &&
+			p.matches("subnetNoList", v.SubnetNo) &&
+			p.matchesOne("vpcNo", v.VpcNo) &&
+			p.matchesOne("subnetName", v.SubnetName) &&
+			p.matchesOne("subnet", v.Subnet) &&
+			p.matchesOne("zoneCode", v.ZoneCode) &&
+			p.matchesOne("networkAclNo", v.NetworkAclNo) &&
+			p.matchesOne("subnetTypeCode", v.SubnetType.Code) &&
+			p.matchesOne("usageTypeCode", v.UsageType.Code)
+	})
+	return listResult("subnetList", items), nil
+}
+
+func (s *Server) setSubnetNetworkAcl(p params) (result, error) {
+	no := p.get("subnetNo")
+	v, ok := s.get(KindSubnet, no)
+	if !ok {
+		return nil, notFound("subnet %s not found", no)
+	}
+	aclNo := p.get("networkAclNo")
+	if _, ok := s.get(KindNetworkAcl, aclNo); !ok {
+		return nil, notFound("network acl %s not found", aclNo)
+	}
+
+	v.(*vpc.Subnet).NetworkAclNo = str(aclNo)
+
+	return listResult("subnetList", []interface{}{v}), nil
+}
+
+func (s *Server) deleteSubnet(p params) (result, error) {
+	no := p.get("subnetNo")
+	v, ok := s.get(KindSubnet, no)
+	if !ok {
+		return nil, notFound("subnet %s not found", no)
+	}
+	s.delete(KindSubnet, no)
+
+	return listResult("subnetList", []interface{}{v}), nil
+}
+
+func (s *Server) createNetworkAcl(p params) (result, error) {
+	vpcNo := p.get("vpcNo")
+	if v, ok := s.get(KindVpc, vpcNo); !ok || !p.matchesOne("regionCode", v.(*vpc.Vpc).RegionCode) {
+		return nil, notFound("vpc %s not found", vpcNo)
+	}
+
+	no := s.nextID()
+	acl := &vpc.NetworkAcl{
+		NetworkAclNo:          str(no),
+		NetworkAclName:        str(p.getOr("networkAclName", "nacl"+no)),
+		VpcNo:                 str(vpcNo),
+		NetworkAclStatus:      vpcCode("RUN"),
+		NetworkAclDescription: str(p.get("networkAclDescription")),
+		CreateDate:            now(),
+		IsDefault:             ncloudBool(false),
+	}
+	s.put(KindNetworkAcl, no, acl)
+
+	return listResult("networkAclList", []interface{}{acl}), nil
+}
+
+func (s *Server) getNetworkAclList(p params) (result, error) {
+	no := p.get("networkAclNo")
+	items := s.list(KindNetworkAcl, func(i interface{}) bool {
+		v := i.(*vpc.NetworkAcl)
+		return (no == "" || *v.NetworkAclNo == no) &&
+			p.matches("networkAclNoList", v.NetworkAclNo) &&
+			p.matchesOne("vpcNo", v.VpcNo) &&
+			p.matchesOne("networkAclName", v.NetworkAclName) &&
+			p.matchesOne("networkAclStatusCode", v.NetworkAclStatus.Code)
+	})
+	return listResult("networkAclList", items), nil
+}
+
+func (s *Server) setNetworkAclDescription(p params) (result, error) {
+	no := p.get("networkAclNo")
+	v, ok := s.get(KindNetworkAcl, no)
+	if !ok {
+		return nil, notFound("network acl %s not found", no)
+	}
+	v.(*vpc.NetworkAcl).NetworkAclDescription = str(p.get("networkAclDescription"))
+
+	return listResult("networkAclList", []interface{}{v}), nil
+}
+
+func (s *Server) deleteNetworkAcl(p params) (result, error) {
+	no := p.get("networkAclNo")
+	v, ok := s.get(KindNetworkAcl, no)
+	if !ok {
+		return nil, notFound("network acl %s not found", no)
+	}
+	if *v.(*vpc.NetworkAcl).IsDefault {
+		return nil, invalidRequest("default network acl %s can't be deleted", no)
+	}
+	s.delete(KindNetworkAcl, no)
+
+	return listResult("networkAclList", []interface{}{v}), nil
+}
+
+func (s *Server) getRouteTableList(p params) (result, error) {
+	no := p.get("routeTableNo")
+	items := s.list(KindRouteTable, func(i interface{}) bool {
+		v := i.(*vpc.RouteTable)
+		return (no == "" || *v.RouteTableNo == no) &&
+			p.matches("routeTableNoList", v.RouteTableNo) &&
+			p.matchesOne("vpcNo", v.VpcNo) &&
+			p.matchesOne("routeTableName", v.RouteTableName) &&
+			p.matchesOne("supportedSubnetTypeCode", v.SupportedSubnetType.Code)
+	})
+	return listResult("routeTableList", items), nil
+}
+
+// cidrContains reports whether the subnet lies within the block
+func cidrContains(block, subnet string) bool {
+	_, b, err := net.ParseCIDR(block)
+	if err != nil {
+		return false
+	}
+	ip, sn, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return false
+	}
+	bOnes, _ := b.Mask.Size()
+	sOnes, _ := sn.Mask.Size()
+	return b.Contains(ip) && sOnes >= bOnes
+}
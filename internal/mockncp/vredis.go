@@ -0,0 +1,66 @@
+package mockncp
+
+import (
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+)
+
+func (s *Server) registerVredis() {
+	h := s.handlers
+
+	h["vredis/createCloudRedisConfigGroup"] = s.createCloudRedisConfigGroup
+	h["vredis/getCloudRedisConfigGroupList"] = s.getCloudRedisConfigGroupList
+	h["vredis/deleteCloudRedisConfigGroup"] = s.deleteCloudRedisConfigGroup
+	h["vredis/getCloudRedisInstanceList"] = s.getCloudRedisInstanceList
+}
+
+func (s *Server) createCloudRedisConfigGroup(p params) (result, error) {
+	name := p.get("configGroupName")
+	if name == "" {
+		return nil, invalidRequest("configGroupName is required")
+	}
+	for _, i := range s.list(KindRedisConfigGroup, nil) {
+		if *i.(*vredis.CloudRedisConfigGroup).ConfigGroupName == name {
+			return nil, &apiError{400, returnCodeDuplicate, "config group " + name + " already exists"}
+		}
+	}
+
+	no := s.nextID()
+	s.put(KindRedisConfigGroup, no, &vredis.CloudRedisConfigGroup{
+		ConfigGroupNo:          str(no),
+		ConfigGroupName:        str(name),
+		ConfigGroupDescription: str(p.get("configGroupDescription")),
+		ConfigGroupStatusName:  str("running"),
+		ConfigGroupStatus:      &vredis.CommonCode{Code: str("RUN"), CodeName: str("running")},
+		CloudRedisVersion:      str(p.get("cloudRedisVersion")),
+		CreateDate:             now(),
+	})
+
+	// Like the real API, the response holds every config group
+	return listResult("cloudRedisConfigGroupList", s.list(KindRedisConfigGroup, nil)), nil
+}
+
+func (s *Server) getCloudRedisConfigGroupList(p params) (result, error) {
+	name := p.get("configGroupName")
+	items := s.list(KindRedisConfigGroup, func(i interface{}) bool {
+		v := i.(*vredis.CloudRedisConfigGroup)
+		// Names are matched by prefix
+		return p.matchesOne("configGroupNo", v.ConfigGroupNo) &&
+			strings.HasPrefix(*v.ConfigGroupName, name)
+	})
+	return listResult("cloudRedisConfigGroupList", items), nil
+}
+
+func (s *Server) deleteCloudRedisConfigGroup(p params) (result, error) {
+	no := p.get("configGroupNo")
+	if !s.delete(KindRedisConfigGroup, no) {
+		return nil, notFound("config group %s not found", no)
+	}
+	return listResult("cloudRedisConfigGroupList", s.list(KindRedisConfigGroup, nil)), nil
+}
+
+// getCloudRedisInstanceList always returns an empty list, since Redis instances are not implemented
+func (s *Server) getCloudRedisInstanceList(p params) (result, error) {
+	return listResult("cloudRedisInstanceList", []interface{}{}), nil
+}
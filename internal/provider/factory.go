@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
@@ -29,7 +28,7 @@ func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.Provide
 		func() tfprotov6.ProviderServer {
 			return upgradedSdkProvider
 		},
-		providerserver.NewProtocol6(fwprovider.New(primary)),
+		fwprovider.NewProtocol6(primary),
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, servers...)
@@ -0,0 +1,52 @@
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// legacyTypeSystemResources were migrated from SDKv2 with blocks that SDKv2
+// declared Optional and Computed. The framework can't declare such blocks, so
+// these resources plan the prior blocks when the config has none and read
+// every block back from the API, which Terraform only accepts from providers
+// using the legacy type system.
+var legacyTypeSystemResources = map[string]bool{
+	"ncloud_server": true,
+}
+
+type providerServer interface {
+	tfprotov6.ProviderServerWithListResource
+	tfprotov6.ProviderServerWithActions
+}
+
+// NewProtocol6 serves the framework provider like providerserver.NewProtocol6,
+// marking the plans and applies of legacyTypeSystemResources.
+func NewProtocol6(primary interface{ Meta() interface{} }) func() tfprotov6.ProviderServer {
+	f := providerserver.NewProtocol6(New(primary))
+
+	return func() tfprotov6.ProviderServer {
+		return &legacyTypeSystemServer{f().(providerServer)}
+	}
+}
+
+type legacyTypeSystemServer struct {
+	providerServer
+}
+
+func (s *legacyTypeSystemServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.providerServer.PlanResourceChange(ctx, req)
+	if resp != nil && legacyTypeSystemResources[req.TypeName] {
+		resp.UnsafeToUseLegacyTypeSystem = true
+	}
+	return resp, err
+}
+
+func (s *legacyTypeSystemServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.providerServer.ApplyResourceChange(ctx, req)
+	if resp != nil && legacyTypeSystemResources[req.TypeName] {
+		resp.UnsafeToUseLegacyTypeSystem = true
+	}
+	return resp, err
+}
@@ -0,0 +1,34 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestLegacyTypeSystemServer(t *testing.T) {
+	ctx := context.Background()
+	server := NewProtocol6(nil)()
+
+	for typeName, legacy := range map[string]bool{
+		"ncloud_server": true,
+		"ncloud_vpc":    false,
+	} {
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{TypeName: typeName})
+		if err != nil {
+			t.Fatalf("%s: planning: %s", typeName, err)
+		}
+		if planResp.UnsafeToUseLegacyTypeSystem != legacy {
+			t.Errorf("%s: expected plan legacy type system %t", typeName, legacy)
+		}
+
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{TypeName: typeName})
+		if err != nil {
+			t.Fatalf("%s: applying: %s", typeName, err)
+		}
+		if applyResp.UnsafeToUseLegacyTypeSystem != legacy {
+			t.Errorf("%s: expected apply legacy type system %t", typeName, legacy)
+		}
+	}
+}
@@ -3,26 +3,41 @@ package fwprovider
 import (
 	"context"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/cdss"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/objectstorage"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/functions"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mssql"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/ses"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 )
 
+var (
+	_ provider.ProviderWithFunctions          = &fwprovider{}
+	_ provider.ProviderWithEphemeralResources = &fwprovider{}
+	_ provider.ProviderWithListResources      = &fwprovider{}
+	_ provider.ProviderWithActions            = &fwprovider{}
+)
+
 func New(primary interface{ Meta() interface{} }) provider.Provider {
 	return &fwprovider{
 		Primary: primary,
@@ -69,6 +84,9 @@ func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureReques
 
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.EphemeralResourceData = providerConfig
+	resp.ListResourceData = providerConfig
+	resp.ActionData = providerConfig
 }
 
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -90,10 +108,12 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, mysql.NewMysqlProductsDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlUsersDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlDatabasesDataSource)
+	dataSources = append(dataSources, mysql.NewMysqlBackupsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbProductsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbImageProductsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbUsersDataSource)
+	dataSources = append(dataSources, mongodb.NewMongoDbBackupsDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopAddOnDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopBucketDataSource)
@@ -103,14 +123,17 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, redis.NewRedisDataSource)
 	dataSources = append(dataSources, redis.NewRedisImageProductsDataSource)
 	dataSources = append(dataSources, redis.NewRedisProductsDataSource)
+	dataSources = append(dataSources, redis.NewRedisBackupsDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlImageProductsDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlProductsDataSource)
+	dataSources = append(dataSources, mssql.NewMssqlBackupsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlProductsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlImageProductsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlDatabasesDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlUsersDataSource)
+	dataSources = append(dataSources, postgresql.NewPostgresqlBackupsDataSource)
 	dataSources = append(dataSources, loadbalancer.NewLoadBalancerDataSource)
 	dataSources = append(dataSources, objectstorage.NewBucketDataSource)
 	dataSources = append(dataSources, objectstorage.NewObjectDataSource)
@@ -134,6 +157,8 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, vpc.NewVpcPeeringResource)
 	resources = append(resources, server.NewLoginKeyResource)
 	resources = append(resources, server.NewInitScriptResource)
+	resources = append(resources, server.NewServerResource)
+	resources = append(resources, server.NewBlockStorageResource)
 	resources = append(resources, mysql.NewMysqlResource)
 	resources = append(resources, mysql.NewMysqlUsersResource)
 	resources = append(resources, mysql.NewMysqlRecoveryResource)
@@ -141,7 +166,12 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, mysql.NewMysqlSlaveResource)
 	resources = append(resources, mongodb.NewMongoDbResource)
 	resources = append(resources, mongodb.NewMongoDbUsersResource)
+	resources = append(resources, mongodb.NewMongoDbUserResource)
 	resources = append(resources, hadoop.NewHadoopResource)
+	resources = append(resources, cdss.NewCDSSTopicResource)
+	resources = append(resources, cdss.NewCDSSACLResource)
+	resources = append(resources, ses.NewSESSnapshotRepositoryResource)
+	resources = append(resources, ses.NewSESISMPolicyResource)
 	resources = append(resources, redis.NewRedisConfigGroupResource)
 	resources = append(resources, redis.NewRedisResource)
 	resources = append(resources, mssql.NewMssqlResource)
@@ -149,6 +179,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, postgresql.NewPostgresqlReadReplicaResource)
 	resources = append(resources, postgresql.NewPostgresqlDatabasesResource)
 	resources = append(resources, postgresql.NewPostgresqlUsersResource)
+	resources = append(resources, postgresql.NewPostgresqlUserResource)
 	resources = append(resources, loadbalancer.NewLbResource)
 	resources = append(resources, objectstorage.NewBucketResource)
 	resources = append(resources, objectstorage.NewObjectResource)
@@ -162,5 +193,49 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		})
 	}
 
+	for i, f := range resources {
+		resources[i] = withRegion(f)
+	}
+
 	return resources
 }
+
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		server.NewRootPasswordEphemeralResource,
+		nks.NewNKSKubeConfigEphemeralResource,
+		mysql.NewMysqlConnectionInfoEphemeralResource,
+		postgresql.NewPostgresqlConnectionInfoEphemeralResource,
+		mssql.NewMssqlConnectionInfoEphemeralResource,
+		mongodb.NewMongoDbConnectionInfoEphemeralResource,
+		redis.NewRedisConnectionInfoEphemeralResource,
+	}
+}
+
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	listResources := []func() list.ListResource{
+		vpc.NewVpcListResource,
+		vpc.NewSubnetListResource,
+		server.NewServerListResource,
+	}
+
+	for i, f := range listResources {
+		listResources[i] = withRegionList(f)
+	}
+
+	return listResources
+}
+
+func (p *fwprovider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		server.NewServerRebootAction,
+	}
+}
+
+func (p *fwprovider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseIDFunction,
+		functions.NewProductCodeSpecFunction,
+		functions.NewSubnetForZoneFunction,
+	}
+}
@@ -0,0 +1,678 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ resource.Resource                     = &regionalResource{}
+	_ resource.ResourceWithConfigure        = &regionalResource{}
+	_ resource.ResourceWithImportState      = &regionalResource{}
+	_ resource.ResourceWithModifyPlan       = &regionalResource{}
+	_ resource.ResourceWithValidateConfig   = &regionalResource{}
+	_ resource.ResourceWithConfigValidators = &regionalResource{}
+	_ resource.ResourceWithUpgradeState     = &regionalResource{}
+	_ resource.ResourceWithIdentity         = &regionalResourceWithIdentity{}
+)
+
+// withRegion adds the "region" argument to the resources built by f. The
+// wrapped resource never sees the argument: it is removed from the values
+// passed in and added back to the state returned, and the resource is
+// configured with the provider config of that region before each call.
+func withRegion(f func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		r := &regionalResource{inner: f()}
+		if _, ok := r.inner.(resource.ResourceWithIdentity); ok {
+			return &regionalResourceWithIdentity{r}
+		}
+		return r
+	}
+}
+
+type regionalResource struct {
+	inner  resource.Resource
+	config *conn.ProviderConfig
+}
+
+// regionalResourceWithIdentity adds "region" to the identity of a wrapped
+// resource that has one, so that resources are imported from the region they
+// were listed in.
+type regionalResourceWithIdentity struct {
+	*regionalResource
+}
+
+func (r *regionalResourceWithIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	r.inner.(resource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+
+	attributes := make(map[string]identityschema.Attribute, len(resp.IdentitySchema.Attributes)+1)
+	for k, v := range resp.IdentitySchema.Attributes {
+		attributes[k] = v
+	}
+	attributes["region"] = identityschema.StringAttribute{
+		OptionalForImport: true,
+		Description:       "Region code of the resource. Defaults to the region of the provider.",
+	}
+	resp.IdentitySchema.Attributes = attributes
+}
+
+func (r *regionalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, req, resp)
+}
+
+func (r *regionalResource) innerSchema(ctx context.Context) (schema.Schema, diag.Diagnostics) {
+	var resp resource.SchemaResponse
+	r.inner.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema, resp.Diagnostics
+}
+
+func (r *regionalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Schema = withRegionAttribute(s)
+}
+
+// withRegionAttribute returns a copy of s with the "region" attribute added.
+func withRegionAttribute(s schema.Schema) schema.Schema {
+	attributes := make(map[string]schema.Attribute, len(s.Attributes)+1)
+	for k, v := range s.Attributes {
+		attributes[k] = v
+	}
+	attributes["region"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIf(func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				// states written before the argument existed have no region yet
+				resp.RequiresReplace = !req.StateValue.IsNull()
+			}, "", ""),
+		},
+		Description: "Region code of the resource. Defaults to the region of the provider. Changing it creates the resource in the new region.",
+	}
+	s.Attributes = attributes
+
+	return s
+}
+
+func (r *regionalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*conn.ProviderConfig); ok {
+		r.config = config
+	}
+
+	if c, ok := r.inner.(resource.ResourceWithConfigure); ok {
+		c.Configure(ctx, req, resp)
+	}
+}
+
+// configureRegion configures the wrapped resource for region, or for the
+// provider region when region is null or unknown.
+func (r *regionalResource) configureRegion(ctx context.Context, region tftypes.Value) (*conn.ProviderConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var code string
+	if region.IsKnown() && !region.IsNull() {
+		if err := region.As(&code); err != nil {
+			diags.AddError("Invalid region", err.Error())
+			return nil, diags
+		}
+	}
+
+	if r.config == nil {
+		return nil, diags
+	}
+
+	config, err := r.config.ForRegion(code)
+	if err != nil {
+		diags.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return nil, diags
+	}
+
+	if c, ok := r.inner.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		c.Configure(ctx, resource.ConfigureRequest{ProviderData: config}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+
+	return config, diags
+}
+
+func (r *regionalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	config, diags := splitRegion(ctx, s, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	plan, diags := splitRegion(ctx, s, req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerConfig, diags := r.configureRegion(ctx, plan.region)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerReq := resource.CreateRequest{
+		Config:       tfsdk.Config{Schema: s, Raw: config.value},
+		Plan:         tfsdk.Plan{Schema: s, Raw: plan.value},
+		ProviderMeta: req.ProviderMeta,
+	}
+	innerResp := resource.CreateResponse{
+		State:   tfsdk.State{Schema: s, Raw: tftypes.NewValue(plan.value.Type(), nil)},
+		Private: resp.Private,
+	}
+	innerResp.Identity, diags = r.innerIdentity(ctx, resp.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.inner.Create(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw, diags = joinRegion(ctx, resp.State.Schema, innerResp.State.Raw, providerConfig)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(joinIdentity(ctx, resp.Identity, innerResp.Identity, resp.State.Raw)...)
+}
+
+func (r *regionalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	state, diags := splitRegion(ctx, s, req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerConfig, diags := r.configureRegion(ctx, state.region)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerReq := resource.ReadRequest{
+		State:              tfsdk.State{Schema: s, Raw: state.value},
+		Private:            req.Private,
+		ProviderMeta:       req.ProviderMeta,
+		ClientCapabilities: req.ClientCapabilities,
+	}
+	innerResp := resource.ReadResponse{
+		State:   tfsdk.State{Schema: s, Raw: state.value.Copy()},
+		Private: resp.Private,
+	}
+	innerReq.Identity, diags = r.innerIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	innerResp.Identity, diags = r.innerIdentity(ctx, resp.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.inner.Read(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	resp.State.Raw, diags = joinRegion(ctx, resp.State.Schema, innerResp.State.Raw, providerConfig)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(joinIdentity(ctx, resp.Identity, innerResp.Identity, resp.State.Raw)...)
+}
+
+func (r *regionalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	config, diags := splitRegion(ctx, s, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	plan, diags := splitRegion(ctx, s, req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	state, diags := splitRegion(ctx, s, req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerConfig, diags := r.configureRegion(ctx, plan.region)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerReq := resource.UpdateRequest{
+		Config:       tfsdk.Config{Schema: s, Raw: config.value},
+		Plan:         tfsdk.Plan{Schema: s, Raw: plan.value},
+		State:        tfsdk.State{Schema: s, Raw: state.value},
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerResp := resource.UpdateResponse{
+		State:   tfsdk.State{Schema: s, Raw: plan.value.Copy()},
+		Private: resp.Private,
+	}
+	innerReq.Identity, diags = r.innerIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	innerResp.Identity, diags = r.innerIdentity(ctx, resp.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.inner.Update(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw, diags = joinRegion(ctx, resp.State.Schema, innerResp.State.Raw, providerConfig)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(joinIdentity(ctx, resp.Identity, innerResp.Identity, resp.State.Raw)...)
+}
+
+func (r *regionalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	state, diags := splitRegion(ctx, s, req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerConfig, diags := r.configureRegion(ctx, state.region)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	innerReq := resource.DeleteRequest{
+		State:        tfsdk.State{Schema: s, Raw: state.value},
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerReq.Identity, diags = r.innerIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	innerResp := resource.DeleteResponse{
+		State:   tfsdk.State{Schema: s, Raw: state.value.Copy()},
+		Private: resp.Private,
+	}
+	r.inner.Delete(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw, diags = joinRegion(ctx, resp.State.Schema, innerResp.State.Raw, providerConfig)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports from the region of an import ID of the form
+// "<id>@<region>" or of the "region" of an identity, or from the region of
+// the provider. The following read records the provider region in state when
+// neither has one.
+func (r *regionalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i, ok := r.inner.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, region := conn.SplitImportRegion(req.ID)
+	innerReq := req
+	innerReq.ID = id
+	innerReq.Identity, diags = r.innerIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.ID == "" && innerReq.Identity != nil {
+		identity, diags := splitRegion(ctx, innerReq.Identity.Schema, req.Identity.Raw)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if identity.region.IsKnown() && !identity.region.IsNull() {
+			if err := identity.region.As(&region); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+				return
+			}
+		}
+	}
+
+	var providerConfig *conn.ProviderConfig
+	if region != "" {
+		providerConfig, diags = r.configureRegion(ctx, tftypes.NewValue(tftypes.String, region))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	innerResp := resource.ImportStateResponse{
+		State:   tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Private: resp.Private,
+	}
+	innerResp.Identity, diags = r.innerIdentity(ctx, resp.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	i.ImportState(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	resp.State.Raw, diags = joinRegion(ctx, resp.State.Schema, innerResp.State.Raw, providerConfig)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(joinIdentity(ctx, resp.Identity, innerResp.Identity, resp.State.Raw)...)
+}
+
+// ModifyPlan plans the provider region for new resources whose configuration
+// does not set "region", then lets the wrapped resource modify the rest of the plan.
+func (r *regionalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	config, diags := splitRegion(ctx, s, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	plan, diags := splitRegion(ctx, s, req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	state, diags := splitRegion(ctx, s, req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := plan.region
+	if req.Plan.Raw.IsNull() {
+		region = state.region
+	}
+	providerConfig, diags := r.configureRegion(ctx, region)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := plan.value
+	if m, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+		innerReq := resource.ModifyPlanRequest{
+			Config:             tfsdk.Config{Schema: s, Raw: config.value},
+			State:              tfsdk.State{Schema: s, Raw: state.value},
+			Plan:               tfsdk.Plan{Schema: s, Raw: plan.value},
+			ProviderMeta:       req.ProviderMeta,
+			Private:            req.Private,
+			ClientCapabilities: req.ClientCapabilities,
+		}
+		innerResp := resource.ModifyPlanResponse{
+			Plan:            tfsdk.Plan{Schema: s, Raw: plan.value.Copy()},
+			RequiresReplace: resp.RequiresReplace,
+			Private:         resp.Private,
+		}
+		m.ModifyPlan(ctx, innerReq, &innerResp)
+
+		resp.Diagnostics.Append(innerResp.Diagnostics...)
+		resp.RequiresReplace = innerResp.RequiresReplace
+		resp.Private = innerResp.Private
+		resp.Deferred = innerResp.Deferred
+		planned = innerResp.Plan.Raw
+	}
+
+	if planned.IsNull() || providerConfig == nil {
+		resp.Plan.Raw, diags = joinRegionValue(ctx, resp.Plan.Schema, planned, plan.region)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// known resources keep the region in state; new ones get the provider region
+	// unless the configuration sets one, possibly to a value only known after apply
+	if !region.IsKnown() && config.region.IsNull() {
+		region = tftypes.NewValue(tftypes.String, providerConfig.RegionCode)
+	}
+	resp.Plan.Raw, diags = joinRegionValue(ctx, resp.Plan.Schema, planned, region)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *regionalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	v, ok := r.inner.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+	config, diags := splitRegion(ctx, s, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: config.value}}, resp)
+}
+
+func (r *regionalResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if v, ok := r.inner.(resource.ResourceWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+// UpgradeState wraps the state upgraders of the wrapped resource so that
+// they see the prior state without "region". The region of the prior state
+// is carried over, which requires the upgraders to declare a PriorSchema.
+func (r *regionalResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	u, ok := r.inner.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	s, diags := r.innerSchema(ctx)
+	upgraders := u.UpgradeState(ctx)
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+	for version, upgrader := range upgraders {
+		wrapped[version] = regionalStateUpgrader(s, diags, upgrader)
+	}
+	return wrapped
+}
+
+func regionalStateUpgrader(s schema.Schema, schemaDiags diag.Diagnostics, upgrader resource.StateUpgrader) resource.StateUpgrader {
+	if upgrader.PriorSchema == nil {
+		return resource.StateUpgrader{
+			StateUpgrader: func(_ context.Context, _ resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The state upgrader of a regional resource must declare a PriorSchema. Please report this to the provider developers.",
+				)
+			},
+		}
+	}
+
+	priorSchema := *upgrader.PriorSchema
+	regionalPriorSchema := withRegionAttribute(priorSchema)
+
+	return resource.StateUpgrader{
+		PriorSchema: &regionalPriorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			resp.Diagnostics.Append(schemaDiags...)
+			if resp.Diagnostics.HasError() || req.State == nil {
+				return
+			}
+
+			prior, diags := splitRegion(ctx, priorSchema, req.State.Raw)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			innerReq := resource.UpgradeStateRequest{
+				State: &tfsdk.State{Schema: priorSchema, Raw: prior.value},
+			}
+			innerResp := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			upgrader.StateUpgrader(ctx, innerReq, &innerResp)
+
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.State.Raw, diags = joinRegionValue(ctx, resp.State.Schema, innerResp.State.Raw, prior.region)
+			resp.Diagnostics.Append(diags...)
+		},
+	}
+}
+
+// typedSchema is the part of a state, plan or identity schema needed to build its values
+type typedSchema interface {
+	Type() attr.Type
+}
+
+type regionalValue struct {
+	// value is the object without "region", typed by the wrapped schema
+	value tftypes.Value
+	// region is the "region" attribute, null when the object is null
+	region tftypes.Value
+}
+
+// splitRegion removes the "region" attribute from raw, an object of the
+// wrapped schema s plus "region".
+func splitRegion(ctx context.Context, s typedSchema, raw tftypes.Value) (regionalValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	typ := s.Type().TerraformType(ctx)
+
+	if raw.IsNull() {
+		return regionalValue{value: tftypes.NewValue(typ, nil), region: tftypes.NewValue(tftypes.String, nil)}, diags
+	}
+	if !raw.IsKnown() {
+		return regionalValue{value: tftypes.NewValue(typ, tftypes.UnknownValue), region: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}, diags
+	}
+
+	attributes, err := objectAttributes(raw)
+	if err != nil {
+		diags.AddError("Invalid resource value", err.Error())
+		return regionalValue{}, diags
+	}
+
+	region, ok := attributes["region"]
+	if !ok {
+		region = tftypes.NewValue(tftypes.String, nil)
+	}
+	delete(attributes, "region")
+
+	return regionalValue{value: tftypes.NewValue(typ, attributes), region: region}, diags
+}
+
+// joinRegion adds the region of config to raw, an object of the wrapped
+// schema. A nil config records a null region.
+func joinRegion(ctx context.Context, s typedSchema, raw tftypes.Value, config *conn.ProviderConfig) (tftypes.Value, diag.Diagnostics) {
+	region := tftypes.NewValue(tftypes.String, nil)
+	if config != nil {
+		region = tftypes.NewValue(tftypes.String, config.RegionCode)
+	}
+	return joinRegionValue(ctx, s, raw, region)
+}
+
+func joinRegionValue(ctx context.Context, s typedSchema, raw tftypes.Value, region tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	typ := s.Type().TerraformType(ctx)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), diags
+	}
+
+	attributes, err := objectAttributes(raw)
+	if err != nil {
+		diags.AddError("Invalid resource value", err.Error())
+		return tftypes.NewValue(typ, nil), diags
+	}
+	attributes["region"] = region
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		diags.AddError("Invalid resource value", fmt.Sprintf("adding region: %s", err))
+		return tftypes.NewValue(typ, nil), diags
+	}
+
+	return tftypes.NewValue(typ, attributes), diags
+}
+
+// innerIdentity returns identity without "region", typed by the identity
+// schema of the wrapped resource. It is nil when the wrapped resource has no
+// identity, or Terraform does not support identities.
+func (r *regionalResource) innerIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
+	i, ok := r.inner.(resource.ResourceWithIdentity)
+	if !ok || identity == nil {
+		return nil, nil
+	}
+
+	var resp resource.IdentitySchemaResponse
+	i.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	v, diags := splitRegion(ctx, resp.IdentitySchema, identity.Raw)
+	resp.Diagnostics.Append(diags...)
+	return &tfsdk.ResourceIdentity{Schema: resp.IdentitySchema, Raw: v.value}, resp.Diagnostics
+}
+
+// joinIdentity sets identity to the identity returned by the wrapped resource
+// and the region of state.
+func joinIdentity(ctx context.Context, identity, inner *tfsdk.ResourceIdentity, state tftypes.Value) diag.Diagnostics {
+	if identity == nil || inner == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	region := tftypes.NewValue(tftypes.String, nil)
+	if state.IsKnown() && !state.IsNull() {
+		attributes, err := objectAttributes(state)
+		if err != nil {
+			diags.AddError("Invalid resource value", err.Error())
+			return diags
+		}
+		if v, ok := attributes["region"]; ok {
+			region = v
+		}
+	}
+
+	identity.Raw, diags = joinRegionValue(ctx, identity.Schema, inner.Raw, region)
+	return diags
+}
+
+// objectAttributes returns a copy of the attributes of raw. As shares the map
+// of the value, which must stay untouched.
+func objectAttributes(raw tftypes.Value) (map[string]tftypes.Value, error) {
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	copied := make(map[string]tftypes.Value, len(attributes)+1)
+	for k, v := range attributes {
+		copied[k] = v
+	}
+	return copied, nil
+}
@@ -0,0 +1,190 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ list.ListResource              = &regionalListResource{}
+	_ list.ListResourceWithConfigure = &regionalListResource{}
+)
+
+// withRegionList adds the "region" argument to the list resources built by f,
+// like withRegion does for resources. The wrapped list resource lists from the
+// provider config of that region, and the region is added to the identity and
+// resource of each result.
+func withRegionList(f func() list.ListResource) func() list.ListResource {
+	return func() list.ListResource {
+		return &regionalListResource{inner: f()}
+	}
+}
+
+type regionalListResource struct {
+	inner  list.ListResource
+	config *conn.ProviderConfig
+}
+
+func (r *regionalListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, req, resp)
+}
+
+func (r *regionalListResource) innerSchema(ctx context.Context) (listschema.Schema, diag.Diagnostics) {
+	var resp list.ListResourceSchemaResponse
+	r.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &resp)
+	return resp.Schema, resp.Diagnostics
+}
+
+func (r *regionalListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	s, diags := r.innerSchema(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	attributes := make(map[string]listschema.Attribute, len(s.Attributes)+1)
+	for k, v := range s.Attributes {
+		attributes[k] = v
+	}
+	attributes["region"] = listschema.StringAttribute{
+		Optional:    true,
+		Description: "Region code to list the resources of. Defaults to the region of the provider.",
+	}
+	s.Attributes = attributes
+
+	resp.Schema = s
+}
+
+func (r *regionalListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*conn.ProviderConfig); ok {
+		r.config = config
+	}
+
+	if c, ok := r.inner.(list.ListResourceWithConfigure); ok {
+		c.Configure(ctx, req, resp)
+	}
+}
+
+func (r *regionalListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	s, d := r.innerSchema(ctx)
+	diags.Append(d...)
+	config, d := splitRegion(ctx, s, req.Config.Raw)
+	diags.Append(d...)
+	resourceSchema, identitySchema, d := withoutRegion(req.ResourceSchema, req.ResourceIdentitySchema)
+	diags.Append(d...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var code string
+	if config.region.IsKnown() && !config.region.IsNull() {
+		if err := config.region.As(&code); err != nil {
+			diags.AddError("Invalid region", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var providerConfig *conn.ProviderConfig
+	if r.config != nil {
+		var err error
+		providerConfig, err = r.config.ForRegion(code)
+		if err != nil {
+			diags.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		if c, ok := r.inner.(list.ListResourceWithConfigure); ok {
+			var resp resource.ConfigureResponse
+			c.Configure(ctx, resource.ConfigureRequest{ProviderData: providerConfig}, &resp)
+			if resp.Diagnostics.HasError() {
+				stream.Results = list.ListResultsStreamDiagnostics(resp.Diagnostics)
+				return
+			}
+		}
+	}
+
+	innerReq := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: s, Raw: config.value},
+		IncludeResource:        req.IncludeResource,
+		Limit:                  req.Limit,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: identitySchema,
+	}
+	innerStream := list.ListResultsStream{Results: list.NoListResults}
+	r.inner.List(ctx, innerReq, &innerStream)
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for inner := range innerStream.Results {
+			result := list.ListResult{
+				DisplayName: inner.DisplayName,
+				Diagnostics: inner.Diagnostics,
+			}
+
+			if inner.Identity != nil {
+				identity := req.NewListResult(ctx).Identity
+				raw, diags := joinRegion(ctx, identity.Schema, inner.Identity.Raw, providerConfig)
+				result.Diagnostics.Append(diags...)
+				identity.Raw = raw
+				result.Identity = identity
+			}
+			if inner.Resource != nil {
+				state := req.NewListResult(ctx).Resource
+				raw, diags := joinRegion(ctx, state.Schema, inner.Resource.Raw, providerConfig)
+				result.Diagnostics.Append(diags...)
+				state.Raw = raw
+				result.Resource = state
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// withoutRegion returns the schemas of the resource wrapped by withRegion
+func withoutRegion(resourceSchema, identitySchema any) (schema.Schema, identityschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rs, ok := resourceSchema.(schema.Schema)
+	if !ok {
+		diags.AddError("Invalid resource schema", fmt.Sprintf("Expected a resource schema, got: %T. Please report this issue to the provider developers.", resourceSchema))
+		return rs, identityschema.Schema{}, diags
+	}
+	is, ok := identitySchema.(identityschema.Schema)
+	if !ok {
+		diags.AddError("Invalid resource identity schema", fmt.Sprintf("Expected a resource identity schema, got: %T. Please report this issue to the provider developers.", identitySchema))
+		return rs, is, diags
+	}
+
+	resourceAttributes := make(map[string]schema.Attribute, len(rs.Attributes))
+	for k, v := range rs.Attributes {
+		if k != "region" {
+			resourceAttributes[k] = v
+		}
+	}
+	rs.Attributes = resourceAttributes
+
+	identityAttributes := make(map[string]identityschema.Attribute, len(is.Attributes))
+	for k, v := range is.Attributes {
+		if k != "region" {
+			identityAttributes[k] = v
+		}
+	}
+	is.Attributes = identityAttributes
+
+	return rs, is, diags
+}
@@ -0,0 +1,169 @@
+package fwprovider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	vpcsdk "github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+)
+
+func TestRegionalListResource_list(t *testing.T) {
+	ctx := context.Background()
+	config := newTestProviderConfig(t)
+
+	vpcNos := map[string]string{}
+	for _, region := range []string{"KR", "JPN"} {
+		resp, err := config.Client.Vpc.V2Api.CreateVpc(&vpcsdk.CreateVpcRequest{
+			RegionCode:    ncloud.String(region),
+			VpcName:       ncloud.String("tf-vpc-" + region),
+			Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+		})
+		if err != nil {
+			t.Fatalf("creating vpc: %s", err)
+		}
+		vpcNos[region] = *resp.VpcList[0].VpcNo
+	}
+
+	r := withRegion(vpc.NewVpcResource)().(resource.ResourceWithIdentity)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	l := withRegionList(vpc.NewVpcListResource)()
+	l.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: config}, &resource.ConfigureResponse{})
+	var configResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configResp)
+	configType := configResp.Schema.Type().TerraformType(ctx)
+
+	listVpcs := func(region interface{}, includeResource bool) []list.ListResult {
+		t.Helper()
+
+		req := list.ListRequest{
+			Config: tfsdk.Config{
+				Schema: configResp.Schema,
+				Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+					"name":   tftypes.NewValue(tftypes.String, nil),
+					"region": tftypes.NewValue(tftypes.String, region),
+				}),
+			},
+			IncludeResource:        includeResource,
+			ResourceSchema:         schemaResp.Schema,
+			ResourceIdentitySchema: identityResp.IdentitySchema,
+		}
+		var stream list.ListResultsStream
+		l.List(ctx, req, &stream)
+
+		var results []list.ListResult
+		for result := range stream.Results {
+			if result.Diagnostics.HasError() {
+				t.Fatalf("listing: %v", result.Diagnostics)
+			}
+			results = append(results, result)
+		}
+		return results
+	}
+
+	for _, tc := range []struct {
+		region         interface{}
+		expectedRegion string
+	}{
+		{nil, "KR"},
+		{"JPN", "JPN"},
+	} {
+		results := listVpcs(tc.region, true)
+		if len(results) != 1 {
+			t.Fatalf("%v: expected 1 vpc, got %d", tc.region, len(results))
+		}
+
+		wantIdentity := tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, vpcNos[tc.expectedRegion]),
+			"region": tftypes.NewValue(tftypes.String, tc.expectedRegion),
+		})
+		if !results[0].Identity.Raw.Equal(wantIdentity) {
+			t.Errorf("%v: expected identity %s, got %s", tc.region, wantIdentity, results[0].Identity.Raw)
+		}
+		if results[0].DisplayName != "tf-vpc-"+tc.expectedRegion {
+			t.Errorf("%v: unexpected display name %s", tc.region, results[0].DisplayName)
+		}
+
+		var vpcNo, region string
+		if diags := results[0].Resource.GetAttribute(ctx, path.Root("vpc_no"), &vpcNo); diags.HasError() {
+			t.Fatalf("%v: %v", tc.region, diags)
+		}
+		if diags := results[0].Resource.GetAttribute(ctx, path.Root("region"), &region); diags.HasError() {
+			t.Fatalf("%v: %v", tc.region, diags)
+		}
+		if vpcNo != vpcNos[tc.expectedRegion] || region != tc.expectedRegion {
+			t.Errorf("%v: expected vpc %s in %s, got %s in %s", tc.region, vpcNos[tc.expectedRegion], tc.expectedRegion, vpcNo, region)
+		}
+	}
+
+	if results := listVpcs(nil, false); len(results) != 1 || !results[0].Resource.Raw.IsNull() {
+		t.Error("expected identities only without include_resource")
+	}
+}
+
+func TestListResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New(nil))()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("reading schema: %s", err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Errorf("reading schema: %s: %s", d.Summary, d.Detail)
+	}
+	identityResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("reading identity schemas: %s", err)
+	}
+	for _, d := range identityResp.Diagnostics {
+		t.Errorf("reading identity schemas: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, typeName := range []string{"ncloud_vpc", "ncloud_subnet", "ncloud_server"} {
+		s, ok := schemaResp.ListResourceSchemas[typeName]
+		if !ok {
+			t.Errorf("%s: list resource schema not found", typeName)
+			continue
+		}
+		if !hasAttribute(s.Block.Attributes, "region") {
+			t.Errorf("%s: expected a region argument", typeName)
+		}
+
+		identity, ok := identityResp.IdentitySchemas[typeName]
+		if !ok {
+			t.Errorf("%s: identity schema not found", typeName)
+			continue
+		}
+		var names []string
+		for _, attr := range identity.IdentityAttributes {
+			names = append(names, attr.Name)
+		}
+		if len(names) != 2 || !slices.Contains(names, "id") || !slices.Contains(names, "region") {
+			t.Errorf("%s: expected an identity of id and region, got %v", typeName, names)
+		}
+	}
+}
+
+func hasAttribute(attributes []*tfprotov6.SchemaAttribute, name string) bool {
+	for _, attr := range attributes {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
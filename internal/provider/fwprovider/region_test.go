@@ -0,0 +1,348 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+// newTestProviderConfig returns the config of a provider in KR whose client
+// reaches a mock server that also serves JPN
+func newTestProviderConfig(t *testing.T) *conn.ProviderConfig {
+	t.Helper()
+
+	srv := mockncp.NewServer("KR")
+	srv.OtherRegions = []string{"JPN"}
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	clientConfig := conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}
+	if err := config.NewClient(clientConfig, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	if err := config.LoadRegions(); err != nil {
+		t.Fatalf("loading regions: %s", err)
+	}
+
+	return config
+}
+
+// testResource records the region it was last configured for
+type testResource struct {
+	region string
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "ncloud_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *testResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*conn.ProviderConfig); ok {
+		r.region = config.RegionCode
+	}
+}
+
+func (r *testResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *testResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *testResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *testResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func newTestRegionalResource(t *testing.T) (*regionalResource, *testResource, schema.Schema) {
+	t.Helper()
+
+	inner := &testResource{}
+	r := withRegion(func() resource.Resource { return inner })().(*regionalResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: newTestProviderConfig(t)}, &resource.ConfigureResponse{})
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading schema: %v", resp.Diagnostics)
+	}
+
+	return r, inner, resp.Schema
+}
+
+func testObject(ctx context.Context, s schema.Schema, id, name, region interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, id),
+		"name":   tftypes.NewValue(tftypes.String, name),
+		"region": tftypes.NewValue(tftypes.String, region),
+	})
+}
+
+func TestSplitJoinRegion(t *testing.T) {
+	ctx := context.Background()
+	r, _, s := newTestRegionalResource(t)
+	inner, diags := r.innerSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("reading inner schema: %v", diags)
+	}
+
+	raw := testObject(ctx, s, "1", "a", "JPN")
+	split, diags := splitRegion(ctx, inner, raw)
+	if diags.HasError() {
+		t.Fatalf("splitting: %v", diags)
+	}
+
+	expectedValue := tftypes.NewValue(inner.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "1"),
+		"name": tftypes.NewValue(tftypes.String, "a"),
+	})
+	if !split.value.Equal(expectedValue) {
+		t.Errorf("expected value without region %s, got %s", expectedValue, split.value)
+	}
+	if !split.region.Equal(tftypes.NewValue(tftypes.String, "JPN")) {
+		t.Errorf("expected region JPN, got %s", split.region)
+	}
+
+	joined, diags := joinRegionValue(ctx, s, split.value, split.region)
+	if diags.HasError() {
+		t.Fatalf("joining: %v", diags)
+	}
+	if !joined.Equal(raw) {
+		t.Errorf("expected %s after split and join, got %s", raw, joined)
+	}
+
+	joined, diags = joinRegion(ctx, s, split.value, nil)
+	if diags.HasError() {
+		t.Fatalf("joining without config: %v", diags)
+	}
+	if expected := testObject(ctx, s, "1", "a", nil); !joined.Equal(expected) {
+		t.Errorf("expected %s when joined without config, got %s", expected, joined)
+	}
+
+	for _, v := range []interface{}{nil, tftypes.UnknownValue} {
+		split, diags := splitRegion(ctx, inner, tftypes.NewValue(s.Type().TerraformType(ctx), v))
+		if diags.HasError() {
+			t.Fatalf("splitting %v: %v", v, diags)
+		}
+		if split.value.IsNull() != (v == nil) || split.value.IsKnown() != (v == nil) {
+			t.Errorf("expected %v value, got %s", v, split.value)
+		}
+		if split.region.IsNull() != (v == nil) || split.region.IsKnown() != (v == nil) {
+			t.Errorf("expected %v region, got %s", v, split.region)
+		}
+	}
+}
+
+func TestRegionalResource_read(t *testing.T) {
+	ctx := context.Background()
+	r, inner, s := newTestRegionalResource(t)
+
+	for _, region := range []interface{}{nil, "KR", "JPN"} {
+		state := tfsdk.State{Schema: s, Raw: testObject(ctx, s, "1", "a", region)}
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%v: %v", region, resp.Diagnostics)
+		}
+
+		expected := region
+		if expected == nil {
+			expected = "KR"
+		}
+		if inner.region != expected {
+			t.Errorf("%v: expected read in %s, got %s", region, expected, inner.region)
+		}
+		if want := testObject(ctx, s, "1", "a", expected); !resp.State.Raw.Equal(want) {
+			t.Errorf("%v: expected state %s, got %s", region, want, resp.State.Raw)
+		}
+	}
+}
+
+func TestRegionalResource_modifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r, _, s := newTestRegionalResource(t)
+
+	cases := []struct {
+		name     string
+		region   interface{}
+		expected interface{}
+	}{
+		{"without region", nil, "KR"},
+		{"with region", "JPN", "JPN"},
+		{"with region known after apply", tftypes.UnknownValue, tftypes.UnknownValue},
+	}
+
+	for _, tc := range cases {
+		config := tfsdk.Config{Schema: s, Raw: testObject(ctx, s, nil, "a", tc.region)}
+		planRegion := tc.region
+		if planRegion == nil {
+			planRegion = tftypes.UnknownValue
+		}
+		plan := tfsdk.Plan{Schema: s, Raw: testObject(ctx, s, tftypes.UnknownValue, "a", planRegion)}
+		state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", tc.name, resp.Diagnostics)
+		}
+
+		if want := testObject(ctx, s, tftypes.UnknownValue, "a", tc.expected); !resp.Plan.Raw.Equal(want) {
+			t.Errorf("%s: expected plan %s, got %s", tc.name, want, resp.Plan.Raw)
+		}
+	}
+}
+
+func TestRegionalResource_importState(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		importID       string
+		expectedID     string
+		expectedRegion interface{}
+		expectedCalled string
+	}{
+		{"123", "123", nil, "KR"},
+		{"123@JPN", "123", "JPN", "JPN"},
+		{"bucket/key@name", "bucket/key@name", nil, "KR"},
+	}
+
+	for _, tc := range cases {
+		r, inner, s := newTestRegionalResource(t)
+
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: tc.importID}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", tc.importID, resp.Diagnostics)
+		}
+
+		if want := testObject(ctx, s, tc.expectedID, nil, tc.expectedRegion); !resp.State.Raw.Equal(want) {
+			t.Errorf("%s: expected state %s, got %s", tc.importID, want, resp.State.Raw)
+		}
+		if inner.region != tc.expectedCalled {
+			t.Errorf("%s: expected import in %s, got %s", tc.importID, tc.expectedCalled, inner.region)
+		}
+	}
+
+	r, _, s := newTestRegionalResource(t)
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "123@USWN"}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an unknown region")
+	}
+}
+
+// testIdentityResource is a testResource identified by its ID
+type testIdentityResource struct {
+	*testResource
+}
+
+func (r *testIdentityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = framework.IDIdentitySchema()
+}
+
+func (r *testIdentityResource) Read(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, id)...)
+}
+
+func (r *testIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func TestRegionalResource_identity(t *testing.T) {
+	ctx := context.Background()
+
+	inner := &testIdentityResource{&testResource{}}
+	r := withRegion(func() resource.Resource { return inner })().(*regionalResourceWithIdentity)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: newTestProviderConfig(t)}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	identitySchema := identityResp.IdentitySchema
+
+	identityObject := func(id, region interface{}) tftypes.Value {
+		return tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, id),
+			"region": tftypes.NewValue(tftypes.String, region),
+		})
+	}
+
+	for _, tc := range []struct {
+		region         interface{}
+		expectedRegion interface{}
+	}{
+		{nil, nil},
+		{"JPN", "JPN"},
+	} {
+		// the framework starts the response from the identity to import
+		importResp := resource.ImportStateResponse{
+			State:    tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: identityObject("123", tc.region)},
+		}
+		r.ImportState(ctx, resource.ImportStateRequest{
+			Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: identityObject("123", tc.region)},
+		}, &importResp)
+		if importResp.Diagnostics.HasError() {
+			t.Fatalf("%v: %v", tc.region, importResp.Diagnostics)
+		}
+		if want := testObject(ctx, s, "123", nil, tc.expectedRegion); !importResp.State.Raw.Equal(want) {
+			t.Errorf("%v: expected state %s, got %s", tc.region, want, importResp.State.Raw)
+		}
+		if want := identityObject("123", tc.expectedRegion); !importResp.Identity.Raw.Equal(want) {
+			t.Errorf("%v: expected identity %s, got %s", tc.region, want, importResp.Identity.Raw)
+		}
+
+		readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+		r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("%v: %v", tc.region, readResp.Diagnostics)
+		}
+
+		region := tc.expectedRegion
+		if region == nil {
+			region = "KR"
+		}
+		if inner.region != region {
+			t.Errorf("%v: expected read in %s, got %s", tc.region, region, inner.region)
+		}
+		if want := identityObject("123", region); !readResp.Identity.Raw.Equal(want) {
+			t.Errorf("%v: expected identity %s after read, got %s", tc.region, want, readResp.Identity.Raw)
+		}
+	}
+}
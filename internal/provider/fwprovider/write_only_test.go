@@ -0,0 +1,99 @@
+package fwprovider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWriteOnlyAttributes(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New(nil))()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("reading schema: %s", err)
+	}
+
+	cases := []struct {
+		typeName string
+		attr     string
+		value    string
+		required bool
+	}{
+		{"ncloud_mysql", "user_password", "Password1!", true},
+		{"ncloud_mongodb", "user_password", "Password1!", true},
+		{"ncloud_postgresql", "user_password", "Password1!", true},
+		{"ncloud_mssql", "user_password", "Password1!", true},
+		{"ncloud_redis", "user_password", "Password1!", false},
+		{"ncloud_hadoop", "admin_user_password", "Password1!", true},
+	}
+
+	for _, tc := range cases {
+		s, ok := schemaResp.ResourceSchemas[tc.typeName]
+		if !ok {
+			t.Fatalf("%s: schema not found", tc.typeName)
+		}
+
+		attrs := map[string]*tfprotov6.SchemaAttribute{}
+		for _, a := range s.Block.Attributes {
+			attrs[a.Name] = a
+		}
+		if a := attrs[tc.attr+"_wo"]; a == nil || !a.WriteOnly || !a.Sensitive {
+			t.Errorf("%s: expected %s_wo to be a sensitive write-only attribute", tc.typeName, tc.attr)
+		}
+		if a := attrs[tc.attr+"_wo_version"]; a == nil || a.WriteOnly {
+			t.Errorf("%s: expected %s_wo_version to be stored in state", tc.typeName, tc.attr)
+		}
+
+		validate := func(values map[string]string) []*tfprotov6.Diagnostic {
+			config := map[string]tftypes.Value{}
+			for name, typ := range s.ValueType().(tftypes.Object).AttributeTypes {
+				config[name] = tftypes.NewValue(typ, nil)
+				if v, ok := values[name]; ok {
+					config[name] = tftypes.NewValue(typ, v)
+				}
+			}
+			dv, err := tfprotov6.NewDynamicValue(s.ValueType(), tftypes.NewValue(s.ValueType(), config))
+			if err != nil {
+				t.Fatalf("%s: %s", tc.typeName, err)
+			}
+
+			resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: tc.typeName,
+				Config:   &dv,
+				ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+			})
+			if err != nil {
+				t.Fatalf("%s: %s", tc.typeName, err)
+			}
+
+			var diags []*tfprotov6.Diagnostic
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError && strings.Contains(d.Detail, tc.attr) {
+					diags = append(diags, d)
+				}
+			}
+			return diags
+		}
+
+		if diags := validate(map[string]string{tc.attr + "_wo": tc.value}); len(diags) > 0 {
+			t.Errorf("%s: expected %s_wo alone to be valid, got %s", tc.typeName, tc.attr, diags[0].Detail)
+		}
+		if diags := validate(map[string]string{tc.attr: tc.value, tc.attr + "_wo": tc.value}); len(diags) == 0 {
+			t.Errorf("%s: expected an error when both %s and %s_wo are set", tc.typeName, tc.attr, tc.attr)
+		}
+		if diags := validate(map[string]string{}); (len(diags) > 0) != tc.required {
+			t.Errorf("%s: expected an error without a password to be %t", tc.typeName, tc.required)
+		}
+		if diags := validate(map[string]string{tc.attr + "_wo": "short"}); len(diags) == 0 {
+			t.Errorf("%s: expected %s_wo to be validated like %s", tc.typeName, tc.attr, tc.attr)
+		}
+	}
+}
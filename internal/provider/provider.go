@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,6 +55,7 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_network_acl_deny_allow_groups":           vpc.DataSourceNcloudNetworkACLDenyAllowGroups(),
 		"ncloud_network_interface":                       server.DataSourceNcloudNetworkInterface(),
 		"ncloud_network_interfaces":                      server.DataSourceNcloudNetworkInterfaces(),
+		"ncloud_nks_addon_versions":                      nks.DataSourceNcloudNKSAddonVersions(),
 		"ncloud_nks_cluster":                             nks.DataSourceNcloudNKSCluster(),
 		"ncloud_nks_clusters":                            nks.DataSourceNcloudNKSClusters(),
 		"ncloud_nks_kube_config":                         nks.DataSourceNcloudNKSKubeConfig(),
@@ -108,7 +110,6 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_auto_scaling_policy":                 autoscaling.ResourceNcloudAutoScalingPolicy(),
 		"ncloud_auto_scaling_schedule":               autoscaling.ResourceNcloudAutoScalingSchedule(),
 		"ncloud_block_storage_snapshot":              server.ResourceNcloudBlockStorageSnapshot(),
-		"ncloud_block_storage":                       server.ResourceNcloudBlockStorage(),
 		"ncloud_cdss_cluster":                        cdss.ResourceNcloudCDSSCluster(),
 		"ncloud_cdss_config_group":                   cdss.ResourceNcloudCDSSConfigGroup(),
 		"ncloud_launch_configuration":                autoscaling.ResourceNcloudLaunchConfiguration(),
@@ -123,6 +124,7 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_network_acl_rule":                    vpc.ResourceNcloudNetworkACLRule(),
 		"ncloud_network_interface":                   server.ResourceNcloudNetworkInterface(),
 		"ncloud_nks_cluster":                         nks.ResourceNcloudNKSCluster(),
+		"ncloud_nks_cluster_addon":                   nks.ResourceNcloudNKSClusterAddon(),
 		"ncloud_nks_node_pool":                       nks.ResourceNcloudNKSNodePool(),
 		"ncloud_placement_group":                     server.ResourceNcloudPlacementGroup(),
 		"ncloud_port_forwarding_rule":                server.ResourceNcloudPortForwadingRule(),
@@ -130,7 +132,6 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_route":                               vpc.ResourceNcloudRoute(),
 		"ncloud_route_table":                         vpc.ResourceNcloudRouteTable(),
 		"ncloud_route_table_association":             vpc.ResourceNcloudRouteTableAssociation(),
-		"ncloud_server":                              server.ResourceNcloudServer(),
 		"ncloud_ses_cluster":                         ses.ResourceNcloudSESCluster(),
 		"ncloud_sourcebuild_project":                 devtools.ResourceNcloudSourceBuildProject(),
 		"ncloud_sourcecommit_repository":             devtools.ResourceNcloudSourceCommitRepository(),
@@ -140,6 +141,10 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_sourcepipeline_project":              devtools.ResourceNcloudSourcePipeline(),
 	}
 
+	for _, r := range resourceMap {
+		withRegion(r)
+	}
+
 	return &schema.Provider{
 		Schema:               SchemaMap(),
 		DataSourcesMap:       dataSourceMap,
@@ -179,6 +184,12 @@ func SchemaMap() map[string]*schema.Schema {
 }
 
 func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return ConfigureWithHTTPClient(ctx, d, nil)
+}
+
+// ConfigureWithHTTPClient is ProviderConfigure with every API client sending requests
+// through httpClient. A nil httpClient keeps the default client.
+func ConfigureWithHTTPClient(ctx context.Context, d *schema.ResourceData, httpClient *http.Client) (interface{}, diag.Diagnostics) {
 	providerConfig := conn.ProviderConfig{
 		SupportVPC: true,
 	}
@@ -187,15 +198,14 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		providerConfig.SupportVPC = false
 	}
 
-	// Set site
+	// Set site. The endpoint stays on this provider's clients instead of the
+	// process environment, so aliases for other sites are not affected.
+	apiGateway := os.Getenv("NCLOUD_API_GW")
 	if site, ok := getOrFromEnv(d, "site", "NCLOUD_SITE"); ok {
 		providerConfig.Site = site.(string)
 
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
+		if gw := conn.SiteAPIGateway(providerConfig.Site); gw != "" {
+			apiGateway = gw
 		}
 	}
 
@@ -219,30 +229,31 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	// Set client
 	config := conn.Config{
-		AccessKey: accessKey.(string),
-		SecretKey: secretKey.(string),
-		Region:    region.(string),
+		AccessKey:  accessKey.(string),
+		SecretKey:  secretKey.(string),
+		Region:     region.(string),
+		APIGateway: apiGateway,
+		HTTPClient: httpClient,
+		// only for debugging against a local S3 server
+		ObsPathStyle: os.Getenv("NCLOUD_OBS_PATH_STYLE") != "",
 	}
 
 	// Set endpoint (only for debugging)
 	obs_endpoint := os.Getenv("NCLOUD_OBS_ENDPOINT")
 
-	if client, err := config.Client(providerConfig.Site, obs_endpoint); err != nil {
+	if err := providerConfig.NewClient(config, obs_endpoint); err != nil {
 		return nil, diag.FromErr(err)
-	} else {
-		providerConfig.Client = client
 	}
 
 	// Set region
-	if err := conn.SetRegionCache(providerConfig.Client, providerConfig.SupportVPC); err != nil {
+	if err := providerConfig.LoadRegions(); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	if conn.IsValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
+	if providerConfig.IsValidRegionCode(region.(string)) {
 		providerConfig.RegionCode = region.(string)
 		if !providerConfig.SupportVPC {
-			providerConfig.RegionNo = *conn.GetRegionNoByCode(region.(string))
+			providerConfig.RegionNo = *providerConfig.GetRegionNoByCode(region.(string))
 		}
 	} else {
 		return nil, []diag.Diagnostic{
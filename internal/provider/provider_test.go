@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProvider(t *testing.T) {
+	if err := New(context.Background()).InternalValidate(); err != nil {
+		t.Fatalf("validating provider: %s", err)
+	}
+}
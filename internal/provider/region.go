@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+const regionDescription = "Region code of the resource. Defaults to the region of the provider. Changing it creates the resource in the new region."
+
+// regionSchema is the "region" argument added to every resource. It is
+// computed so that the region a resource was created in stays in state even
+// when the provider region changes later.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: regionDescription,
+	}
+}
+
+// withRegion adds the "region" argument to r and makes its CRUD functions
+// run with the provider config of that region. Resources that already take a
+// "region" argument of their own are routed by it but keep their schema.
+func withRegion(r *schema.Resource) *schema.Resource {
+	if s, ok := r.Schema["region"]; !ok || !s.Optional {
+		r.Schema["region"] = regionSchema()
+	}
+	owned := r.Schema["region"].Computed
+
+	if r.CreateContext != nil {
+		r.CreateContext = regionalContextFunc(r.CreateContext, owned)
+	}
+	if r.Create != nil {
+		r.Create = regionalFunc(r.Create, owned)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = regionalContextFunc(r.ReadContext, owned)
+	}
+	if r.Read != nil {
+		r.Read = regionalFunc(r.Read, owned)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = regionalContextFunc(r.UpdateContext, owned)
+	}
+	if r.Update != nil {
+		r.Update = regionalFunc(r.Update, owned)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = regionalContextFunc(r.DeleteContext, false)
+	}
+	if r.Delete != nil {
+		r.Delete = regionalFunc(r.Delete, false)
+	}
+
+	if owned && r.Importer != nil {
+		r.Importer = regionalImporter(r.Importer)
+	}
+
+	if owned {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			config := meta.(*conn.ProviderConfig)
+			if d.Id() == "" && !regionConfigured(d) {
+				if err := d.SetNew("region", config.RegionCode); err != nil {
+					return err
+				}
+			}
+
+			if customizeDiff == nil {
+				return nil
+			}
+
+			regional, err := config.ForRegion(d.Get("region").(string))
+			if err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, regional)
+		}
+	}
+
+	return r
+}
+
+// regionConfigured reports whether the configuration sets "region", possibly
+// to a value only known after apply. An unset region is planned as unknown,
+// since the argument is computed.
+func regionConfigured(d *schema.ResourceDiff) bool {
+	if raw := d.GetRawConfig(); raw.IsKnown() && !raw.IsNull() {
+		return !raw.GetAttr("region").IsNull()
+	}
+	return !d.NewValueKnown("region") || d.Get("region").(string) != ""
+}
+
+// regionalImporter accepts import IDs of the form "<id>@<region>" and runs
+// importer with the provider config of that region. The region is recorded
+// in state so that the following read uses it too.
+func regionalImporter(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	stateContext := importer.StateContext
+	if stateContext == nil && importer.State != nil {
+		state := importer.State
+		stateContext = func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, meta)
+		}
+	}
+
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, region := conn.SplitImportRegion(d.Id())
+			config, err := meta.(*conn.ProviderConfig).ForRegion(region)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+
+			results := []*schema.ResourceData{d}
+			if stateContext != nil {
+				if results, err = stateContext(ctx, d, config); err != nil {
+					return nil, err
+				}
+			}
+
+			if region != "" {
+				for _, rd := range results {
+					if err := rd.Set("region", config.RegionCode); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return results, nil
+		},
+	}
+}
+
+func regionalContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, owned bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config, err := meta.(*conn.ProviderConfig).ForRegion(d.Get("region").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, config)
+		if owned && d.Id() != "" {
+			if err := d.Set("region", config.RegionCode); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+}
+
+func regionalFunc(f func(*schema.ResourceData, interface{}) error, owned bool) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config, err := meta.(*conn.ProviderConfig).ForRegion(d.Get("region").(string))
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, config); err != nil {
+			return err
+		}
+		if owned && d.Id() != "" {
+			return d.Set("region", config.RegionCode)
+		}
+		return nil
+	}
+}
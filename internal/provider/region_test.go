@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+// unknownConfigValue is the placeholder terraform.NewResourceConfigRaw reads as
+// a value known only after apply
+const unknownConfigValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// newTestProviderConfig returns the config of a provider in KR whose client
+// reaches a mock server that also serves JPN
+func newTestProviderConfig(t *testing.T) *conn.ProviderConfig {
+	t.Helper()
+
+	srv := mockncp.NewServer("KR")
+	srv.OtherRegions = []string{"JPN"}
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	clientConfig := conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}
+	if err := config.NewClient(clientConfig, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	if err := config.LoadRegions(); err != nil {
+		t.Fatalf("loading regions: %s", err)
+	}
+
+	return config
+}
+
+// newTestRegionalResource returns a regional resource that records the
+// region of the config each function is called with
+func newTestRegionalResource(called *string) *schema.Resource {
+	record := func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		*called = meta.(*conn.ProviderConfig).RegionCode
+		return nil
+	}
+
+	return withRegion(&schema.Resource{
+		CreateContext: record,
+		ReadContext:   record,
+		DeleteContext: record,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				*called = meta.(*conn.ProviderConfig).RegionCode
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	})
+}
+
+func TestWithRegion_customizeDiff(t *testing.T) {
+	config := newTestProviderConfig(t)
+	var called string
+	r := newTestRegionalResource(&called)
+
+	// rawConfig is the configuration as sent by Terraform, which the diff reads to tell an unset region
+	// from one set to a value known only after apply
+	rawConfig := func(name string, region cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":     cty.NullVal(cty.String),
+			"name":   cty.StringVal(name),
+			"region": region,
+		})
+	}
+
+	cases := []struct {
+		name             string
+		state            *terraform.InstanceState
+		raw              map[string]interface{}
+		expected         string
+		expectedComputed bool
+	}{
+		{
+			name:     "new without region",
+			state:    &terraform.InstanceState{RawConfig: rawConfig("a", cty.NullVal(cty.String))},
+			raw:      map[string]interface{}{"name": "a"},
+			expected: "KR",
+		},
+		{
+			name:     "new with region",
+			state:    &terraform.InstanceState{RawConfig: rawConfig("a", cty.StringVal("JPN"))},
+			raw:      map[string]interface{}{"name": "a", "region": "JPN"},
+			expected: "JPN",
+		},
+		{
+			name:             "new with unknown region",
+			state:            &terraform.InstanceState{RawConfig: rawConfig("a", cty.UnknownVal(cty.String))},
+			raw:              map[string]interface{}{"name": "a", "region": unknownConfigValue},
+			expectedComputed: true,
+		},
+		{
+			name: "existing without region",
+			state: &terraform.InstanceState{
+				ID:         "1",
+				Attributes: map[string]string{"id": "1", "name": "a", "region": "JPN"},
+				RawConfig:  rawConfig("b", cty.NullVal(cty.String)),
+			},
+			raw: map[string]interface{}{"name": "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		diff, err := r.Diff(context.Background(), tc.state, terraform.NewResourceConfigRaw(tc.raw), config)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+
+		var got string
+		var computed bool
+		if attr, ok := diff.Attributes["region"]; ok {
+			got, computed = attr.New, attr.NewComputed
+		}
+		if computed != tc.expectedComputed {
+			t.Errorf("%s: expected region computed to be %t, got %t", tc.name, tc.expectedComputed, computed)
+		}
+		if !computed && got != tc.expected {
+			t.Errorf("%s: expected planned region %q, got %q", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestWithRegion_crud(t *testing.T) {
+	config := newTestProviderConfig(t)
+	var called string
+	r := newTestRegionalResource(&called)
+
+	for _, region := range []string{"", "KR", "JPN"} {
+		d := r.Data(nil)
+		d.SetId("1")
+		d.Set("region", region)
+
+		if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("%q: %v", region, diags)
+		}
+
+		expected := region
+		if expected == "" {
+			expected = config.RegionCode
+		}
+		if called != expected {
+			t.Errorf("%q: expected read in %s, got %s", region, expected, called)
+		}
+		if got := d.Get("region").(string); got != expected {
+			t.Errorf("%q: expected region %s in state, got %s", region, expected, got)
+		}
+	}
+}
+
+func TestWithRegion_import(t *testing.T) {
+	config := newTestProviderConfig(t)
+	var called string
+	r := newTestRegionalResource(&called)
+
+	cases := []struct {
+		importID       string
+		expectedID     string
+		expectedRegion string
+		expectedCalled string
+	}{
+		{"123", "123", "", "KR"},
+		{"123@JPN", "123", "JPN", "JPN"},
+		{"123@KR", "123", "KR", "KR"},
+		{"bucket/key@name", "bucket/key@name", "", "KR"},
+	}
+
+	for _, tc := range cases {
+		d := r.Data(nil)
+		d.SetId(tc.importID)
+
+		results, err := r.Importer.StateContext(context.Background(), d, config)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.importID, err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("%s: expected 1 result, got %d", tc.importID, len(results))
+		}
+
+		if got := results[0].Id(); got != tc.expectedID {
+			t.Errorf("%s: expected ID %s, got %s", tc.importID, tc.expectedID, got)
+		}
+		if got := results[0].Get("region").(string); got != tc.expectedRegion {
+			t.Errorf("%s: expected region %q, got %q", tc.importID, tc.expectedRegion, got)
+		}
+		if called != tc.expectedCalled {
+			t.Errorf("%s: expected import in %s, got %s", tc.importID, tc.expectedCalled, called)
+		}
+	}
+
+	d := r.Data(nil)
+	d.SetId("123@USWN")
+	if _, err := r.Importer.StateContext(context.Background(), d, config); err == nil {
+		t.Error("expected an error for an unknown region")
+	}
+}
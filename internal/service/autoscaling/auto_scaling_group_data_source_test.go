@@ -50,7 +50,7 @@ func TestAccDataSourceNcloudAutoScalingGroup_vpc_basic(t *testing.T) {
 	resourceName := "ncloud_auto_scaling_group.auto"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -52,7 +52,7 @@ func TestAccResourceNcloudAutoScalingGroup_vpc_basic(t *testing.T) {
 	var autoScalingGroup autoscaling.AutoScalingGroup
 	resourceName := "ncloud_auto_scaling_group.auto"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckAutoScalingGroupDestroy(state, GetTestProvider(true))
@@ -119,7 +119,7 @@ func TestAccResourceNcloudAutoScalingGroup_vpc_zero_value(t *testing.T) {
 	var autoScalingGroup autoscaling.AutoScalingGroup
 	resourceName := "ncloud_auto_scaling_group.auto"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckAutoScalingGroupDestroy(state, GetTestProvider(true))
@@ -176,7 +176,7 @@ func TestAccResourceNcloudAutoScalingGroup_vpc_disappears(t *testing.T) {
 	var autoScalingGroup autoscaling.AutoScalingGroup
 	resourceName := "ncloud_auto_scaling_group.auto"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckAutoScalingGroupDestroy(state, GetTestProvider(true))
@@ -1,10 +1,8 @@
 package autoscaling_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -14,7 +12,7 @@ func TestAccDataSourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 	// Images are all deprecated in Classic
 	t.Skip()
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	dataName := "data.ncloud_auto_scaling_policy.policy"
 	resourceName := "ncloud_auto_scaling_policy.test-policy-CHANG"
 
@@ -39,12 +37,12 @@ func TestAccDataSourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudAutoScalingPolicy_vpc_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	dataName := "data.ncloud_auto_scaling_policy.policy"
 	resourceName := "ncloud_auto_scaling_policy.test-policy-CHANG"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -62,7 +61,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_zero_value(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -124,13 +123,13 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_zero_value(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingPolicy_vpc_zero_value(t *testing.T) {
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNcloudAutoScalingPolicyDestroy(state, GetTestProvider(true))
@@ -191,7 +190,7 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_disappears(t *testing.T) {
 	t.Skip()
 
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
@@ -221,12 +220,12 @@ func TestAccResourceNcloudAutoScalingPolicy_classic_disappears(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingPolicy_vpc_disappears(t *testing.T) {
 	var policy autoscaling.AutoScalingPolicy
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceCHANG := "ncloud_auto_scaling_policy.test-policy-CHANG"
 	resourceEXACT := "ncloud_auto_scaling_policy.test-policy-EXACT"
 	resourcePRCNT := "ncloud_auto_scaling_policy.test-policy-PRCNT"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNcloudAutoScalingPolicyDestroy(state, GetTestProvider(true))
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +20,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -45,12 +44,12 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingSchedule_vpc_basic(t *testing.T) {
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNcloudAutoScalingScheduleDestroy(state, GetTestProvider(true))
@@ -71,7 +70,7 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_disappears(t *testing.T) {
 	t.Skip()
 
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
@@ -97,12 +96,12 @@ func TestAccResourceNcloudAutoScalingSchedule_classic_disappears(t *testing.T) {
 
 func TestAccResourceNcloudAutoScalingSchedule_vpc_disappears(t *testing.T) {
 	var schedule autoscaling.AutoScalingSchedule
-	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
+	name := RandomName(t, "asg")
 	resourceName := "ncloud_auto_scaling_schedule.test-schedule"
 	start := testAccNcloudAutoscalingScheduleValidStart(t)
 	end := testAccNcloudAutoscalingScheduleValidEnd(t)
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNcloudAutoScalingScheduleDestroy(state, GetTestProvider(true))
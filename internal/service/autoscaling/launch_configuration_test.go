@@ -50,7 +50,7 @@ func TestAccResourceNcloudLaunchConfiguration_vpc_basic(t *testing.T) {
 	serverImageProductCode := "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
 	serverProductCode := "SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckLaunchConfigurationDestroy(state, GetTestProvider(true))
@@ -104,7 +104,7 @@ func TestAccResourceNcloudLaunchConfiguration_vpc_disappears(t *testing.T) {
 	serverImageProductCode := "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
 	serverProductCode := "SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vautoscaling") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckLaunchConfigurationDestroy(state, GetTestProvider(true))
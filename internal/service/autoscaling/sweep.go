@@ -0,0 +1,70 @@
+//go:build sweep
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_auto_scaling_group", &resource.Sweeper{
+		Name: "ncloud_auto_scaling_group",
+		F:    sweepAutoScalingGroups,
+	})
+
+	resource.AddTestSweepers("ncloud_launch_configuration", &resource.Sweeper{
+		Name:         "ncloud_launch_configuration",
+		F:            sweepLaunchConfigurations,
+		Dependencies: []string{"ncloud_auto_scaling_group"},
+	})
+}
+
+func sweepAutoScalingGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vautoscaling.V2Api.GetAutoScalingGroupList(&vautoscaling.GetAutoScalingGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing auto scaling groups: %w", err)
+	}
+
+	r := ResourceNcloudAutoScalingGroup()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.AutoScalingGroupList {
+		if sweep.IsTestName(v.AutoScalingGroupName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.AutoScalingGroupNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLaunchConfigurations(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vautoscaling.V2Api.GetLaunchConfigurationList(&vautoscaling.GetLaunchConfigurationListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing launch configurations: %w", err)
+	}
+
+	r := ResourceNcloudLaunchConfiguration()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.LaunchConfigurationList {
+		if sweep.IsTestName(v.LaunchConfigurationName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.LaunchConfigurationNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
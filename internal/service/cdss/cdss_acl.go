@@ -0,0 +1,320 @@
+package cdss
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ resource.Resource                = &cdssACLResource{}
+	_ resource.ResourceWithConfigure   = &cdssACLResource{}
+	_ resource.ResourceWithImportState = &cdssACLResource{}
+)
+
+func NewCDSSACLResource() resource.Resource {
+	return &cdssACLResource{}
+}
+
+type cdssACLResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *cdssACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bootstrapServers, id, ok := parseCDSSImportID(req.ID)
+	idParts := strings.Split(id, "|")
+	if !ok || len(idParts) != 7 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: bootstrap_server[,bootstrap_server...]/resource_type|resource_name|resource_pattern_type|principal|host|operation|permission_type. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_servers"), bootstrapServers)...)
+	for i, attr := range cdssACLIDAttributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), idParts[i])...)
+	}
+}
+
+func (r *cdssACLResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *cdssACLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdss_acl"
+}
+
+func (r *cdssACLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bootstrap_servers": cdssBootstrapServersAttribute(),
+			"tls_ca_cert":       cdssTLSCACertAttribute(),
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("TOPIC", "GROUP", "CLUSTER", "TRANSACTIONAL_ID"),
+				},
+			},
+			"resource_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Use `kafka-cluster` for the CLUSTER resource type.",
+			},
+			"resource_pattern_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("LITERAL"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("LITERAL", "PREFIXED"),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cdssPrincipalRegexp, "Must be in the form of `User:<name>`."),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("*"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE",
+						"CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"),
+				},
+			},
+			"permission_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ALLOW"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALLOW", "DENY"),
+				},
+			},
+		},
+	}
+}
+
+func (r *cdssACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cdssACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := newCDSSKafkaAdmin(ctx, plan.BootstrapServers, plan.TLSCACert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, diags := plan.toACL()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "CreateCDSSACL reqParams="+common.MarshalUncheckedString(acl))
+
+	if err := admin.createACL(ctx, acl); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cdssACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cdssACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := newCDSSKafkaAdmin(ctx, state.BootstrapServers, state.TLSCACert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, diags := state.toACL()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exists, err := admin.aclExists(ctx, acl)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.id())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only moves bootstrap_servers and tls_ca_cert into state, as every
+// other attribute replaces the ACL.
+func (r *cdssACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cdssACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cdssACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cdssACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := newCDSSKafkaAdmin(ctx, state.BootstrapServers, state.TLSCACert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, diags := state.toACL()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DeleteCDSSACL reqParams="+common.MarshalUncheckedString(acl))
+
+	if err := admin.deleteACL(ctx, acl); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+var cdssPrincipalRegexp = regexp.MustCompile(`^User:.+$`)
+
+// cdssACLIDAttributes are the attributes joined with "|", in order, to build the ID.
+var cdssACLIDAttributes = []string{"resource_type", "resource_name", "resource_pattern_type", "principal", "host", "operation", "permission_type"}
+
+type cdssACLResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	BootstrapServers    types.List   `tfsdk:"bootstrap_servers"`
+	TLSCACert           types.String `tfsdk:"tls_ca_cert"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourcePatternType types.String `tfsdk:"resource_pattern_type"`
+	Principal           types.String `tfsdk:"principal"`
+	Host                types.String `tfsdk:"host"`
+	Operation           types.String `tfsdk:"operation"`
+	PermissionType      types.String `tfsdk:"permission_type"`
+}
+
+func (m *cdssACLResourceModel) id() string {
+	return strings.Join([]string{
+		m.ResourceType.ValueString(),
+		m.ResourceName.ValueString(),
+		m.ResourcePatternType.ValueString(),
+		m.Principal.ValueString(),
+		m.Host.ValueString(),
+		m.Operation.ValueString(),
+		m.PermissionType.ValueString(),
+	}, "|")
+}
+
+func (m *cdssACLResourceModel) toACL() (*kafkaACL, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	acl := &kafkaACL{
+		ResourceName: m.ResourceName.ValueString(),
+		Principal:    m.Principal.ValueString(),
+		Host:         m.Host.ValueString(),
+	}
+
+	var err error
+	if acl.ResourceType, err = kmsg.ParseACLResourceType(m.ResourceType.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("resource_type"), "Invalid Attribute Value", err.Error())
+	}
+	if acl.ResourcePatternType, err = kmsg.ParseACLResourcePatternType(m.ResourcePatternType.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("resource_pattern_type"), "Invalid Attribute Value", err.Error())
+	}
+	if acl.Operation, err = kmsg.ParseACLOperation(m.Operation.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("operation"), "Invalid Attribute Value", err.Error())
+	}
+	if acl.PermissionType, err = kmsg.ParseACLPermissionType(m.PermissionType.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("permission_type"), "Invalid Attribute Value", err.Error())
+	}
+
+	return acl, diags
+}
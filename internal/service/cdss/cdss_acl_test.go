@@ -0,0 +1,75 @@
+package cdss_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+func TestAccResourceNcloudCDSSACL_basic(t *testing.T) {
+	broker := mockncp.NewKafkaBroker()
+	defer broker.Close()
+
+	resourceName := "ncloud_cdss_acl.acl"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCDSSACLDestroy(broker),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDSSACLConfig(broker.Addr(), "READ"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCDSSACLCount(broker, 1),
+					resource.TestCheckResourceAttr(resourceName, "id", "TOPIC|orders|PREFIXED|User:consumer|*|READ|ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "host", "*"),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", "ALLOW"),
+				),
+			},
+			{
+				Config: testAccCDSSACLConfig(broker.Addr(), "WRITE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCDSSACLCount(broker, 1),
+					resource.TestCheckResourceAttr(resourceName, "id", "TOPIC|orders|PREFIXED|User:consumer|*|WRITE|ALLOW"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     broker.Addr() + "/TOPIC|orders|PREFIXED|User:consumer|*|WRITE|ALLOW",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCDSSACLCount(broker *mockncp.KafkaBroker, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if count := broker.ACLCount(); count != expected {
+			return fmt.Errorf("expected %d ACLs, got %d", expected, count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCDSSACLDestroy(broker *mockncp.KafkaBroker) resource.TestCheckFunc {
+	return testAccCheckCDSSACLCount(broker, 0)
+}
+
+func testAccCDSSACLConfig(bootstrapServer, operation string) string {
+	return fmt.Sprintf(`
+resource "ncloud_cdss_acl" "acl" {
+	bootstrap_servers     = ["%[1]s"]
+	resource_type         = "TOPIC"
+	resource_name         = "orders"
+	resource_pattern_type = "PREFIXED"
+	principal             = "User:consumer"
+	operation             = "%[2]s"
+}
+`, bootstrapServer, operation)
+}
@@ -206,6 +206,11 @@ func ResourceNcloudCDSSCluster() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -277,6 +282,9 @@ func resourceNcloudCDSSClusterRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("os_image", cluster.SoftwareProductCode)
 	d.Set("vpc_no", strconv.Itoa(int(cluster.VpcNo)))
 	d.Set("config_group_no", strconv.Itoa(int(cluster.ConfigGroupNo)))
+	// Imported resources and states written before deletion_protection
+	// existed have it unset. Storing the default keeps their plan empty.
+	d.Set("deletion_protection", d.Get("deletion_protection").(bool))
 
 	var cList []map[string]interface{}
 	var mList []map[string]interface{}
@@ -503,6 +511,10 @@ func resourceNcloudCDSSClusterDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.FromErr(ErrorDeletionProtected("ncloud_cdss_cluster", d.Id(), "deletion_protection"))
+	}
+
 	if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,428 @@
+package cdss
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ resource.Resource                = &cdssTopicResource{}
+	_ resource.ResourceWithConfigure   = &cdssTopicResource{}
+	_ resource.ResourceWithImportState = &cdssTopicResource{}
+	_ resource.ResourceWithModifyPlan  = &cdssTopicResource{}
+)
+
+func NewCDSSTopicResource() resource.Resource {
+	return &cdssTopicResource{}
+}
+
+type cdssTopicResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *cdssTopicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bootstrapServers, name, ok := parseCDSSImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: bootstrap_server[,bootstrap_server...]/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_servers"), bootstrapServers)...)
+}
+
+func (r *cdssTopicResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *cdssTopicResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdss_topic"
+}
+
+func (r *cdssTopicResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bootstrap_servers": cdssBootstrapServersAttribute(),
+			"tls_ca_cert":       cdssTLSCACertAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(1, 249),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9._-]+$`), "Allows only alphabets, numbers, period (.), underbar (_) and hyphen (-)."),
+					),
+				},
+			},
+			"partitions": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Can only be increased.",
+			},
+			"replication_factor": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32767),
+				},
+			},
+			"config": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Topic level configs such as retention.ms. Configs not listed here use the broker defaults.",
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects removing partitions, which Kafka does not support.
+func (r *cdssTopicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state cdssTopicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Partitions.IsUnknown() || plan.Partitions.IsNull() || state.Partitions.IsNull() {
+		return
+	}
+
+	if plan.Partitions.ValueInt64() < state.Partitions.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("partitions"),
+			"Invalid Partition Count",
+			fmt.Sprintf("`partitions` can only be increased, from %d. Got %d.", state.Partitions.ValueInt64(), plan.Partitions.ValueInt64()),
+		)
+	}
+}
+
+func (r *cdssTopicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cdssTopicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := plan.admin(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topic := &kafkaTopic{
+		Name:              plan.Name.ValueString(),
+		Partitions:        int32(plan.Partitions.ValueInt64()),
+		ReplicationFactor: int16(plan.ReplicationFactor.ValueInt64()),
+	}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &topic.Config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "CreateCDSSTopic reqParams="+common.MarshalUncheckedString(topic))
+
+	if err := admin.createTopic(ctx, topic); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := waitCDSSTopicCreated(ctx, admin, topic.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR CREATION ERROR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cdssTopicResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cdssTopicResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := state.admin(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := admin.describeTopic(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cdssTopicResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state cdssTopicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := plan.admin(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	if !plan.Partitions.Equal(state.Partitions) {
+		tflog.Info(ctx, fmt.Sprintf("CreateCDSSTopicPartitions topic=%s count=%d", name, plan.Partitions.ValueInt64()))
+		if err := admin.createPartitions(ctx, name, int32(plan.Partitions.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	if !plan.Config.Equal(state.Config) {
+		config := map[string]string{}
+		resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "AlterCDSSTopicConfig reqParams="+common.MarshalUncheckedString(config))
+
+		if err := admin.alterTopicConfig(ctx, name, config); err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	output, err := admin.describeTopic(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("topic %s not found after update", name))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *cdssTopicResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cdssTopicResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, diags := state.admin(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	tflog.Info(ctx, "DeleteCDSSTopic topic="+name)
+
+	if err := admin.deleteTopic(ctx, name); err != nil {
+		if isKafkaError(err, kafkaErrUnknownTopicOrPartition) {
+			return
+		}
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	if err := waitCDSSTopicDeleted(ctx, admin, name); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+	}
+}
+
+// Topic creation and deletion finish asynchronously on the brokers, so wait
+// until the metadata agrees.
+func waitCDSSTopicCreated(ctx context.Context, admin *kafkaAdmin, name string) (*kafkaTopic, error) {
+	var topic *kafkaTopic
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"CREATED"},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			topic, err = admin.describeTopic(ctx, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if topic == nil {
+				return 0, "CREATING", nil
+			}
+			return topic, "CREATED", nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		MinTimeout: 1 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return topic, nil
+}
+
+func waitCDSSTopicDeleted(ctx context.Context, admin *kafkaAdmin, name string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			topic, err := admin.describeTopic(ctx, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if topic != nil {
+				return topic, "DELETING", nil
+			}
+			return 0, "DELETED", nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		MinTimeout: 1 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+type cdssTopicResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	BootstrapServers  types.List   `tfsdk:"bootstrap_servers"`
+	TLSCACert         types.String `tfsdk:"tls_ca_cert"`
+	Name              types.String `tfsdk:"name"`
+	Partitions        types.Int64  `tfsdk:"partitions"`
+	ReplicationFactor types.Int64  `tfsdk:"replication_factor"`
+	Config            types.Map    `tfsdk:"config"`
+}
+
+func (m *cdssTopicResourceModel) admin(ctx context.Context) (*kafkaAdmin, diag.Diagnostics) {
+	return newCDSSKafkaAdmin(ctx, m.BootstrapServers, m.TLSCACert)
+}
+
+func (m *cdssTopicResourceModel) refreshFromOutput(ctx context.Context, output *kafkaTopic) diag.Diagnostics {
+	m.ID = types.StringValue(output.Name)
+	m.Name = types.StringValue(output.Name)
+	m.Partitions = types.Int64Value(int64(output.Partitions))
+	m.ReplicationFactor = types.Int64Value(int64(output.ReplicationFactor))
+
+	if len(output.Config) == 0 && m.Config.IsNull() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	m.Config, diags = types.MapValueFrom(ctx, types.StringType, output.Config)
+	return diags
+}
+
+func cdssBootstrapServersAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		Description: "Broker addresses (host:port) used to reach the cluster, e.g. `ncloud_cdss_cluster.endpoints[0].plaintext`.",
+	}
+}
+
+func cdssTLSCACertAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "PEM encoded CA certificate. When set, brokers are reached over TLS.",
+	}
+}
+
+func newCDSSKafkaAdmin(ctx context.Context, bootstrapServers types.List, caCert types.String) (*kafkaAdmin, diag.Diagnostics) {
+	var servers []string
+	diags := bootstrapServers.ElementsAs(ctx, &servers, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	admin, err := newKafkaAdmin(servers, caCert.ValueString())
+	if err != nil {
+		diags.AddError("KAFKA CLIENT ERROR", err.Error())
+		return nil, diags
+	}
+	return admin, diags
+}
+
+// parseCDSSImportID splits "host:port[,host:port...]/name" import identifiers.
+func parseCDSSImportID(id string) ([]string, string, bool) {
+	servers, name, ok := strings.Cut(id, "/")
+	if !ok || servers == "" || name == "" {
+		return nil, "", false
+	}
+	return strings.Split(servers, ","), name, true
+}
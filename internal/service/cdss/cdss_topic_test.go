@@ -0,0 +1,123 @@
+package cdss_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+func TestAccResourceNcloudCDSSTopic_basic(t *testing.T) {
+	broker := mockncp.NewKafkaBroker()
+	defer broker.Close()
+
+	name := fmt.Sprintf("tf-topic-%s", acctest.RandString(5))
+	resourceName := "ncloud_cdss_topic.topic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCDSSTopicDestroy(broker),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDSSTopicConfig(broker.Addr(), name, 1, `"retention.ms" = "3600000"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCDSSTopicExists(broker, name),
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "partitions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.retention.ms", "3600000"),
+				),
+			},
+			{
+				Config: testAccCDSSTopicConfig(broker.Addr(), name, 3, `"cleanup.policy" = "compact"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "partitions", "3"),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.cleanup.policy", "compact"),
+				),
+			},
+			{
+				Config:      testAccCDSSTopicConfig(broker.Addr(), name, 2, `"cleanup.policy" = "compact"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`partitions` can only be increased"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     broker.Addr() + "/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudCDSSTopic_disappears(t *testing.T) {
+	broker := mockncp.NewKafkaBroker()
+	defer broker.Close()
+
+	name := fmt.Sprintf("tf-topic-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCDSSTopicDestroy(broker),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCDSSTopicConfig(broker.Addr(), name, 1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCDSSTopicExists(broker, name),
+					func(*terraform.State) error {
+						broker.RemoveTopic(name)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCDSSTopicExists(broker *mockncp.KafkaBroker, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !broker.HasTopic(name) {
+			return fmt.Errorf("topic %s not found", name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCDSSTopicDestroy(broker *mockncp.KafkaBroker) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ncloud_cdss_topic" {
+				continue
+			}
+			if broker.HasTopic(rs.Primary.ID) {
+				return fmt.Errorf("topic %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCDSSTopicConfig(bootstrapServer, name string, partitions int, config string) string {
+	return fmt.Sprintf(`
+resource "ncloud_cdss_topic" "topic" {
+	bootstrap_servers  = ["%[1]s"]
+	name               = "%[2]s"
+	partitions         = %[3]d
+	replication_factor = 1
+	config = {
+		%[4]s
+	}
+}
+`, bootstrapServer, name, partitions, config)
+}
@@ -0,0 +1,521 @@
+package cdss
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+const (
+	kafkaClientID       = "terraform-provider-ncloud"
+	kafkaRequestTimeout = 30 * time.Second
+
+	kafkaErrUnknownTopicOrPartition int16 = 3
+)
+
+// kafkaError is a non-zero error code returned by a broker.
+type kafkaError struct {
+	Code    int16
+	Message string
+}
+
+func (e *kafkaError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("kafka error code %d: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("kafka error code %d", e.Code)
+}
+
+func newKafkaError(code int16, message *string) error {
+	if code == 0 {
+		return nil
+	}
+	e := &kafkaError{Code: code}
+	if message != nil {
+		e.Message = *message
+	}
+	return e
+}
+
+func isKafkaError(err error, code int16) bool {
+	var e *kafkaError
+	return errors.As(err, &e) && e.Code == code
+}
+
+// kafkaAdmin issues admin requests to the brokers of a CDSS cluster. It speaks
+// the Kafka protocol through kmsg and opens a connection per call, which is
+// plenty for the handful of requests made during a plan or apply.
+type kafkaAdmin struct {
+	bootstrapServers []string
+	tlsConfig        *tls.Config
+}
+
+func newKafkaAdmin(bootstrapServers []string, caCert string) (*kafkaAdmin, error) {
+	if len(bootstrapServers) == 0 {
+		return nil, fmt.Errorf("at least one bootstrap server is required")
+	}
+
+	a := &kafkaAdmin{bootstrapServers: bootstrapServers}
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("tls_ca_cert does not contain a PEM encoded certificate")
+		}
+		a.tlsConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return a, nil
+}
+
+// request sends req to a bootstrap server, or to the controller for requests
+// that must be handled there, and returns the decoded response.
+func (a *kafkaAdmin) request(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
+	conn, err := a.dialAny(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := req.(kmsg.AdminRequest); ok {
+		controller, err := conn.controllerAddr(ctx)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if controller != conn.addr {
+			conn.Close()
+			if conn, err = a.dial(ctx, controller); err != nil {
+				return nil, err
+			}
+		}
+	}
+	defer conn.Close()
+
+	return conn.request(ctx, req)
+}
+
+func (a *kafkaAdmin) dialAny(ctx context.Context) (*kafkaConn, error) {
+	var errs []error
+	for _, addr := range a.bootstrapServers {
+		conn, err := a.dial(ctx, addr)
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, fmt.Errorf("unable to reach any bootstrap server: %w", errors.Join(errs...))
+}
+
+func (a *kafkaAdmin) dial(ctx context.Context, addr string) (*kafkaConn, error) {
+	var d net.Dialer
+	var raw net.Conn
+	var err error
+	if a.tlsConfig != nil {
+		raw, err = (&tls.Dialer{NetDialer: &d, Config: a.tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		raw, err = d.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to %s: %w", addr, err)
+	}
+
+	conn := &kafkaConn{
+		Conn:      raw,
+		addr:      addr,
+		formatter: kmsg.NewRequestFormatter(kmsg.FormatterClientID(kafkaClientID)),
+	}
+	if err := conn.negotiateVersions(ctx); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error negotiating api versions with %s: %w", addr, err)
+	}
+	return conn, nil
+}
+
+type kafkaConn struct {
+	net.Conn
+	addr          string
+	formatter     *kmsg.RequestFormatter
+	correlationID int32
+	maxVersions   map[int16]int16
+}
+
+func (c *kafkaConn) negotiateVersions(ctx context.Context) error {
+	req := kmsg.NewPtrApiVersionsRequest()
+	req.SetVersion(0)
+
+	resp, err := c.roundTrip(ctx, req)
+	if err != nil {
+		return err
+	}
+	versions := resp.(*kmsg.ApiVersionsResponse)
+	if err := newKafkaError(versions.ErrorCode, nil); err != nil {
+		return err
+	}
+
+	c.maxVersions = make(map[int16]int16, len(versions.ApiKeys))
+	for _, k := range versions.ApiKeys {
+		c.maxVersions[k.ApiKey] = k.MaxVersion
+	}
+	return nil
+}
+
+// request picks the highest version both sides support before sending req.
+func (c *kafkaConn) request(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
+	brokerMax, ok := c.maxVersions[req.Key()]
+	if !ok {
+		return nil, fmt.Errorf("broker %s does not support %s", c.addr, kmsg.NameForKey(req.Key()))
+	}
+
+	version := req.MaxVersion()
+	if brokerMax < version {
+		version = brokerMax
+	}
+	req.SetVersion(version)
+
+	return c.roundTrip(ctx, req)
+}
+
+func (c *kafkaConn) roundTrip(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(kafkaRequestTimeout)
+	}
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	c.correlationID++
+	if _, err := c.Write(c.formatter.AppendRequest(nil, req, c.correlationID)); err != nil {
+		return nil, err
+	}
+
+	var size [4]byte
+	if _, err := io.ReadFull(c, size[:]); err != nil {
+		return nil, err
+	}
+	body := make([]byte, binary.BigEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(c, body); err != nil {
+		return nil, err
+	}
+
+	if len(body) < 4 {
+		return nil, fmt.Errorf("short %s response", kmsg.NameForKey(req.Key()))
+	}
+	if id := int32(binary.BigEndian.Uint32(body)); id != c.correlationID {
+		return nil, fmt.Errorf("correlation id mismatch, expected %d, got %d", c.correlationID, id)
+	}
+	body = body[4:]
+
+	resp := req.ResponseKind()
+	// ApiVersions keeps a non-flexible response header so old clients can read it.
+	if resp.IsFlexible() && req.Key() != 18 {
+		var err error
+		if body, err = skipKafkaTags(body); err != nil {
+			return nil, err
+		}
+	}
+	if err := resp.ReadFrom(body); err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", kmsg.NameForKey(req.Key()), err)
+	}
+	return resp, nil
+}
+
+func (c *kafkaConn) controllerAddr(ctx context.Context) (string, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	req.Topics = []kmsg.MetadataRequestTopic{}
+
+	resp, err := c.request(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	metadata := resp.(*kmsg.MetadataResponse)
+	for _, b := range metadata.Brokers {
+		if b.NodeID == metadata.ControllerID {
+			return net.JoinHostPort(b.Host, strconv.Itoa(int(b.Port))), nil
+		}
+	}
+	return "", fmt.Errorf("controller %d not found in cluster metadata", metadata.ControllerID)
+}
+
+// skipKafkaTags drops the tagged fields that end a flexible header.
+func skipKafkaTags(b []byte) ([]byte, error) {
+	num, n := binary.Uvarint(b)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid tagged fields")
+	}
+	b = b[n:]
+	for i := uint64(0); i < num; i++ {
+		if _, n = binary.Uvarint(b); n <= 0 {
+			return nil, fmt.Errorf("invalid tagged fields")
+		}
+		b = b[n:]
+		size, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b)-n) < size {
+			return nil, fmt.Errorf("invalid tagged fields")
+		}
+		b = b[n+int(size):]
+	}
+	return b, nil
+}
+
+// kafkaTopic is a topic as seen by the brokers. Config only holds values set
+// on the topic itself, not broker defaults.
+type kafkaTopic struct {
+	Name              string
+	Partitions        int32
+	ReplicationFactor int16
+	Config            map[string]string
+}
+
+// describeTopic returns nil when the topic does not exist.
+func (a *kafkaAdmin) describeTopic(ctx context.Context, name string) (*kafkaTopic, error) {
+	metadataReq := kmsg.NewPtrMetadataRequest()
+	metadataReq.Topics = []kmsg.MetadataRequestTopic{{Topic: kmsg.StringPtr(name)}}
+	metadataReq.AllowAutoTopicCreation = false
+
+	resp, err := a.request(ctx, metadataReq)
+	if err != nil {
+		return nil, err
+	}
+	metadata := resp.(*kmsg.MetadataResponse)
+	if len(metadata.Topics) != 1 {
+		return nil, fmt.Errorf("unexpected metadata for topic %s", name)
+	}
+	t := metadata.Topics[0]
+	if t.ErrorCode == kafkaErrUnknownTopicOrPartition {
+		return nil, nil
+	}
+	if err := newKafkaError(t.ErrorCode, nil); err != nil {
+		return nil, err
+	}
+
+	topic := &kafkaTopic{
+		Name:       name,
+		Partitions: int32(len(t.Partitions)),
+		Config:     map[string]string{},
+	}
+	for _, p := range t.Partitions {
+		if p.Partition == 0 {
+			topic.ReplicationFactor = int16(len(p.Replicas))
+		}
+	}
+
+	configReq := kmsg.NewPtrDescribeConfigsRequest()
+	configReq.Resources = []kmsg.DescribeConfigsRequestResource{{
+		ResourceType: kmsg.ConfigResourceTypeTopic,
+		ResourceName: name,
+	}}
+
+	resp, err = a.request(ctx, configReq)
+	if err != nil {
+		return nil, err
+	}
+	configs := resp.(*kmsg.DescribeConfigsResponse)
+	for _, r := range configs.Resources {
+		if err := newKafkaError(r.ErrorCode, r.ErrorMessage); err != nil {
+			return nil, err
+		}
+		for _, c := range r.Configs {
+			if c.Value == nil || c.IsSensitive {
+				continue
+			}
+			if c.Source == kmsg.ConfigSourceDynamicTopicConfig || (configs.Version == 0 && !c.IsDefault) {
+				topic.Config[c.Name] = *c.Value
+			}
+		}
+	}
+
+	return topic, nil
+}
+
+func (a *kafkaAdmin) createTopic(ctx context.Context, topic *kafkaTopic) error {
+	t := kmsg.NewCreateTopicsRequestTopic()
+	t.Topic = topic.Name
+	t.NumPartitions = topic.Partitions
+	t.ReplicationFactor = topic.ReplicationFactor
+	for k, v := range topic.Config {
+		c := kmsg.NewCreateTopicsRequestTopicConfig()
+		c.Name = k
+		c.Value = kmsg.StringPtr(v)
+		t.Configs = append(t.Configs, c)
+	}
+
+	req := kmsg.NewPtrCreateTopicsRequest()
+	req.Topics = []kmsg.CreateTopicsRequestTopic{t}
+	req.TimeoutMillis = int32(kafkaRequestTimeout.Milliseconds())
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, t := range resp.(*kmsg.CreateTopicsResponse).Topics {
+		if err := newKafkaError(t.ErrorCode, t.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *kafkaAdmin) createPartitions(ctx context.Context, name string, count int32) error {
+	t := kmsg.NewCreatePartitionsRequestTopic()
+	t.Topic = name
+	t.Count = count
+
+	req := kmsg.NewPtrCreatePartitionsRequest()
+	req.Topics = []kmsg.CreatePartitionsRequestTopic{t}
+	req.TimeoutMillis = int32(kafkaRequestTimeout.Milliseconds())
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, t := range resp.(*kmsg.CreatePartitionsResponse).Topics {
+		if err := newKafkaError(t.ErrorCode, t.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// alterTopicConfig replaces every config set on the topic with config.
+func (a *kafkaAdmin) alterTopicConfig(ctx context.Context, name string, config map[string]string) error {
+	r := kmsg.NewAlterConfigsRequestResource()
+	r.ResourceType = kmsg.ConfigResourceTypeTopic
+	r.ResourceName = name
+	for k, v := range config {
+		c := kmsg.NewAlterConfigsRequestResourceConfig()
+		c.Name = k
+		c.Value = kmsg.StringPtr(v)
+		r.Configs = append(r.Configs, c)
+	}
+
+	req := kmsg.NewPtrAlterConfigsRequest()
+	req.Resources = []kmsg.AlterConfigsRequestResource{r}
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, r := range resp.(*kmsg.AlterConfigsResponse).Resources {
+		if err := newKafkaError(r.ErrorCode, r.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *kafkaAdmin) deleteTopic(ctx context.Context, name string) error {
+	t := kmsg.NewDeleteTopicsRequestTopic()
+	t.Topic = kmsg.StringPtr(name)
+
+	req := kmsg.NewPtrDeleteTopicsRequest()
+	req.TopicNames = []string{name}
+	req.Topics = []kmsg.DeleteTopicsRequestTopic{t}
+	req.TimeoutMillis = int32(kafkaRequestTimeout.Milliseconds())
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, t := range resp.(*kmsg.DeleteTopicsResponse).Topics {
+		if err := newKafkaError(t.ErrorCode, t.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// kafkaACL is a single access control entry.
+type kafkaACL struct {
+	ResourceType        kmsg.ACLResourceType
+	ResourceName        string
+	ResourcePatternType kmsg.ACLResourcePatternType
+	Principal           string
+	Host                string
+	Operation           kmsg.ACLOperation
+	PermissionType      kmsg.ACLPermissionType
+}
+
+func (a *kafkaAdmin) createACL(ctx context.Context, acl *kafkaACL) error {
+	c := kmsg.NewCreateACLsRequestCreation()
+	c.ResourceType = acl.ResourceType
+	c.ResourceName = acl.ResourceName
+	c.ResourcePatternType = acl.ResourcePatternType
+	c.Principal = acl.Principal
+	c.Host = acl.Host
+	c.Operation = acl.Operation
+	c.PermissionType = acl.PermissionType
+
+	req := kmsg.NewPtrCreateACLsRequest()
+	req.Creations = []kmsg.CreateACLsRequestCreation{c}
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, r := range resp.(*kmsg.CreateACLsResponse).Results {
+		if err := newKafkaError(r.ErrorCode, r.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aclExists looks up the exact entry described by acl.
+func (a *kafkaAdmin) aclExists(ctx context.Context, acl *kafkaACL) (bool, error) {
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.ResourceType = acl.ResourceType
+	req.ResourceName = kmsg.StringPtr(acl.ResourceName)
+	req.ResourcePatternType = acl.ResourcePatternType
+	req.Principal = kmsg.StringPtr(acl.Principal)
+	req.Host = kmsg.StringPtr(acl.Host)
+	req.Operation = acl.Operation
+	req.PermissionType = acl.PermissionType
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	described := resp.(*kmsg.DescribeACLsResponse)
+	if err := newKafkaError(described.ErrorCode, described.ErrorMessage); err != nil {
+		return false, err
+	}
+	for _, r := range described.Resources {
+		if len(r.ACLs) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (a *kafkaAdmin) deleteACL(ctx context.Context, acl *kafkaACL) error {
+	f := kmsg.NewDeleteACLsRequestFilter()
+	f.ResourceType = acl.ResourceType
+	f.ResourceName = kmsg.StringPtr(acl.ResourceName)
+	f.ResourcePatternType = acl.ResourcePatternType
+	f.Principal = kmsg.StringPtr(acl.Principal)
+	f.Host = kmsg.StringPtr(acl.Host)
+	f.Operation = acl.Operation
+	f.PermissionType = acl.PermissionType
+
+	req := kmsg.NewPtrDeleteACLsRequest()
+	req.Filters = []kmsg.DeleteACLsRequestFilter{f}
+
+	resp, err := a.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, r := range resp.(*kmsg.DeleteACLsResponse).Results {
+		if err := newKafkaError(r.ErrorCode, r.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
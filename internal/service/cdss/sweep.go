@@ -0,0 +1,81 @@
+//go:build sweep
+
+package cdss
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vcdss"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_cdss_cluster", &resource.Sweeper{
+		Name: "ncloud_cdss_cluster",
+		F:    sweepCDSSClusters,
+	})
+
+	resource.AddTestSweepers("ncloud_cdss_config_group", &resource.Sweeper{
+		Name:         "ncloud_cdss_config_group",
+		F:            sweepCDSSConfigGroups,
+		Dependencies: []string{"ncloud_cdss_cluster"},
+	})
+}
+
+func sweepCDSSClusters(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, _, err := config.Client.Vcdss.V1Api.ClusterGetClusterInfoListPost(context.Background(), vcdss.GetClusterRequest{})
+	if err != nil {
+		return fmt.Errorf("listing cdss clusters: %w", err)
+	}
+
+	r := ResourceNcloudCDSSCluster()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.Result.AllowedClusters {
+		if sweep.IsTestName(&v.ClusterName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, v.ServiceGroupInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepCDSSConfigGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	versions, _, err := config.Client.Vcdss.V1Api.ClusterGetCDSSVersionListGet(context.Background())
+	if err != nil {
+		return fmt.Errorf("listing kafka versions: %w", err)
+	}
+
+	r := ResourceNcloudCDSSConfigGroup()
+	var sweepables []sweep.Sweepable
+	// config groups are only listed per kafka version
+	for _, version := range versions.Result.KafkaVersionList {
+		resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupGetKafkaVersionConfigGroupListPost(context.Background(), vcdss.GetKafkaVersionConfigGroupListRequest{
+			KafkaVersionCode: version.KafkaVersionCode,
+		})
+		if err != nil {
+			return fmt.Errorf("listing cdss config groups of %s: %w", version.KafkaVersionCode, err)
+		}
+
+		for _, v := range resp.Result.KafkaConfigGroupList {
+			if sweep.IsTestName(&v.ConfigGroupName) {
+				sweepables = append(sweepables, sweep.NewSweepResourceByID(r, strconv.Itoa(int(v.ConfigGroupNo)), config))
+			}
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -135,7 +135,7 @@ func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{})
 		return NotSupportVpc("resource `ncloud_load_balancer`")
 	}
 
-	reqParams, err := buildCreateLoadBalancerInstanceParams(d)
+	reqParams, err := buildCreateLoadBalancerInstanceParams(config, d)
 	if err != nil {
 		return err
 	}
@@ -346,8 +346,8 @@ func changeLoadBalancedServerInstances(client *conn.NcloudAPIClient, d *schema.R
 	return nil
 }
 
-func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+func buildCreateLoadBalancerInstanceParams(config *conn.ProviderConfig, d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
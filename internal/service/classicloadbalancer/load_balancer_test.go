@@ -29,7 +29,7 @@ func TestAccNcloudLoadBalancerBasic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "loadbalancer") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckLoadBalancerDestroy,
 		Steps: []resource.TestStep{
@@ -65,7 +65,7 @@ func TestAccNcloudLoadBalancerChangeConfiguration(t *testing.T) {
 	testLoadBalancerName := prefix + "_lb"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "loadbalancer") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckLoadBalancerDestroy,
 		Steps: []resource.TestStep{
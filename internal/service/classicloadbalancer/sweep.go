@@ -0,0 +1,43 @@
+//go:build sweep
+
+package classicloadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_load_balancer", &resource.Sweeper{
+		Name:         "ncloud_load_balancer",
+		F:            sweepLoadBalancers,
+		Dependencies: []string{"ncloud_auto_scaling_group"},
+	})
+}
+
+func sweepLoadBalancers(region string) error {
+	config, err := sweep.SharedRegionalClassicClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Loadbalancer.V2Api.GetLoadBalancerInstanceList(&loadbalancer.GetLoadBalancerInstanceListRequest{RegionNo: &config.RegionNo})
+	if err != nil {
+		return fmt.Errorf("listing load balancers: %w", err)
+	}
+
+	r := ResourceNcloudLoadBalancer()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.LoadBalancerInstanceList {
+		if sweep.IsTestName(v.LoadBalancerName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.LoadBalancerInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -4,18 +4,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudSourceBuildProject(t *testing.T) {
-	name := fmt.Sprintf("test-sourcebuild-project-name-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "build")
+	repoName := RandomName(t, "repo")
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcebuild") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/sourcebuild"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,12 +18,12 @@ import (
 
 func TestAccResourceNcloudSourcebuildProject_basic(t *testing.T) {
 	var project sourcebuild.GetProjectDetailResponse
-	name := fmt.Sprintf("test-sourcebuild-project-basic-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "build")
+	repoName := RandomName(t, "repo")
 	resourceName := "ncloud_sourcebuild_project.test-project"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcebuild") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSourcebuildProjectDestroy,
 		Steps: []resource.TestStep{
@@ -46,12 +45,12 @@ func TestAccResourceNcloudSourcebuildProject_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcebuildProject_update(t *testing.T) {
 	var project sourcebuild.GetProjectDetailResponse
-	name := fmt.Sprintf("test-sourcebuild-project-name-%s", acctest.RandString(5))
-	repoName := fmt.Sprintf("test-repo-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "build")
+	repoName := RandomName(t, "repo")
 	resourceName := "ncloud_sourcebuild_project.test-project"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcebuild") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSourcebuildProjectDestroy,
 		Steps: []resource.TestStep{
@@ -262,6 +262,8 @@ func GetRepositoryById(ctx context.Context, config *conn.ProviderConfig, id stri
 
 func GetRepositories(ctx context.Context, config *conn.ProviderConfig) (*sourcecommit.GetRepositoryListResponse, error) {
 	LogCommonRequest("getRepositories", "")
+	// The SDK sends none of the pageNo and pageSize parameters that the API
+	// documents, so this is the first page only.
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepositories(ctx)
 	if err != nil {
 		LogErrorResponse("getRepositories", err, "")
@@ -12,11 +12,11 @@ import (
 func TestAccDataSourceNcloudSourceCommitRepository(t *testing.T) {
 	dataName := "data.ncloud_sourcecommit_repository.test-repo"
 	resourceName := "ncloud_sourcecommit_repository.test-repo"
-	repositoryName := getTestRepositoryName()
+	repositoryName := getTestRepositoryName(t)
 	repositoryDesc := fmt.Sprintf("description of %v", repositoryName)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcecommit") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -10,9 +10,9 @@ import (
 )
 
 func TestAccDataSourceNcloudSourceDeploySingleScenario(t *testing.T) {
-	stageNameSvr := GetTestSourceDeployScenarioName() + "svr"
+	stageNameSvr := GetTestSourceDeployScenarioName(t) + "svr"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcedeploy") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
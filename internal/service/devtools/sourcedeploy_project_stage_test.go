@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcedeploy"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -31,17 +30,17 @@ const TF_TEST_SD_OBJECTSTORAGE_BUCKET_NAME = "bucket-name"
 
 func TestAccResourceNcloudSourceDeployStage_basic(t *testing.T) {
 	var stage vsourcedeploy.GetStageDetailResponse
-	stageNameSvr := getTestSourceDeployStageName() + "-svr"
-	stageNameAsg := getTestSourceDeployStageName() + "-asg"
-	stageNameNks := getTestSourceDeployStageName() + "-nks"
-	stageNameObj := getTestSourceDeployStageName() + "-obj"
+	stageNameSvr := getTestSourceDeployStageName(t) + "-svr"
+	stageNameAsg := getTestSourceDeployStageName(t) + "-asg"
+	stageNameNks := getTestSourceDeployStageName(t) + "-nks"
+	stageNameObj := getTestSourceDeployStageName(t) + "-obj"
 	resourceNameSvr := "ncloud_sourcedeploy_project_stage.test-stage-svr"
 	resourceNameAsg := "ncloud_sourcedeploy_project_stage.test-stage-asg"
 	resourceNameNks := "ncloud_sourcedeploy_project_stage.test-stage-nks"
 	resourceNameObj := "ncloud_sourcedeploy_project_stage.test-stage-obj"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcedeploy") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSourceDeployStageDestroy,
 		Steps: []resource.TestStep{
@@ -212,8 +211,7 @@ resource "ncloud_sourcedeploy_project_stage" "test-stage-obj" {
 		stageNameSvr, stageNameAsg, stageNameNks, stageNameObj)
 }
 
-func getTestSourceDeployStageName() string {
-	rInt := acctest.RandIntRange(1, 9999)
-	testStageName := fmt.Sprintf("tf-%d-stage", rInt)
+func getTestSourceDeployStageName(t *testing.T) string {
+	testStageName := RandomName(t, "stage")
 	return testStageName
 }
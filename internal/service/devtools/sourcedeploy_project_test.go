@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcedeploy"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,11 +17,11 @@ import (
 
 func TestAccResourceNcloudSourceDeployProject_basic(t *testing.T) {
 	var project vsourcedeploy.GetIdNameResponse
-	name := getTestSourceDeployProjectName()
+	name := getTestSourceDeployProjectName(t)
 	resourceName := "ncloud_sourcedeploy_project.test-project"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcedeploy") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSourceDeployProjectDestroy,
 		Steps: []resource.TestStep{
@@ -92,8 +91,7 @@ func testAccCheckSourceDeployProjectDestroy(s *terraform.State) error {
 	return nil
 }
 
-func getTestSourceDeployProjectName() string {
-	rInt := acctest.RandIntRange(1, 9999)
-	testProjectName := fmt.Sprintf("tf-%d-project", rInt)
+func getTestSourceDeployProjectName(t *testing.T) string {
+	testProjectName := RandomName(t, "deploy")
 	return testProjectName
 }
@@ -14,7 +14,7 @@ func TestAccDataSourceNcloudSourcePipelineProject_classic_basic(t *testing.T) {
 	resourceName := "ncloud_sourcepipeline_project.test-project"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -35,7 +35,7 @@ func TestAccDataSourceNcloudSourcePipelineProject_vpc_basic(t *testing.T) {
 	resourceName := "ncloud_sourcepipeline_project.test-project"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,11 +18,11 @@ import (
 
 func TestAccResourceNcloudSourcePipelineProject_classic_basic(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(false))
@@ -47,11 +46,11 @@ func TestAccResourceNcloudSourcePipelineProject_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcePipelineProject_classic_updateTaskName(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(false))
@@ -84,11 +83,11 @@ func TestAccResourceNcloudSourcePipelineProject_classic_updateTaskName(t *testin
 
 func TestAccResourceNcloudSourcePipelineProject_classic_updateDescription(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(false))
@@ -121,11 +120,11 @@ func TestAccResourceNcloudSourcePipelineProject_classic_updateDescription(t *tes
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_basic(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(true))
@@ -149,11 +148,11 @@ func TestAccResourceNcloudSourcePipelineProject_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_updateTaskName(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(true))
@@ -186,11 +185,11 @@ func TestAccResourceNcloudSourcePipelineProject_vpc_updateTaskName(t *testing.T)
 
 func TestAccResourceNcloudSourcePipelineProject_vpc_updateDescription(t *testing.T) {
 	var project devtools.PipelineProject
-	name := fmt.Sprintf("test-pipeline-name-%s", acctest.RandString(5))
+	name := RandomName(t, "pipeline")
 	resourceName := "ncloud_sourcepipeline_project.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckSourcePipelineProjectDestroy(state, GetTestProvider(true))
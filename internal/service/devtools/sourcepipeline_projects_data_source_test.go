@@ -10,7 +10,7 @@ import (
 
 func TestAccDataSourceNcloudSourcePipelineProjects_classic_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -24,7 +24,7 @@ func TestAccDataSourceNcloudSourcePipelineProjects_classic_basic(t *testing.T) {
 }
 func TestAccDataSourceNcloudSourcePipelineProjects_vpc_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "sourcepipeline") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
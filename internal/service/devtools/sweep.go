@@ -0,0 +1,131 @@
+//go:build sweep
+
+package devtools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_sourcepipeline_project", &resource.Sweeper{
+		Name: "ncloud_sourcepipeline_project",
+		F:    sweepSourcePipelineProjects,
+	})
+
+	resource.AddTestSweepers("ncloud_sourcecommit_repository", &resource.Sweeper{
+		Name:         "ncloud_sourcecommit_repository",
+		F:            sweepSourceCommitRepositories,
+		Dependencies: []string{"ncloud_sourcepipeline_project", "ncloud_sourcebuild_project"},
+	})
+
+	resource.AddTestSweepers("ncloud_sourcebuild_project", &resource.Sweeper{
+		Name:         "ncloud_sourcebuild_project",
+		F:            sweepSourceBuildProjects,
+		Dependencies: []string{"ncloud_sourcepipeline_project"},
+	})
+
+	// stages and scenarios go away with their project
+	resource.AddTestSweepers("ncloud_sourcedeploy_project", &resource.Sweeper{
+		Name:         "ncloud_sourcedeploy_project",
+		F:            sweepSourceDeployProjects,
+		Dependencies: []string{"ncloud_sourcepipeline_project"},
+	})
+}
+
+func sweepSourcePipelineProjects(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	projects, err := getSourcePipelineProjects(ctx, config)
+	if err != nil {
+		return fmt.Errorf("listing sourcepipeline projects: %w", err)
+	}
+
+	r := ResourceNcloudSourcePipeline()
+	var sweepables []sweep.Sweepable
+	for _, v := range projects {
+		if sweep.IsTestName(v.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, strconv.Itoa(int(*v.Id)), config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourceCommitRepositories(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := GetRepositories(ctx, config)
+	if err != nil {
+		return fmt.Errorf("listing sourcecommit repositories: %w", err)
+	}
+
+	r := ResourceNcloudSourceCommitRepository()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.Repository {
+		if sweep.IsTestName(v.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, strconv.Itoa(*v.Id), config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourceBuildProjects(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Sourcebuild.V1Api.GetProjects(ctx, map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("listing sourcebuild projects: %w", err)
+	}
+
+	r := ResourceNcloudSourceBuildProject()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.Project {
+		if sweep.IsTestName(v.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, strconv.Itoa(int(*v.Id)), config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+func sweepSourceDeployProjects(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	projects, err := getSourceDeployProjects(ctx, config)
+	if err != nil {
+		return fmt.Errorf("listing sourcedeploy projects: %w", err)
+	}
+
+	r := ResourceNcloudSourceDeployProject()
+	var sweepables []sweep.Sweepable
+	for _, v := range projects {
+		if sweep.IsTestName(v.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, strconv.Itoa(int(*v.Id)), config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
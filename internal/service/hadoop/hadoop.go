@@ -39,6 +39,7 @@ var (
 	_ resource.Resource                = &hadoopResource{}
 	_ resource.ResourceWithConfigure   = &hadoopResource{}
 	_ resource.ResourceWithImportState = &hadoopResource{}
+	_ resource.ResourceWithModifyPlan  = &hadoopResource{}
 )
 
 func NewHadoopResource() resource.Resource {
@@ -54,9 +55,26 @@ func (r *hadoopResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	adminUserPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[\W_]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&\\"'/\s`+"`"+`]*$`), "Must not have ` & \\ \" ' / and white space."),
+	)
+
+	kdcPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": framework.IDAttribute(),
+			"id":                  framework.IDAttribute(),
+			"deletion_protection": framework.DeletionProtectionAttribute(),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -100,21 +118,21 @@ func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"admin_user_password": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[\W_]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&\\"'/\s`+"`"+`]*$`), "Must not have ` & \\ \" ' / and white space."),
-					),
+					adminUserPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("admin_user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"admin_user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `admin_user_password`, which is not stored in state.",
+				adminUserPasswordValidator,
+			),
+			"admin_user_password_wo_version": framework.WriteOnlyVersionAttribute("admin_user_password_wo", int64planmodifier.RequiresReplace()),
 			"login_key_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -284,15 +302,16 @@ func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, r
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("use_kdc"),
 					}...),
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					kdcPasswordValidator,
+					stringvalidator.ConflictsWith(path.MatchRoot("kdc_password_wo")),
 				},
 			},
+			"kdc_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `kdc_password`, which is not stored in state.",
+				stringvalidator.AlsoRequires(path.MatchRoot("use_kdc")),
+				kdcPasswordValidator,
+			),
+			"kdc_password_wo_version": framework.WriteOnlyVersionAttribute("kdc_password_wo", int64planmodifier.RequiresReplace()),
 			"use_bootstrap_script": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
@@ -429,6 +448,50 @@ func (r *hadoopResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.config = config
 }
 
+// ModifyPlan checks worker_node_count against the limits the API enforces:
+// 2 to 8 workers on creation, and at most 10 added or removed per change.
+func (r *hadoopResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan hadoopResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.WorkerNodeCount.IsUnknown() || plan.WorkerNodeCount.IsNull() {
+		return
+	}
+
+	planCount := plan.WorkerNodeCount.ValueInt64()
+
+	if req.State.Raw.IsNull() {
+		if planCount > 8 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("worker_node_count"),
+				"Invalid Worker Node Count",
+				fmt.Sprintf("`worker_node_count` must be between 2 and 8 on creation, got %d. Add more workers after the cluster is created.", planCount),
+			)
+		}
+		return
+	}
+
+	var state hadoopResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.WorkerNodeCount.IsNull() {
+		return
+	}
+
+	stateCount := state.WorkerNodeCount.ValueInt64()
+	if diff := planCount - stateCount; diff > 10 || diff < -10 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("worker_node_count"),
+			"Invalid Worker Node Count",
+			fmt.Sprintf("`worker_node_count` can change by at most 10 nodes at once, from %d to %d requested.", stateCount, planCount),
+		)
+	}
+}
+
 func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan hadoopResourceModel
 
@@ -445,13 +508,21 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	adminUserPassword, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("admin_user_password_wo"), plan.AdminUserPassword)
+	resp.Diagnostics.Append(diags...)
+	kdcPassword, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("kdc_password_wo"), plan.KdcPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vhadoop.CreateCloudHadoopInstanceRequest{
 		RegionCode:                    &r.config.RegionCode,
 		VpcNo:                         plan.VpcNo.ValueStringPointer(),
 		CloudHadoopClusterName:        plan.ClusterName.ValueStringPointer(),
 		CloudHadoopClusterTypeCode:    plan.ClusterTypeCode.ValueStringPointer(),
 		CloudHadoopAdminUserName:      plan.AdminUserName.ValueStringPointer(),
-		CloudHadoopAdminUserPassword:  plan.AdminUserPassword.ValueStringPointer(),
+		CloudHadoopAdminUserPassword:  adminUserPassword.ValueStringPointer(),
 		LoginKeyName:                  plan.LoginKey.ValueStringPointer(),
 		EdgeNodeSubnetNo:              plan.EdgeNodeSubnetNo.ValueStringPointer(),
 		MasterNodeSubnetNo:            plan.MasterNodeSubnetNo.ValueStringPointer(),
@@ -505,18 +576,18 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		if !plan.KdcPassword.IsNull() {
-			reqParams.KdcPassword = plan.KdcPassword.ValueStringPointer()
+		if !kdcPassword.IsNull() {
+			reqParams.KdcPassword = kdcPassword.ValueStringPointer()
 		} else {
 			resp.Diagnostics.AddError(
 				"CREATING ERROR",
-				"when `use_kdc` is true, `kdc_password` must be entered`",
+				"when `use_kdc` is true, `kdc_password` or `kdc_password_wo` must be entered`",
 			)
 			return
 		}
 	} else {
 		kdcRealmHasValue := !plan.KdcRealm.IsNull() && !plan.KdcRealm.IsUnknown()
-		kdcPasswordHasValue := !plan.KdcPassword.IsNull() && !plan.KdcPassword.IsUnknown()
+		kdcPasswordHasValue := !kdcPassword.IsNull() && !kdcPassword.IsUnknown()
 		if kdcRealmHasValue || kdcPasswordHasValue {
 			resp.Diagnostics.AddError(
 				"CREATING ERROR",
@@ -598,6 +669,10 @@ func (r *hadoopResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	state.refreshFromOutput(ctx, output)
 
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -685,6 +760,8 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 		state.refreshFromOutput(ctx, output)
 	}
 
+	state.DeletionProtection = plan.DeletionProtection
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -696,6 +773,11 @@ func (r *hadoopResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_hadoop", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vhadoop.DeleteCloudHadoopInstanceRequest{
 		RegionCode:            &r.config.RegionCode,
 		CloudHadoopInstanceNo: state.ID.ValueStringPointer(),
@@ -800,7 +882,7 @@ func waitHadoopUpdate(ctx context.Context, config *conn.ProviderConfig, id strin
 				return hadoopInstance, "RUN", nil
 			}
 
-			return 0, "", fmt.Errorf("")
+			return 0, "", fmt.Errorf("error occurred while waiting to update, status: %s, operation: %s", status, op)
 		},
 		Timeout:    6 * conn.DefaultUpdateTimeout,
 		Delay:      2 * time.Second,
@@ -850,11 +932,14 @@ func waitHadoopDeletion(ctx context.Context, config *conn.ProviderConfig, id str
 
 type hadoopResourceModel struct {
 	ID                         types.String `tfsdk:"id"`
+	DeletionProtection         types.Bool   `tfsdk:"deletion_protection"`
 	VpcNo                      types.String `tfsdk:"vpc_no"`
 	ClusterName                types.String `tfsdk:"cluster_name"`
 	ClusterTypeCode            types.String `tfsdk:"cluster_type_code"`
 	AdminUserName              types.String `tfsdk:"admin_user_name"`
 	AdminUserPassword          types.String `tfsdk:"admin_user_password"`
+	AdminUserPasswordWo        types.String `tfsdk:"admin_user_password_wo"`
+	AdminUserPasswordWoVersion types.Int64  `tfsdk:"admin_user_password_wo_version"`
 	LoginKey                   types.String `tfsdk:"login_key_name"`
 	EdgeNodeSubnetNo           types.String `tfsdk:"edge_node_subnet_no"`
 	MasterNodeSubnetNo         types.String `tfsdk:"master_node_subnet_no"`
@@ -874,6 +959,8 @@ type hadoopResourceModel struct {
 	UseKdc                     types.Bool   `tfsdk:"use_kdc"`
 	KdcRealm                   types.String `tfsdk:"kdc_realm"`
 	KdcPassword                types.String `tfsdk:"kdc_password"`
+	KdcPasswordWo              types.String `tfsdk:"kdc_password_wo"`
+	KdcPasswordWoVersion       types.Int64  `tfsdk:"kdc_password_wo_version"`
 	UseBootstrapScript         types.Bool   `tfsdk:"use_bootstrap_script"`
 	BootstrapScript            types.String `tfsdk:"bootstrap_script"`
 	UseDataCatalog             types.Bool   `tfsdk:"use_data_catalog"`
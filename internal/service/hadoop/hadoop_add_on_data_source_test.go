@@ -16,7 +16,7 @@ func TestAccDataSourceNcloudHadoopAddOn_basic(t *testing.T) {
 	clusterTypeCode := "CORE_HADOOP_WITH_SPARK"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
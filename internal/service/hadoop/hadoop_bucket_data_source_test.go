@@ -14,7 +14,7 @@ func TestAccDataSourceNcloudHadoopBucket_basic(t *testing.T) {
 	dataName := "data.ncloud_hadoop_bucket.bucket"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -3,7 +3,6 @@ package hadoop_test
 import (
 	"fmt"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -14,11 +13,11 @@ import (
 func TestAccDataSourceNcloudHadoop_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_hadoop.hadoop"
 	resourceName := "ncloud_hadoop.hadoop"
-	instanceName := fmt.Sprintf("tf-hadoop-%s", randacctest.RandString(3))
+	instanceName := RandomName(t, "hd")
 	bucketName := "hadoop.bucket"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
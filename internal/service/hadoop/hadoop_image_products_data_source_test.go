@@ -13,7 +13,7 @@ func TestAccDataSourceNcloudHadoopImages_basic(t *testing.T) {
 	productName := "Cloud Hadoop 2.1"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -15,7 +15,7 @@ func TestAccDataSourceNcloudHadoopProducts_basic(t *testing.T) {
 	productType := "STAND"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +20,7 @@ import (
 
 func TestAccResourceNcloudHadoop_vpc_update(t *testing.T) {
 	var hadoopInstance vhadoop.CloudHadoopInstance
-	testHadoopName := fmt.Sprintf("tf-hadoop-%s", acctest.RandString(3))
+	testHadoopName := RandomName(t, "hd")
 	resourceName := "ncloud_hadoop.hadoop"
 	bucketName := "hadoop.bucket"
 
@@ -38,7 +38,7 @@ func TestAccResourceNcloudHadoop_vpc_update(t *testing.T) {
 	productCodeAfter := "SVR.VCHDP.MSTDT.HICPU.C008.M016.NET.HDD.B050.G002"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vhadoop") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckHadoopDestroy,
 		Steps: []resource.TestStep{
@@ -64,6 +64,23 @@ func TestAccResourceNcloudHadoop_vpc_update(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudHadoop_vpc_invalidWorkerCount(t *testing.T) {
+	testHadoopName := RandomName(t, "hd")
+	productCode := "SVR.VCHDP.MSTDT.STAND.C004.M016.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHadoopConfigUpdate(testHadoopName, 9, productCode, "hadoop.bucket"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`worker_node_count` must be between 2 and 8 on creation"),
+			},
+		},
+	})
+}
+
 func testAccCheckHadoopExistsWithProvider(n string, hadoop *vhadoop.CloudHadoopInstance, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resource, ok := s.RootModule().Resources[n]
@@ -0,0 +1,43 @@
+//go:build sweep
+
+package hadoop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_hadoop", &resource.Sweeper{
+		Name: "ncloud_hadoop",
+		F:    sweepHadoops,
+	})
+}
+
+func sweepHadoops(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vhadoop.V2Api.GetCloudHadoopInstanceList(&vhadoop.GetCloudHadoopInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing hadoop clusters: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.CloudHadoopInstanceList {
+		if sweep.IsTestName(v.CloudHadoopClusterName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewHadoopResource, map[string]string{
+				"id": *v.CloudHadoopInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
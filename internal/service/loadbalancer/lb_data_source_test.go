@@ -1,21 +1,19 @@
 package loadbalancer_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudLb_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	name := RandomName(t, "lb")
 	dataName := "data.ncloud_lb.test"
 	resourceName := "ncloud_lb.test"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vloadbalancer/createLoadBalancerInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
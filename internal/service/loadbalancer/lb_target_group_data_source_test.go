@@ -1,17 +1,15 @@
 package loadbalancer_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudLbTargetGroup_basic(t *testing.T) {
-	name := fmt.Sprintf("terraform-testacc-tg-%s", acctest.RandString(5))
+	name := RandomName(t, "tg")
 	dataName := "data.ncloud_lb_target_group.test"
 	resourceName := "ncloud_lb_target_group.test"
 
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,7 +16,7 @@ import (
 
 func TestAccResourceNcloudLbTargetGroup_basic(t *testing.T) {
 	var tg loadbalancer.TargetGroup
-	name := fmt.Sprintf("terraform-testacc-tg-%s", acctest.RandString(5))
+	name := RandomName(t, "tg")
 	resourceName := "ncloud_lb_target_group.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -0,0 +1,72 @@
+//go:build sweep
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_lb", &resource.Sweeper{
+		Name: "ncloud_lb",
+		F:    sweepLbs,
+	})
+
+	resource.AddTestSweepers("ncloud_lb_target_group", &resource.Sweeper{
+		Name:         "ncloud_lb_target_group",
+		F:            sweepLbTargetGroups,
+		Dependencies: []string{"ncloud_lb", "ncloud_auto_scaling_group"},
+	})
+}
+
+func sweepLbs(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing load balancers: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.LoadBalancerInstanceList {
+		if sweep.IsTestName(v.LoadBalancerName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewLbResource, map[string]string{
+				"id":               *v.LoadBalancerInstanceNo,
+				"load_balancer_no": *v.LoadBalancerInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLbTargetGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vloadbalancer.V2Api.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing target groups: %w", err)
+	}
+
+	r := ResourceNcloudLbTargetGroup()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.TargetGroupList {
+		if sweep.IsTestName(v.TargetGroupName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.TargetGroupNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -32,9 +32,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &mongodbResource{}
-	_ resource.ResourceWithConfigure   = &mongodbResource{}
-	_ resource.ResourceWithImportState = &mongodbResource{}
+	_ resource.Resource                   = &mongodbResource{}
+	_ resource.ResourceWithConfigure      = &mongodbResource{}
+	_ resource.ResourceWithImportState    = &mongodbResource{}
+	_ resource.ResourceWithValidateConfig = &mongodbResource{}
+	_ resource.ResourceWithModifyPlan     = &mongodbResource{}
 )
 
 func NewMongoDbResource() resource.Resource {
@@ -50,6 +52,14 @@ func (m *mongodbResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -78,7 +88,8 @@ func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					),
 				},
 			},
-			"id": framework.IDAttribute(),
+			"id":                  framework.IDAttribute(),
+			"deletion_protection": framework.DeletionProtectionAttribute(),
 			"user_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -93,22 +104,19 @@ func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Access username, which will be used for DB admin.",
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Description: "Access password for user, which will be used for DB admin.",
 				Sensitive:   true,
 			},
+			"user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `user_password`, which is not stored in state.",
+				userPasswordValidator,
+			),
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -312,6 +320,7 @@ func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -402,6 +411,88 @@ func (m *mongodbResource) Configure(_ context.Context, req resource.ConfigureReq
 	m.config = config
 }
 
+// ValidateConfig rejects topology attributes that the chosen cluster_type_code does not have,
+// so they fail at plan time instead of minutes into the creation.
+func (m *mongodbResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config mongodbResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ClusterTypeCode.IsNull() || config.ClusterTypeCode.IsUnknown() {
+		return
+	}
+
+	clusterTypeCode := config.ClusterTypeCode.ValueString()
+
+	if clusterTypeCode != "SHARDED_CLUSTER" {
+		for _, a := range []struct {
+			name  string
+			value attr.Value
+		}{
+			{"mongos_product_code", config.MongosProductCode},
+			{"config_product_code", config.ConfigProductCode},
+			{"shard_count", config.ShardCount},
+			{"mongos_server_count", config.MongosServerCount},
+			{"config_server_count", config.ConfigServerCount},
+			{"mongos_port", config.MongosPort},
+			{"config_port", config.ConfigPort},
+		} {
+			if !a.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(a.name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("`%s` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER, got %s.", a.name, clusterTypeCode),
+				)
+			}
+		}
+	}
+
+	if clusterTypeCode == "STAND_ALONE" {
+		for _, a := range []struct {
+			name  string
+			value attr.Value
+		}{
+			{"arbiter_product_code", config.ArbiterProductCode},
+			{"member_server_count", config.MemberServerCount},
+			{"arbiter_server_count", config.ArbiterServerCount},
+		} {
+			if !a.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(a.name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("`%s` invalid. Necessary only if the cluster_type_code is SINGLE_REPLICA_SET or SHARDED_CLUSTER.", a.name),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan rejects removing shards, as the API can only add them.
+func (m *mongodbResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state mongodbResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ShardCount.IsUnknown() || plan.ShardCount.IsNull() || state.ShardCount.IsNull() {
+		return
+	}
+
+	if plan.ShardCount.ValueInt64() < state.ShardCount.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("shard_count"),
+			"Invalid Shard Count",
+			fmt.Sprintf("`shard_count` can only be increased, from %d to at most 5. Got %d.", state.ShardCount.ValueInt64(), plan.ShardCount.ValueInt64()),
+		)
+	}
+}
+
 func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan mongodbResourceModel
 
@@ -418,12 +509,18 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vmongodb.CreateCloudMongoDbInstanceRequest{
 		RegionCode:                   &m.config.RegionCode,
 		CloudMongoDbServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudMongoDbServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudMongoDbUserName:         plan.UserName.ValueStringPointer(),
-		CloudMongoDbUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudMongoDbUserPassword:     password.ValueStringPointer(),
 		VpcNo:                        plan.VpcNo.ValueStringPointer(),
 		SubnetNo:                     plan.SubnetNo.ValueStringPointer(),
 		ClusterTypeCode:              plan.ClusterTypeCode.ValueStringPointer(),
@@ -443,90 +540,34 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.ArbiterProductCode.IsNull() && !plan.ArbiterProductCode.IsUnknown() {
-		if *reqParams.ClusterTypeCode == "STAND_ALONE" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`arbiter_product_code` invalid. Necessary only if the cluster_type_code is SINGLE_REPLICA_SET or SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ArbiterProductCode = plan.ArbiterProductCode.ValueStringPointer()
 	}
 
 	if !plan.MongosProductCode.IsNull() && !plan.MongosProductCode.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`mongos_product_code` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.MongosProductCode = plan.MongosProductCode.ValueStringPointer()
 	}
 
 	if !plan.ConfigProductCode.IsNull() && !plan.ConfigProductCode.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`config_product_code` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ConfigProductCode = plan.ConfigProductCode.ValueStringPointer()
 	}
 
 	if !plan.ShardCount.IsNull() && !plan.ShardCount.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`shard_count` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ShardCount = ncloud.Int32(int32(plan.ShardCount.ValueInt64()))
 	}
 
 	if !plan.MemberServerCount.IsNull() && !plan.MemberServerCount.IsUnknown() {
-		if *reqParams.ClusterTypeCode == "STAND_ALONE" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`member_server_count` invalid. Necessary only if the cluster_type_code is SINGLE_REPLICA_SET or SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.MemberServerCount = ncloud.Int32(int32(plan.MemberServerCount.ValueInt64()))
 	}
 
 	if !plan.ArbiterServerCount.IsNull() && !plan.ArbiterServerCount.IsUnknown() {
-		if *reqParams.ClusterTypeCode == "STAND_ALONE" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`arbiter_server_count` invalid. Necessary only if the cluster_type_code is SINGLE_REPLICA_SET or SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ArbiterServerCount = ncloud.Int32(int32(plan.ArbiterServerCount.ValueInt64()))
 	}
 
 	if !plan.MongosServerCount.IsNull() && !plan.MongosServerCount.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`mongos_server_count` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.MongosServerCount = ncloud.Int32(int32(plan.MongosServerCount.ValueInt64()))
 	}
 
 	if !plan.ConfigServerCount.IsNull() && !plan.ConfigServerCount.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`config_server_count` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ConfigServerCount = ncloud.Int32(int32(plan.ConfigServerCount.ValueInt64()))
 	}
 
@@ -543,24 +584,10 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.MongosPort.IsNull() && !plan.MongosPort.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`mongos_port` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.MongosPort = ncloud.Int32(int32(plan.MongosPort.ValueInt64()))
 	}
 
 	if !plan.ConfigPort.IsNull() && !plan.ConfigPort.IsUnknown() {
-		if *reqParams.ClusterTypeCode != "SHARDED_CLUSTER" {
-			resp.Diagnostics.AddError(
-				"CREATING ERROR",
-				"`config_port` invalid. Necessary only if the cluster_type_code is SHARDED_CLUSTER.",
-			)
-			return
-		}
 		reqParams.ConfigPort = ncloud.Int32(int32(plan.ConfigPort.ValueInt64()))
 	}
 
@@ -615,6 +642,10 @@ func (m *mongodbResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	state.refreshFromOutput(ctx, output)
 
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -757,6 +788,24 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 		state.refreshFromOutput(ctx, output)
 	}
 
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) {
+		password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := changeMongoDbUserPassword(ctx, m.config, state.ID.ValueString(), state.UserName.ValueString(), password.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+
+		state.UserPassword = plan.UserPassword
+		state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+	}
+
+	state.DeletionProtection = plan.DeletionProtection
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -768,6 +817,11 @@ func (m *mongodbResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_mongodb", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vmongodb.DeleteCloudMongoDbInstanceRequest{
 		RegionCode:             &m.config.RegionCode,
 		CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
@@ -936,12 +990,15 @@ func waitMongoDbDeleted(ctx context.Context, config *conn.ProviderConfig, id str
 
 type mongodbResourceModel struct {
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 	VpcNo                     types.String `tfsdk:"vpc_no"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
 	ServiceName               types.String `tfsdk:"service_name"`
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ClusterTypeCode           types.String `tfsdk:"cluster_type_code"`
 	ImageProductCode          types.String `tfsdk:"image_product_code"`
 	MemberProductCode         types.String `tfsdk:"member_product_code"`
@@ -0,0 +1,238 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mongodbBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mongodbBackupsDataSource{}
+)
+
+func NewMongoDbBackupsDataSource() datasource.DataSource {
+	return &mongodbBackupsDataSource{}
+}
+
+type mongodbBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mongodbBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodb_backups"
+}
+
+func (d *mongodbBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mongodbBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mongodb_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"backup_parallel": schema.Int64Attribute{
+							Computed: true,
+						},
+						"shard": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mongodbBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mongodbBackupsDataSourceModel
+
+	if !d.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMongoDbBackupDetailList(ctx, d.config, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	backupList := flattenMongoDbBackups(output)
+	filteredList := common.FilterModels(ctx, data.Filters, backupList)
+	if diags := data.refreshFromOutput(ctx, filteredList, data.ID.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		if err := common.WriteToFile(data.OutputFile.ValueString(), convertMongoDbBackupsToJsonStruct(filteredList)); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func GetMongoDbBackupDetailList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmongodb.CloudMongoDbBackupDetail, error) {
+	reqParams := &vmongodb.GetCloudMongoDbBackupDetailListRequest{
+		RegionCode:             &config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetMongoDbBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbBackupDetailList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetMongoDbBackupDetailList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudMongoDbBackupDetailList, nil
+}
+
+type mongodbBackupsDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	MongoDbBackupList types.List   `tfsdk:"mongodb_backup_list"`
+	OutputFile        types.String `tfsdk:"output_file"`
+	Filters           types.Set    `tfsdk:"filter"`
+}
+
+type mongodbBackup struct {
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+	BackupParallel  types.Int64  `tfsdk:"backup_parallel"`
+	Shard           types.String `tfsdk:"shard"`
+}
+
+type mongodbBackupToJsonConvert struct {
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+	BackupParallel  int64  `json:"backup_parallel"`
+	Shard           string `json:"shard"`
+}
+
+func (b mongodbBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+		"backup_parallel":   types.Int64Type,
+		"shard":             types.StringType,
+	}
+}
+
+func convertMongoDbBackupsToJsonStruct(backups []*mongodbBackup) []mongodbBackupToJsonConvert {
+	var backupsToConvert = []mongodbBackupToJsonConvert{}
+
+	for _, b := range backups {
+		backupsToConvert = append(backupsToConvert, mongodbBackupToJsonConvert{
+			StartTime:       b.StartTime.ValueString(),
+			EndTime:         b.EndTime.ValueString(),
+			BackupSize:      b.BackupSize.ValueInt64(),
+			DataStorageSize: b.DataStorageSize.ValueInt64(),
+			BackupParallel:  b.BackupParallel.ValueInt64(),
+			Shard:           b.Shard.ValueString(),
+		})
+	}
+
+	return backupsToConvert
+}
+
+func flattenMongoDbBackups(list []*vmongodb.CloudMongoDbBackupDetail) []*mongodbBackup {
+	var outputs []*mongodbBackup
+
+	for _, v := range list {
+		var output mongodbBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mongodbBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mongodbBackup, id string) diag.Diagnostics {
+	d.ID = types.StringValue(id)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mongodbBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.MongoDbBackupList = backupListValue
+
+	return nil
+}
+
+func (b *mongodbBackup) refreshFromOutput(output *vmongodb.CloudMongoDbBackupDetail) {
+	b.StartTime = types.StringPointerValue(output.StartTime)
+	b.EndTime = types.StringPointerValue(output.EndTime)
+	b.BackupSize = types.Int64PointerValue(output.BackupSize)
+	b.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+	b.BackupParallel = common.Int64ValueFromInt32(output.BackupParallel)
+	b.Shard = types.StringPointerValue(output.Shard)
+}
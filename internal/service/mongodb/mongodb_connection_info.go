@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// NewMongoDbConnectionInfoEphemeralResource points at a mongos server of a
+// sharded cluster, or else at a member server
+var NewMongoDbConnectionInfoEphemeralResource = framework.NewConnectionInfoEphemeralResource("mongodb", "mongodb", getMongoDbConnectionInfo)
+
+func getMongoDbConnectionInfo(ctx context.Context, config *conn.ProviderConfig, no string) (*framework.ConnectionInfo, error) {
+	output, err := GetCloudMongoDbInstance(ctx, config, no)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	var member *framework.ConnectionInfo
+	for _, s := range output.CloudMongoDbServerInstanceList {
+		switch ncloud.StringValue(common.GetCodePtrByCommonCode(s.CloudMongoDbServerRole)) {
+		case "RT":
+			return &framework.ConnectionInfo{
+				Host: ncloud.StringValue(s.PrivateDomain),
+				Port: ncloud.Int32Value(output.MongosPort),
+			}, nil
+		case "A", "MB":
+			if member == nil {
+				member = &framework.ConnectionInfo{
+					Host: ncloud.StringValue(s.PrivateDomain),
+					Port: ncloud.Int32Value(output.MemberPort),
+				}
+			}
+		}
+	}
+
+	return member, nil
+}
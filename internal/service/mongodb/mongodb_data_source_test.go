@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -13,10 +12,10 @@ import (
 func TestAccDataSourceNcloudMongoDb_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_mongodb.by_id"
 	resourceName := "ncloud_mongodb.mongodb"
-	testMongoDbName := fmt.Sprintf("tf-mongodb-%s", randacctest.RandString(4))
+	testMongoDbName := RandomName(t, "mg")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmongodb") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -12,7 +12,7 @@ func TestAccDataSourceNcloudMongoDbImageProducts_basic(t *testing.T) {
 	productType := "LINUX"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vmongodb") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
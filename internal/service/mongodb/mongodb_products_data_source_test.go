@@ -14,7 +14,7 @@ func TestAccDataSourceNcloudMongoDbProducts_basic(t *testing.T) {
 	productType := "STAND"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vmongodb") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
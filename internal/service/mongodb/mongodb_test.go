@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,12 +19,12 @@ import (
 
 func TestAccResourceNcloudMongoDb_vpc_basic(t *testing.T) {
 	var mongodbInstance vmongodb.CloudMongoDbInstance
-	name := fmt.Sprintf("tf-mongodb-%s", sdkacctest.RandString(4))
+	name := RandomName(t, "mg")
 	resourceName := "ncloud_mongodb.mongodb"
 	clusterTypeCode := "STAND_ALONE"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmongodb") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckMongoDbDestroy,
 		Steps: []resource.TestStep{
@@ -46,13 +45,47 @@ func TestAccResourceNcloudMongoDb_vpc_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudMongoDb_vpc_updatePassword(t *testing.T) {
+	var before, after vmongodb.CloudMongoDbInstance
+	name := RandomName(t, "mg")
+	resourceName := "ncloud_mongodb.mongodb"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmongodb") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMongoDbDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDbVpcConfigPassword(name, "t123456789!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDbExists(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t123456789!"),
+				),
+			},
+			{
+				Config: testAccMongoDbVpcConfigPassword(name, "t987654321!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDbExists(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t987654321!"),
+					func(*terraform.State) error {
+						if *before.CloudMongoDbInstanceNo != *after.CloudMongoDbInstanceNo {
+							return fmt.Errorf("mongodb instance was replaced on password change")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudMongoDb_vpc_sharding(t *testing.T) {
 	var mongodbInstance vmongodb.CloudMongoDbInstance
-	name := fmt.Sprintf("tf-mongodb-%s", sdkacctest.RandString(4))
+	name := RandomName(t, "mg")
 	resourceName := "ncloud_mongodb.mongodb"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmongodb") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckMongoDbDestroy,
 		Steps: []resource.TestStep{
@@ -69,6 +102,38 @@ func TestAccResourceNcloudMongoDb_vpc_sharding(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cluster_type_code", "SHARDED_CLUSTER"),
 				),
 			},
+			{
+				Config: testAccMongoDbVpcConfigShard(name, 3, 3, 0, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "3"),
+				),
+			},
+			{
+				Config:      testAccMongoDbVpcConfigShard(name, 2, 3, 0, 2, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`shard_count` can only be increased"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudMongoDb_vpc_invalidTopology(t *testing.T) {
+	name := RandomName(t, "mg")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMongoDbVpcConfigInvalidTopology(name, "SINGLE_REPLICA_SET", "shard_count = 2"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`shard_count` invalid. Necessary only if the cluster_type_code is\\s+SHARDED_CLUSTER"),
+			},
+			{
+				Config:      testAccMongoDbVpcConfigInvalidTopology(name, "STAND_ALONE", "member_server_count = 3"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`member_server_count` invalid"),
+			},
 		},
 	})
 }
@@ -147,6 +212,63 @@ resource "ncloud_mongodb" "mongodb" {
 `, testMongoDbName, clusterTypeCode)
 }
 
+func testAccMongoDbVpcConfigPassword(testMongoDbName string, password string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.0.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.0.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mongodb" "mongodb" {
+	vpc_no = ncloud_vpc.vpc.vpc_no
+	subnet_no = ncloud_subnet.subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "ex-svr"
+	cluster_type_code = "STAND_ALONE"
+	user_name = "testuser"
+	user_password = "%[2]s"
+}
+`, testMongoDbName, password)
+}
+
+func testAccMongoDbVpcConfigInvalidTopology(testMongoDbName string, clusterTypeCode string, extra string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.0.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.0.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mongodb" "mongodb" {
+	vpc_no = ncloud_vpc.vpc.vpc_no
+	subnet_no = ncloud_subnet.subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "ex-svr"
+	cluster_type_code = "%[2]s"
+	user_name = "testuser"
+	user_password = "t123456789!"
+	%[3]s
+}
+`, testMongoDbName, clusterTypeCode, extra)
+}
+
 func testAccMongoDbVpcConfigShard(testMongoDbName string, shardCount int, memberServerCount int, arbiterServerCount int, mongosServerCount int, configServerCount int) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "vpc" {
@@ -0,0 +1,365 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ resource.Resource                = &mongodbUserResource{}
+	_ resource.ResourceWithConfigure   = &mongodbUserResource{}
+	_ resource.ResourceWithImportState = &mongodbUserResource{}
+)
+
+// mongodbInstanceMutexKV serializes user changes, as an instance rejects them while another one is being applied
+var mongodbInstanceMutexKV common.MutexKV
+
+func NewMongoDbUserResource() resource.Resource {
+	return &mongodbUserResource{}
+}
+
+type mongodbUserResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *mongodbUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: mongodb_instance_no:database_name:name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mongodb_instance_no"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+}
+
+func (r *mongodbUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *mongodbUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodb_user"
+}
+
+func (r *mongodbUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mongodb_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(4, 16),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]+$`), "Allows only alphabets, numbers and underbar (_). Must start with an alphabetic character"),
+					),
+				},
+			},
+			"database_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(4, 30),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]+$`), "Allows only alphabets, numbers and underbar (_). Must start with an alphabetic character"),
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(8, 20),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+					),
+				},
+			},
+			"authority": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"READ", "READ_WRITE"}...),
+				},
+			},
+		},
+	}
+}
+
+func (r *mongodbUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mongodbUserResourceModel
+
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := plan.MongodbInstanceNo.ValueString()
+	mongodbInstanceMutexKV.Lock(instanceNo)
+	defer mongodbInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := waitMongoDbCreated(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MONGODB CREATING ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmongodb.AddCloudMongoDbUserListRequest{
+		RegionCode:             &r.config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(instanceNo),
+		CloudMongoDbUserList:   []*vmongodb.AddOrChangeCloudMongoDbUserParameter{plan.toParameter()},
+	}
+
+	response, err := r.config.Client.Vmongodb.V2Api.AddCloudMongoDbUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "AddCloudMongoDbUserList response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	if _, err := waitMongoDbUpdate(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MONGODB CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := GetMongoDbUser(ctx, r.config, instanceNo, plan.DatabaseName.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("mongodb user %s not found after creation", plan.Name.ValueString()))
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *mongodbUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mongodbUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMongoDbUser(ctx, r.config, state.MongodbInstanceNo.ValueString(), state.DatabaseName.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *mongodbUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mongodbUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := state.MongodbInstanceNo.ValueString()
+	mongodbInstanceMutexKV.Lock(instanceNo)
+	defer mongodbInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := waitMongoDbCreated(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MONGODB UPDATE ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmongodb.ChangeCloudMongoDbUserListRequest{
+		RegionCode:             &r.config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(instanceNo),
+		CloudMongoDbUserList:   []*vmongodb.AddOrChangeCloudMongoDbUserParameter{plan.toParameter()},
+	}
+
+	response, err := r.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "ChangeCloudMongoDbUserList response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("UPDATING ERROR", "response invalid")
+		return
+	}
+
+	if _, err := waitMongoDbUpdate(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MONGODB UPDATE ERROR", err.Error())
+		return
+	}
+
+	output, err := GetMongoDbUser(ctx, r.config, instanceNo, plan.DatabaseName.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *mongodbUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mongodbUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := state.MongodbInstanceNo.ValueString()
+	mongodbInstanceMutexKV.Lock(instanceNo)
+	defer mongodbInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := waitMongoDbCreated(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("Unable to delete. Please try again later", err.Error())
+		return
+	}
+
+	reqParams := &vmongodb.DeleteCloudMongoDbUserListRequest{
+		RegionCode:             &r.config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(instanceNo),
+		CloudMongoDbUserList: []*vmongodb.DeleteCloudMongoDbUserParameter{
+			{
+				UserName:     state.Name.ValueStringPointer(),
+				DatabaseName: state.DatabaseName.ValueStringPointer(),
+			},
+		},
+	}
+	tflog.Info(ctx, "DeleteMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vmongodb.V2Api.DeleteCloudMongoDbUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteMongodbUserList response="+common.MarshalUncheckedString(response))
+
+	if _, err := waitMongoDbUpdate(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETION ERROR", err.Error())
+		return
+	}
+}
+
+func GetMongoDbUser(ctx context.Context, config *conn.ProviderConfig, id string, databaseName string, name string) (*vmongodb.CloudMongoDbUser, error) {
+	users, err := GetMongoDbUserAllList(ctx, config, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		if ncloud.StringValue(u.UserName) == name && ncloud.StringValue(u.DatabaseName) == databaseName {
+			return u, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type mongodbUserResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	MongodbInstanceNo types.String `tfsdk:"mongodb_instance_no"`
+	Name              types.String `tfsdk:"name"`
+	DatabaseName      types.String `tfsdk:"database_name"`
+	Password          types.String `tfsdk:"password"`
+	Authority         types.String `tfsdk:"authority"`
+}
+
+func (r *mongodbUserResourceModel) toParameter() *vmongodb.AddOrChangeCloudMongoDbUserParameter {
+	return &vmongodb.AddOrChangeCloudMongoDbUserParameter{
+		UserName:     r.Name.ValueStringPointer(),
+		DatabaseName: r.DatabaseName.ValueStringPointer(),
+		Password:     r.Password.ValueStringPointer(),
+		Authority:    r.Authority.ValueStringPointer(),
+	}
+}
+
+func (r *mongodbUserResourceModel) refreshFromOutput(output *vmongodb.CloudMongoDbUser) {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", r.MongodbInstanceNo.ValueString(), *output.DatabaseName, *output.UserName))
+	r.Name = types.StringPointerValue(output.UserName)
+	r.DatabaseName = types.StringPointerValue(output.DatabaseName)
+	r.Authority = types.StringPointerValue(output.Authority)
+}
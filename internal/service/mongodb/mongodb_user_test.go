@@ -0,0 +1,63 @@
+package mongodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudMongoDbUser_vpc_update(t *testing.T) {
+	testName := RandomName(t, "mg")
+	resourceName := "ncloud_mongodb_user.test"
+	dbResourceName := "ncloud_mongodb.mongodb"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmongodb") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMongoDbDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDbUserConfig(testName, "READ"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "mongodb_instance_no", dbResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "testuser1"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", "testdb1"),
+					resource.TestCheckResourceAttr(resourceName, "authority", "READ"),
+				),
+			},
+			{
+				Config: testAccMongoDbUserConfig(testName, "READ_WRITE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authority", "READ_WRITE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccMongoDbUsersRemoveConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDbUsersNotExists(dbResourceName, GetTestProvider(true)),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDbUserConfig(testName, authority string) string {
+	return testAccMongoDbUsersRemoveConfig(testName) + fmt.Sprintf(`
+resource "ncloud_mongodb_user" "test" {
+	mongodb_instance_no = ncloud_mongodb.mongodb.id
+	name                = "testuser1"
+	database_name       = "testdb1"
+	password            = "t123456789!"
+	authority           = "%[1]s"
+}
+`, authority)
+}
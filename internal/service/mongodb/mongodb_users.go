@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -516,3 +517,56 @@ func deleteUserList(ctx context.Context, config *conn.ProviderConfig, id *string
 	}
 	return nil
 }
+
+// changeMongoDbUserPassword changes the password of an existing user, keeping its database and authority.
+func changeMongoDbUserPassword(ctx context.Context, config *conn.ProviderConfig, id string, name string, password string) error {
+	mongodbInstanceMutexKV.Lock(id)
+	defer mongodbInstanceMutexKV.Unlock(id)
+
+	users, err := GetMongoDbUserAllList(ctx, config, id)
+	if err != nil {
+		return err
+	}
+
+	var user *vmongodb.CloudMongoDbUser
+	for _, u := range users {
+		if ncloud.StringValue(u.UserName) == name {
+			user = u
+			break
+		}
+	}
+
+	if user == nil {
+		return fmt.Errorf("mongodb user %s not found", name)
+	}
+
+	reqParams := &vmongodb.ChangeCloudMongoDbUserListRequest{
+		RegionCode:             &config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(id),
+		CloudMongoDbUserList: []*vmongodb.AddOrChangeCloudMongoDbUserParameter{
+			{
+				UserName:     user.UserName,
+				DatabaseName: user.DatabaseName,
+				Password:     ncloud.String(password),
+				Authority:    user.Authority,
+			},
+		},
+	}
+	tflog.Info(ctx, "ChangeMongoDbUserPassword instanceNo="+id+", userName="+name)
+
+	response, err := config.Client.Vmongodb.V2Api.ChangeCloudMongoDbUserList(reqParams)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "ChangeMongoDbUserPassword response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		return fmt.Errorf("ChangeCloudMongoDbUserList response invalid")
+	}
+
+	if _, err := waitMongoDbUpdate(ctx, config, id); err != nil {
+		return err
+	}
+
+	return nil
+}
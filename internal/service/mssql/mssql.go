@@ -54,9 +54,18 @@ func (m *mssqlResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (m *mssqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": framework.IDAttribute(),
+			"id":                  framework.IDAttribute(),
+			"deletion_protection": framework.DeletionProtectionAttribute(),
 			"subnet_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -97,21 +106,21 @@ func (m *mssqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `user_password`, which is not stored in state.",
+				userPasswordValidator,
+			),
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo", int64planmodifier.RequiresReplace()),
 			"config_group_no": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -315,6 +324,12 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -329,7 +344,7 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		SubnetNo:                  subnet.SubnetNo,
 		CloudMssqlServiceName:     plan.ServiceName.ValueStringPointer(),
 		CloudMssqlUserName:        plan.UserName.ValueStringPointer(),
-		CloudMssqlUserPassword:    plan.UserPassword.ValueStringPointer(),
+		CloudMssqlUserPassword:    password.ValueStringPointer(),
 		IsHa:                      plan.IsHa.ValueBoolPointer(),
 		ConfigGroupNo:             plan.ConfigGroupNo.ValueStringPointer(),
 		BackupFileRetentionPeriod: ncloud.Int32(int32(plan.BackupFileRetentionPeriod.ValueInt64())),
@@ -420,13 +435,29 @@ func (r *mssqlResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	state.refreshFromOutput(ctx, output)
 
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (m *mssqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (m *mssqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mssqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeletionProtection = plan.DeletionProtection
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *mssqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -437,6 +468,11 @@ func (r *mssqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_mssql", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vmssql.DeleteCloudMssqlInstanceRequest{
 		RegionCode:           &r.config.RegionCode,
 		CloudMssqlInstanceNo: state.ID.ValueStringPointer(),
@@ -559,11 +595,14 @@ func waitMssqlDeletion(ctx context.Context, config *conn.ProviderConfig, id stri
 
 type mssqlResourceModel struct {
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
 	ServiceName               types.String `tfsdk:"service_name"`
 	IsHa                      types.Bool   `tfsdk:"is_ha"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ConfigGroupNo             types.String `tfsdk:"config_group_no"`
 	ImageProductCode          types.String `tfsdk:"image_product_code"`
 	ProductCode               types.String `tfsdk:"product_code"`
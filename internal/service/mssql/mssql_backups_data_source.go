@@ -0,0 +1,252 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mssqlBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mssqlBackupsDataSource{}
+)
+
+func NewMssqlBackupsDataSource() datasource.DataSource {
+	return &mssqlBackupsDataSource{}
+}
+
+type mssqlBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mssqlBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mssql_backups"
+}
+
+func (d *mssqlBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mssqlBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"mssql_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mssql_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"full_backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"log_backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"log_backup_count": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mssqlBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mssqlBackupsDataSourceModel
+
+	if !d.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMssqlBackupDetailAllList(ctx, d.config, data.MssqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	backupList := flattenMssqlBackups(output)
+	filteredList := common.FilterModels(ctx, data.Filters, backupList)
+	if diags := data.refreshFromOutput(ctx, filteredList, data.MssqlInstanceNo.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		if err := common.WriteToFile(data.OutputFile.ValueString(), convertMssqlBackupsToJsonStruct(filteredList)); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func GetMssqlBackupDetailAllList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmssql.CloudMssqlBackupDetail, error) {
+	allBackups, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vmssql.CloudMssqlBackupDetail, *int32, error) {
+		reqParams := &vmssql.GetCloudMssqlBackupDetailListRequest{
+			RegionCode:           &config.RegionCode,
+			CloudMssqlInstanceNo: ncloud.String(id),
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		}
+		tflog.Info(ctx, "GetMssqlBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+		resp, err := config.Client.Vmssql.V2Api.GetCloudMssqlBackupDetailList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp == nil {
+			return nil, nil, nil
+		}
+
+		return resp.CloudMssqlBackupDetailList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetMssqlBackupDetailList response="+common.MarshalUncheckedString(allBackups))
+
+	return allBackups, nil
+}
+
+type mssqlBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MssqlInstanceNo types.String `tfsdk:"mssql_instance_no"`
+	MssqlBackupList types.List   `tfsdk:"mssql_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type mssqlBackup struct {
+	DatabaseName   types.String `tfsdk:"database_name"`
+	StartTime      types.String `tfsdk:"start_time"`
+	EndTime        types.String `tfsdk:"end_time"`
+	FullBackupSize types.Int64  `tfsdk:"full_backup_size"`
+	LogBackupSize  types.Int64  `tfsdk:"log_backup_size"`
+	LogBackupCount types.Int64  `tfsdk:"log_backup_count"`
+}
+
+type mssqlBackupToJsonConvert struct {
+	DatabaseName   string `json:"database_name"`
+	StartTime      string `json:"start_time"`
+	EndTime        string `json:"end_time"`
+	FullBackupSize int64  `json:"full_backup_size"`
+	LogBackupSize  int64  `json:"log_backup_size"`
+	LogBackupCount int64  `json:"log_backup_count"`
+}
+
+func (b mssqlBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"database_name":    types.StringType,
+		"start_time":       types.StringType,
+		"end_time":         types.StringType,
+		"full_backup_size": types.Int64Type,
+		"log_backup_size":  types.Int64Type,
+		"log_backup_count": types.Int64Type,
+	}
+}
+
+func convertMssqlBackupsToJsonStruct(backups []*mssqlBackup) []mssqlBackupToJsonConvert {
+	var backupsToConvert = []mssqlBackupToJsonConvert{}
+
+	for _, b := range backups {
+		backupsToConvert = append(backupsToConvert, mssqlBackupToJsonConvert{
+			DatabaseName:   b.DatabaseName.ValueString(),
+			StartTime:      b.StartTime.ValueString(),
+			EndTime:        b.EndTime.ValueString(),
+			FullBackupSize: b.FullBackupSize.ValueInt64(),
+			LogBackupSize:  b.LogBackupSize.ValueInt64(),
+			LogBackupCount: b.LogBackupCount.ValueInt64(),
+		})
+	}
+
+	return backupsToConvert
+}
+
+func flattenMssqlBackups(list []*vmssql.CloudMssqlBackupDetail) []*mssqlBackup {
+	var outputs []*mssqlBackup
+
+	for _, v := range list {
+		var output mssqlBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mssqlBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mssqlBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.MssqlInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mssqlBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.MssqlBackupList = backupListValue
+
+	return nil
+}
+
+func (b *mssqlBackup) refreshFromOutput(output *vmssql.CloudMssqlBackupDetail) {
+	b.DatabaseName = types.StringPointerValue(output.DatabaseName)
+	b.StartTime = types.StringPointerValue(output.StartTime)
+	b.EndTime = types.StringPointerValue(output.EndTime)
+	b.FullBackupSize = types.Int64PointerValue(output.FullBackupSize)
+	b.LogBackupSize = types.Int64PointerValue(output.LogBackupSize)
+	b.LogBackupCount = common.Int64ValueFromInt32(output.LogBackupCount)
+}
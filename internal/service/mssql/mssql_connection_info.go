@@ -0,0 +1,34 @@
+package mssql
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// NewMssqlConnectionInfoEphemeralResource points at the principal server of an instance
+var NewMssqlConnectionInfoEphemeralResource = framework.NewConnectionInfoEphemeralResource("mssql", "sqlserver", getMssqlConnectionInfo)
+
+func getMssqlConnectionInfo(ctx context.Context, config *conn.ProviderConfig, no string) (*framework.ConnectionInfo, error) {
+	output, err := GetMssqlInstance(ctx, config, no)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	server := output.CloudMssqlServerInstanceList[0]
+	for _, s := range output.CloudMssqlServerInstanceList {
+		if ncloud.StringValue(common.GetCodePtrByCommonCode(s.CloudMssqlServerRole)) == "M" {
+			server = s
+			break
+		}
+	}
+
+	return &framework.ConnectionInfo{
+		Host: ncloud.StringValue(server.PrivateDomain),
+		Port: ncloud.Int32Value(output.CloudMssqlPort),
+	}, nil
+}
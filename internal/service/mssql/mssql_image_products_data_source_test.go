@@ -12,7 +12,7 @@ func TestAccDataSourceNcloudMssqlImageProducts_basic(t *testing.T) {
 	productType := "WINNT"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vmssql") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
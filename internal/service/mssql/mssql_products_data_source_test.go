@@ -13,7 +13,7 @@ func TestAccDataSourceNcloudMssqlProducts_basic(t *testing.T) {
 	productType := "STAND"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vmssql") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -7,7 +7,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,11 +18,11 @@ import (
 
 func TestAccResourceNcloudMssql_vpc_basic(t *testing.T) {
 	var mssqlInstance vmssql.CloudMssqlInstance
-	testMssqlName := fmt.Sprintf("tf-mssql-%s", acctest.RandString(5))
+	testMssqlName := RandomName(t, "ms")
 	resourceName := "ncloud_mssql.mssql"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmssql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckCloudMssqlDestroy(state, GetTestProvider(true))
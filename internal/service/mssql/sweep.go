@@ -0,0 +1,56 @@
+//go:build sweep
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_mssql", &resource.Sweeper{
+		Name: "ncloud_mssql",
+		F:    sweepMssqls,
+	})
+}
+
+func sweepMssqls(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	instances, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vmssql.CloudMssqlInstance, *int32, error) {
+		resp, err := config.Client.Vmssql.V2Api.GetCloudMssqlInstanceList(&vmssql.GetCloudMssqlInstanceListRequest{
+			RegionCode: &config.RegionCode,
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CloudMssqlInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing mssql instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range instances {
+		if sweep.IsTestName(v.CloudMssqlServiceName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewMssqlResource, map[string]string{
+				"id": *v.CloudMssqlInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
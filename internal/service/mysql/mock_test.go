@@ -0,0 +1,13 @@
+package mysql
+
+import (
+	"os"
+)
+
+func init() {
+	// The mock server applies changes at once
+	if os.Getenv("NCLOUD_MOCK") != "" {
+		mysqlCreationDelay = 0
+		mysqlDeletionDelay = 0
+	}
+}
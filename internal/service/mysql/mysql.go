@@ -64,6 +64,14 @@ func (m *mysqlResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -79,7 +87,8 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					),
 				},
 			},
-			"id": framework.IDAttribute(),
+			"id":                  framework.IDAttribute(),
+			"deletion_protection": framework.DeletionProtectionAttribute(),
 			"server_name_prefix": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -107,21 +116,18 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `user_password`, which is not stored in state.",
+				userPasswordValidator,
+			),
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"host_ip": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -177,6 +183,7 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 				Description: "default: true",
@@ -209,6 +216,7 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 				Description: "default: true",
@@ -275,6 +283,7 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -391,6 +400,12 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -404,7 +419,7 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		CloudMysqlServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudMysqlServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudMysqlUserName:         plan.UserName.ValueStringPointer(),
-		CloudMysqlUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudMysqlUserPassword:     password.ValueStringPointer(),
 		HostIp:                     plan.HostIp.ValueStringPointer(),
 		CloudMysqlDatabaseName:     plan.DatabaseName.ValueStringPointer(),
 		VpcNo:                      subnet.VpcNo,
@@ -590,13 +605,58 @@ func (r *mysqlResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Imported resources and states written before deletion_protection
+	// existed have it unset
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (m *mysqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *mysqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mysqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) {
+		password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := changeMysqlUserPassword(ctx, r.config, state.ID.ValueString(), state.UserName.ValueString(), password.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	output, err := GetMysqlInstance(ctx, r.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, output); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -607,6 +667,11 @@ func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_mysql", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vmysql.DeleteCloudMysqlInstanceRequest{
 		RegionCode:           &r.config.RegionCode,
 		CloudMysqlInstanceNo: state.ID.ValueStringPointer(),
@@ -649,6 +714,13 @@ func GetMysqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 	return resp.CloudMysqlInstanceList[0], nil
 }
 
+// The API keeps reporting the previous state for a while after a change,
+// so waiters start polling after these delays
+var (
+	mysqlCreationDelay = 3 * time.Minute
+	mysqlDeletionDelay = 1 * time.Minute
+)
+
 func waitMysqlCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vmysql.CloudMysqlInstance, error) {
 	var mysqlInstance *vmysql.CloudMysqlInstance
 	stateConf := &retry.StateChangeConf{
@@ -679,7 +751,7 @@ func waitMysqlCreation(ctx context.Context, config *conn.ProviderConfig, id stri
 			return 0, "", fmt.Errorf("error occurred while waiting to create mysql")
 		},
 		Timeout:    6 * conn.DefaultTimeout,
-		Delay:      3 * time.Minute,
+		Delay:      mysqlCreationDelay,
 		MinTimeout: 3 * time.Second,
 	}
 	_, err := stateConf.WaitForState()
@@ -714,7 +786,7 @@ func waitMysqlDeletion(ctx context.Context, config *conn.ProviderConfig, id stri
 			return 0, "", fmt.Errorf("error occurred while waiting to delete mysql")
 		},
 		Timeout:    conn.DefaultTimeout,
-		Delay:      1 * time.Minute,
+		Delay:      mysqlDeletionDelay,
 		MinTimeout: 3 * time.Second,
 	}
 
@@ -727,10 +799,13 @@ func waitMysqlDeletion(ctx context.Context, config *conn.ProviderConfig, id stri
 
 type mysqlResourceModel struct {
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 	ServiceName               types.String `tfsdk:"service_name"`
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	HostIp                    types.String `tfsdk:"host_ip"`
 	DatabaseName              types.String `tfsdk:"database_name"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
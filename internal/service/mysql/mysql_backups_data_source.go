@@ -0,0 +1,244 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mysqlBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mysqlBackupsDataSource{}
+)
+
+func NewMysqlBackupsDataSource() datasource.DataSource {
+	return &mysqlBackupsDataSource{}
+}
+
+type mysqlBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mysqlBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_backups"
+}
+
+func (d *mysqlBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mysqlBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"mysql_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mysql_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mysqlBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mysqlBackupsDataSourceModel
+
+	if !d.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMysqlBackupDetailAllList(ctx, d.config, data.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	backupList := flattenMysqlBackups(output)
+	filteredList := common.FilterModels(ctx, data.Filters, backupList)
+	if diags := data.refreshFromOutput(ctx, filteredList, data.MysqlInstanceNo.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		if err := common.WriteToFile(data.OutputFile.ValueString(), convertMysqlBackupsToJsonStruct(filteredList)); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func GetMysqlBackupDetailAllList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmysql.CloudMysqlBackupDetail, error) {
+	allBackups, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vmysql.CloudMysqlBackupDetail, *int32, error) {
+		reqParams := &vmysql.GetCloudMysqlBackupDetailListRequest{
+			RegionCode:           &config.RegionCode,
+			CloudMysqlInstanceNo: ncloud.String(id),
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		}
+		tflog.Info(ctx, "GetMysqlBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlBackupDetailList(reqParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp == nil {
+			return nil, nil, nil
+		}
+
+		return resp.CloudMysqlBackupDetailList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetMysqlBackupDetailList response="+common.MarshalUncheckedString(allBackups))
+
+	return allBackups, nil
+}
+
+type mysqlBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MysqlInstanceNo types.String `tfsdk:"mysql_instance_no"`
+	MysqlBackupList types.List   `tfsdk:"mysql_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type mysqlBackup struct {
+	FileName        types.String `tfsdk:"file_name"`
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+}
+
+type mysqlBackupToJsonConvert struct {
+	FileName        string `json:"file_name"`
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+}
+
+func (b mysqlBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name":         types.StringType,
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+	}
+}
+
+func convertMysqlBackupsToJsonStruct(backups []*mysqlBackup) []mysqlBackupToJsonConvert {
+	var backupsToConvert = []mysqlBackupToJsonConvert{}
+
+	for _, b := range backups {
+		backupsToConvert = append(backupsToConvert, mysqlBackupToJsonConvert{
+			FileName:        b.FileName.ValueString(),
+			StartTime:       b.StartTime.ValueString(),
+			EndTime:         b.EndTime.ValueString(),
+			BackupSize:      b.BackupSize.ValueInt64(),
+			DataStorageSize: b.DataStorageSize.ValueInt64(),
+		})
+	}
+
+	return backupsToConvert
+}
+
+func flattenMysqlBackups(list []*vmysql.CloudMysqlBackupDetail) []*mysqlBackup {
+	var outputs []*mysqlBackup
+
+	for _, v := range list {
+		var output mysqlBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mysqlBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mysqlBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.MysqlInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mysqlBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.MysqlBackupList = backupListValue
+
+	return nil
+}
+
+func (b *mysqlBackup) refreshFromOutput(output *vmysql.CloudMysqlBackupDetail) {
+	b.FileName = types.StringPointerValue(output.FileName)
+	b.StartTime = types.StringPointerValue(output.StartTime)
+	b.EndTime = types.StringPointerValue(output.EndTime)
+	b.BackupSize = types.Int64PointerValue(output.BackupSize)
+	b.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+}
@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// NewMysqlConnectionInfoEphemeralResource points at the master server of an instance
+var NewMysqlConnectionInfoEphemeralResource = framework.NewConnectionInfoEphemeralResource("mysql", "mysql", getMysqlConnectionInfo)
+
+func getMysqlConnectionInfo(ctx context.Context, config *conn.ProviderConfig, no string) (*framework.ConnectionInfo, error) {
+	output, err := GetMysqlInstance(ctx, config, no)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	server := output.CloudMysqlServerInstanceList[0]
+	for _, s := range output.CloudMysqlServerInstanceList {
+		if ncloud.StringValue(common.GetCodePtrByCommonCode(s.CloudMysqlServerRole)) == "M" {
+			server = s
+			break
+		}
+	}
+
+	return &framework.ConnectionInfo{
+		Host: ncloud.StringValue(server.PrivateDomain),
+		Port: ncloud.Int32Value(output.CloudMysqlPort),
+	}, nil
+}
@@ -0,0 +1,147 @@
+package mysql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+	mysqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+)
+
+func TestMysqlConnectionInfoEphemeralResource_open(t *testing.T) {
+	ctx := context.Background()
+
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := config.NewClient(conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	vpcResp, err := config.Client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    ncloud.String("KR"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("creating vpc: %s", err)
+	}
+	vpcNo := vpcResp.VpcList[0].VpcNo
+
+	aclResp, err := config.Client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{
+		RegionCode: ncloud.String("KR"),
+		VpcNo:      vpcNo,
+	})
+	if err != nil {
+		t.Fatalf("reading network acls: %s", err)
+	}
+
+	subnetResp, err := config.Client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		RegionCode:     ncloud.String("KR"),
+		ZoneCode:       ncloud.String("KR-1"),
+		VpcNo:          vpcNo,
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   aclResp.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PRIVATE"),
+	})
+	if err != nil {
+		t.Fatalf("creating subnet: %s", err)
+	}
+
+	mysqlResp, err := config.Client.Vmysql.V2Api.CreateCloudMysqlInstance(&vmysql.CreateCloudMysqlInstanceRequest{
+		RegionCode:                 ncloud.String("KR"),
+		VpcNo:                      vpcNo,
+		SubnetNo:                   subnetResp.SubnetList[0].SubnetNo,
+		CloudMysqlServiceName:      ncloud.String("tf-acc-mysql"),
+		CloudMysqlServerNamePrefix: ncloud.String("tf-acc"),
+		CloudMysqlUserName:         ncloud.String("testuser"),
+		CloudMysqlUserPassword:     ncloud.String("Password1!"),
+		HostIp:                     ncloud.String("%"),
+		CloudMysqlDatabaseName:     ncloud.String("testdb"),
+		CloudMysqlPort:             ncloud.Int32(3307),
+	})
+	if err != nil {
+		t.Fatalf("creating mysql: %s", err)
+	}
+	instance := mysqlResp.CloudMysqlInstanceList[0]
+
+	e := mysqlservice.NewMysqlConnectionInfoEphemeralResource()
+	var configureResp ephemeral.ConfigureResponse
+	e.(ephemeral.EphemeralResourceWithConfigure).Configure(ctx, ephemeral.ConfigureRequest{ProviderData: config}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configuring: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	open := func(id string, userName, password interface{}) (map[string]tftypes.Value, bool) {
+		t.Helper()
+
+		resp := ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+		}
+		e.Open(ctx, ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":        tftypes.NewValue(tftypes.String, id),
+					"user_name": tftypes.NewValue(tftypes.String, userName),
+					"password":  tftypes.NewValue(tftypes.String, password),
+					"host":      tftypes.NewValue(tftypes.String, nil),
+					"port":      tftypes.NewValue(tftypes.Number, nil),
+					"uri":       tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		}, &resp)
+		if resp.Diagnostics.HasError() {
+			return nil, false
+		}
+
+		var result map[string]tftypes.Value
+		if err := resp.Result.Raw.As(&result); err != nil {
+			t.Fatalf("reading result: %s", err)
+		}
+		return result, true
+	}
+
+	host := ncloud.StringValue(instance.CloudMysqlServerInstanceList[0].PrivateDomain)
+
+	result, ok := open(ncloud.StringValue(instance.CloudMysqlInstanceNo), "testuser", "p@ss/word")
+	if !ok {
+		t.Fatal("expected the instance to be found")
+	}
+	if !result["host"].Equal(tftypes.NewValue(tftypes.String, host)) {
+		t.Errorf("expected host %s, got %s", host, result["host"])
+	}
+	if !result["port"].Equal(tftypes.NewValue(tftypes.Number, 3307)) {
+		t.Errorf("expected port 3307, got %s", result["port"])
+	}
+	if expected := "mysql://testuser:p%40ss%2Fword@" + host + ":3307"; !result["uri"].Equal(tftypes.NewValue(tftypes.String, expected)) {
+		t.Errorf("expected uri %s, got %s", expected, result["uri"])
+	}
+
+	result, ok = open(ncloud.StringValue(instance.CloudMysqlInstanceNo), nil, nil)
+	if !ok {
+		t.Fatal("expected the instance to be found")
+	}
+	if expected := "mysql://" + host + ":3307"; !result["uri"].Equal(tftypes.NewValue(tftypes.String, expected)) {
+		t.Errorf("expected uri %s without credentials, got %s", expected, result["uri"])
+	}
+
+	if _, ok := open("0", nil, nil); ok {
+		t.Error("expected an error for an instance that does not exist")
+	}
+}
@@ -3,7 +3,6 @@ package mysql_test
 import (
 	"fmt"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -21,7 +20,7 @@ func TestAccDataSourceNcloudMysql_vpc_basic(t *testing.T) {
 
 	dataName := "data.ncloud_mysql.by_id"
 	resourceName := "ncloud_mysql.mysql"
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", randacctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
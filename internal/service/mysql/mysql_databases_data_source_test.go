@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -16,7 +15,7 @@ func TestAccDataSourceNcloudMysqlDatabases_vpc_basic(t *testing.T) {
 	t.Skip()
 
 	dataName := "data.ncloud_mysql_databases.all"
-	testName := fmt.Sprintf("tf-mysqldb-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
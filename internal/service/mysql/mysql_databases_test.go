@@ -7,7 +7,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -22,7 +21,7 @@ func TestAccResourceNcloudMysqlDatabases_vpc_basic(t *testing.T) {
 	*/
 	t.Skip()
 
-	testName := fmt.Sprintf("tf-mysqldb-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 	resourceName := "ncloud_mysql_databases.mysql_dbs"
 
 	resource.Test(t, resource.TestCase{
@@ -12,7 +12,10 @@ func TestAccDataSourceNcloudMysqlImageProducts_basic(t *testing.T) {
 	productType := "LINUX"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheckMock(t, "vmysql/getCloudMysqlImageProductList")
+		},
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,12 +19,12 @@ import (
 
 func TestAccResourceNcloudMysqlRecovery_vpc_basic(t *testing.T) {
 	var mysqlServerInstance vmysql.CloudMysqlServerInstance
-	testName := fmt.Sprintf("tf-mysqlsv-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 	resourceName := "ncloud_mysql_recovery.mysql_recovery"
 	testDate := time.Now().Format("20060102")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmysql/createCloudMysqlRecoveryInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckMysqlRecoveryDestroy,
 		Steps: []resource.TestStep{
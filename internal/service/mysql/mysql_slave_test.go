@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -18,11 +17,11 @@ import (
 
 func TestAccResourceNcloudMysqlSlave_vpc_basic(t *testing.T) {
 	var mysqlServerInstance vmysql.CloudMysqlServerInstance
-	testName := fmt.Sprintf("tf-mysqlsv-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 	resourceName := "ncloud_mysql_slave.mysql_slave"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vmysql/createCloudMysqlSlaveInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckMysqlSlaveDestroy,
 		Steps: []resource.TestStep{
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -43,6 +42,80 @@ func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "is_storage_encryption", "false"),
 					resource.TestCheckResourceAttr(resourceName, "is_backup", "true"),
 					resource.TestCheckResourceAttr(resourceName, "backup_file_retention_period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudMysql_vpc_updatePassword(t *testing.T) {
+	var before, after vmysql.CloudMysqlInstance
+	testMysqlName := RandomName(t, "my")
+	resourceName := "ncloud_mysql.mysql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMysqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlVpcConfigPassword(testMysqlName, "t123456789!a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMysqlExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t123456789!a"),
+				),
+			},
+			{
+				Config: testAccMysqlVpcConfigPassword(testMysqlName, "t987654321!b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMysqlExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t987654321!b"),
+					func(*terraform.State) error {
+						if *before.CloudMysqlInstanceNo != *after.CloudMysqlInstanceNo {
+							return fmt.Errorf("mysql instance was replaced on password change")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudMysql_vpc_deletionProtection(t *testing.T) {
+	var before, after vmysql.CloudMysqlInstance
+	testMysqlName := RandomName(t, "my")
+	resourceName := "ncloud_mysql.mysql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMysqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlVpcConfigDeletionProtection(testMysqlName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMysqlExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccMysqlVpcConfigDeletionProtection(testMysqlName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is protected from deletion"),
+			},
+			{
+				Config: testAccMysqlVpcConfigDeletionProtection(testMysqlName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMysqlExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					func(*terraform.State) error {
+						if *before.CloudMysqlInstanceNo != *after.CloudMysqlInstanceNo {
+							return fmt.Errorf("mysql instance was replaced on deletion_protection change")
+						}
+						return nil
+					},
 				),
 			},
 		},
@@ -51,7 +124,7 @@ func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_isHa(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -76,7 +149,7 @@ func TestAccResourceNcloudMysql_vpc_isHa(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_isHa_options(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -101,7 +174,7 @@ func TestAccResourceNcloudMysql_vpc_isHa_options(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_auto_backup(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -125,7 +198,7 @@ func TestAccResourceNcloudMysql_vpc_auto_backup(t *testing.T) {
 
 func TestAccResourceNcloudMysql_vpc_not_auto_backup(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 	resourceName := "ncloud_mysql.mysql"
 
 	resource.Test(t, resource.TestCase{
@@ -149,7 +222,7 @@ func TestAccResourceNcloudMysql_vpc_not_auto_backup(t *testing.T) {
 }
 
 func TestAccResourceNcloudMysql_error_case(t *testing.T) {
-	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	testMysqlName := RandomName(t, "my")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -258,6 +331,63 @@ resource "ncloud_mysql" "mysql" {
 `, testMysqlName)
 }
 
+func testAccMysqlVpcConfigPassword(testMysqlName string, password string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mysql" "mysql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "%[2]s"
+	host_ip = "192.168.0.1"
+	database_name = "test_db"
+}
+`, testMysqlName, password)
+}
+
+func testAccMysqlVpcConfigDeletionProtection(testMysqlName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mysql" "mysql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "t123456789!a"
+	host_ip = "192.168.0.1"
+	database_name = "test_db"
+	deletion_protection = %[2]t
+}
+`, testMysqlName, deletionProtection)
+}
+
 func testAccMysqlVpcConfigIsHa(testMysqlName string, isHa bool, isMultiZone bool, isStorageEncryption bool) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test_vpc" {
@@ -483,3 +483,47 @@ func convertToCloudMysqlUserKeyParameter(values basetypes.ListValue) []*vmysql.C
 
 	return result
 }
+
+// changeMysqlUserPassword changes the password of an existing user, keeping its host and authority.
+func changeMysqlUserPassword(ctx context.Context, config *conn.ProviderConfig, id string, name string, password string) error {
+	users, err := GetMysqlUserList(ctx, config, id, []string{name})
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		return fmt.Errorf("mysql user %s not found", name)
+	}
+
+	user := users[0]
+	reqParams := &vmysql.ChangeCloudMysqlUserListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMysqlInstanceNo: ncloud.String(id),
+		CloudMysqlUserList: []*vmysql.CloudMysqlUserParameter{
+			{
+				Name:                user.UserName,
+				HostIp:              user.HostIp,
+				Password:            ncloud.String(password),
+				Authority:           user.Authority,
+				IsSystemTableAccess: user.IsSystemTableAccess,
+			},
+		},
+	}
+	tflog.Info(ctx, "ChangeMysqlUserPassword instanceNo="+id+", userName="+name)
+
+	response, err := config.Client.Vmysql.V2Api.ChangeCloudMysqlUserList(reqParams)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "ChangeMysqlUserPassword response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		return fmt.Errorf("ChangeCloudMysqlUserList response invalid")
+	}
+
+	if _, err := waitMysqlCreation(ctx, config, id); err != nil {
+		return err
+	}
+
+	return nil
+}
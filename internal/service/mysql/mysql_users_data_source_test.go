@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudMysqlUsers_vpc_basic(t *testing.T) {
-	testName := fmt.Sprintf("tf-mysqluser-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 	dataName := "data.ncloud_mysql_users.all"
 
 	resource.Test(t, resource.TestCase{
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudMysqlUsers_vpc_basic_update(t *testing.T) {
-	testName := fmt.Sprintf("tf-mysqluser-%s", acctest.RandString(5))
+	testName := RandomName(t, "my")
 	resourceName := "ncloud_mysql_users.mysql_users"
 	testUserBefore := "test"
 	testUserAfter := "testuser"
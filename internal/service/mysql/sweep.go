@@ -0,0 +1,56 @@
+//go:build sweep
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_mysql", &resource.Sweeper{
+		Name: "ncloud_mysql",
+		F:    sweepMysqls,
+	})
+}
+
+func sweepMysqls(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	instances, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vmysql.CloudMysqlInstance, *int32, error) {
+		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceList(&vmysql.GetCloudMysqlInstanceListRequest{
+			RegionCode: &config.RegionCode,
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CloudMysqlInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing mysql instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range instances {
+		if sweep.IsTestName(v.CloudMysqlServiceName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewMysqlResource, map[string]string{
+				"id": *v.CloudMysqlInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,6 +32,9 @@ func ResourceNcloudNasVolume() *schema.Resource {
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("cifs_user_password"), cty.GetAttrPath("cifs_user_password_wo")),
+		},
 		Schema: map[string]*schema.Schema{
 			"volume_name_postfix": {
 				Type:             schema.TypeString,
@@ -73,9 +77,25 @@ func ResourceNcloudNasVolume() *schema.Resource {
 				ForceNew: true,
 			},
 			"cifs_user_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cifs_user_password_wo"},
+			},
+			"cifs_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"cifs_user_password"},
+				Description:   "Write-only form of `cifs_user_password`, which is not stored in state.",
+			},
+			"cifs_user_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"cifs_user_password_wo"},
+				Description:  "Change this value to send `cifs_user_password_wo` again.",
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -99,6 +119,11 @@ func ResourceNcloudNasVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"nas_volume_no": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -161,6 +186,9 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 	instance := ConvertToMap(r)
 
 	SetSingularResourceDataFromMapSchema(ResourceNcloudNasVolume(), d, instance)
+	// Imported resources and states written before deletion_protection
+	// existed have it unset. Storing the default keeps their plan empty.
+	d.Set("deletion_protection", d.Get("deletion_protection").(bool))
 
 	return nil
 }
@@ -168,6 +196,15 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
+	if d.Get("deletion_protection").(bool) {
+		return ErrorDeletionProtected("ncloud_nas_volume", d.Id(), "deletion_protection")
+	}
+	// NCP rejects returning a volume with return protection on. Refusing here
+	// fails the destroy before Terraform starts on the resources around it.
+	if d.Get("is_return_protection").(bool) {
+		return ErrorDeletionProtected("ncloud_nas_volume", d.Id(), "is_return_protection")
+	}
+
 	if err := deleteNasVolume(d, config, d.Id()); err != nil {
 		return err
 	}
@@ -191,6 +228,12 @@ func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("is_return_protection") {
+		if err := setNasVolumeReturnProtection(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudNasVolumeRead(d, meta)
 }
 
@@ -315,7 +358,7 @@ func createNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*stri
 }
 
 func createClassicNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -332,7 +375,7 @@ func createClassicNasVolume(d *schema.ResourceData, config *conn.ProviderConfig)
 		VolumeSize:                      ncloud.Int32(int32(d.Get("volume_size").(int))),
 		VolumeAllotmentProtocolTypeCode: ncloud.String(d.Get("volume_allotment_protocol_type").(string)),
 		CifsUserName:                    StringPtrOrNil(d.GetOk("cifs_user_name")),
-		CifsUserPassword:                StringPtrOrNil(d.GetOk("cifs_user_password")),
+		CifsUserPassword:                cifsUserPassword(d),
 		NasVolumeDescription:            StringPtrOrNil(d.GetOk("description")),
 		IsReturnProtection:              BoolPtrOrNil(d.GetOk("is_return_protection")),
 	}
@@ -356,7 +399,7 @@ func createVpcNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 		VolumeSize:                      ncloud.Int32(int32(d.Get("volume_size").(int))),
 		VolumeAllotmentProtocolTypeCode: ncloud.String(d.Get("volume_allotment_protocol_type").(string)),
 		CifsUserName:                    StringPtrOrNil(d.GetOk("cifs_user_name")),
-		CifsUserPassword:                StringPtrOrNil(d.GetOk("cifs_user_password")),
+		CifsUserPassword:                cifsUserPassword(d),
 		NasVolumeDescription:            StringPtrOrNil(d.GetOk("description")),
 		IsEncryptedVolume:               BoolPtrOrNil(d.GetOk("is_encrypted_volume")),
 		IsReturnProtection:              BoolPtrOrNil(d.GetOk("is_return_protection")),
@@ -372,6 +415,16 @@ func createVpcNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 	return resp.NasVolumeInstanceList[0].NasVolumeInstanceNo, nil
 }
 
+// cifsUserPassword prefers the write-only password, which is only in the config.
+func cifsUserPassword(d *schema.ResourceData) *string {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath("cifs_user_password_wo"))
+	if !diags.HasError() && v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+		return ncloud.String(v.AsString())
+	}
+
+	return StringPtrOrNil(d.GetOk("cifs_user_password"))
+}
+
 func waitForNasVolumeCreation(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT"},
@@ -526,6 +579,49 @@ func changeVpcNasVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig)
 	return nil
 }
 
+func setNasVolumeReturnProtection(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	if config.SupportVPC {
+		return setVpcNasVolumeReturnProtection(d, config)
+	} else {
+		return setClassicNasVolumeReturnProtection(d, config)
+	}
+}
+
+func setClassicNasVolumeReturnProtection(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	reqParams := &server.SetNasVolumeReturnProtectionRequest{
+		NasVolumeInstanceNo: ncloud.String(d.Id()),
+		IsReturnProtection:  ncloud.Bool(d.Get("is_return_protection").(bool)),
+	}
+	LogCommonRequest("setClassicNasVolumeReturnProtection", reqParams)
+
+	resp, err := config.Client.Server.V2Api.SetNasVolumeReturnProtection(reqParams)
+	if err != nil {
+		LogErrorResponse("setClassicNasVolumeReturnProtection", err, reqParams)
+		return err
+	}
+	LogResponse("setClassicNasVolumeReturnProtection", resp)
+
+	return nil
+}
+
+func setVpcNasVolumeReturnProtection(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	reqParams := &vnas.SetNasVolumeReturnProtectionRequest{
+		RegionCode:          &config.RegionCode,
+		NasVolumeInstanceNo: ncloud.String(d.Id()),
+		IsReturnProtection:  ncloud.Bool(d.Get("is_return_protection").(bool)),
+	}
+	LogCommonRequest("setVpcNasVolumeReturnProtection", reqParams)
+
+	resp, err := config.Client.Vnas.V2Api.SetNasVolumeReturnProtection(reqParams)
+	if err != nil {
+		LogErrorResponse("setVpcNasVolumeReturnProtection", err, reqParams)
+		return err
+	}
+	LogResponse("setVpcNasVolumeReturnProtection", resp)
+
+	return nil
+}
+
 func setNasVolumeAccessControl(d *schema.ResourceData, config *conn.ProviderConfig) error {
 	if config.SupportVPC {
 		if d.HasChange("server_instance_no_list") {
@@ -89,7 +89,7 @@ func getNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*N
 func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
 	client := config.Client
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -120,8 +120,9 @@ func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig
 	}
 	LogResponse("getClassicNasVolumeList", resp)
 
+	// The classic API has no paging parameters and returns every volume at once
 	var list []*NasVolume
-	for _, r := range resp.NasVolumeInstanceList {
+	for _, r := range LimitResults(resp.NasVolumeInstanceList, GetMaxResults(d)) {
 		list = append(list, convertClassicNasVolume(r))
 	}
 
@@ -143,17 +144,27 @@ func getVpcNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([
 		reqParams.NasVolumeInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcNasVolumeList", reqParams)
+	instances, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vnas.NasVolumeInstance, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
-	resp, err := client.Vnas.V2Api.GetNasVolumeInstanceList(reqParams)
+		LogCommonRequest("getVpcNasVolumeList", pageParams)
+		resp, err := client.Vnas.V2Api.GetNasVolumeInstanceList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getVpcNasVolumeList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getVpcNasVolumeList", resp)
+
+		return resp.NasVolumeInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcNasVolumeList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNasVolumeList", resp)
 
 	var list []*NasVolume
-	for _, r := range resp.NasVolumeInstanceList {
+	for _, r := range instances {
 		list = append(list, convertVpcNasVolume(r))
 	}
 
@@ -23,7 +23,10 @@ func testAccDataSourceNcloudNasVolumeBasic(t *testing.T, isVpc bool) {
 	postfix := GetTestPrefix()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccNasVolumeAPI(isVpc, "createNasVolumeInstance"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -1,16 +1,20 @@
 package nasvolume_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nasvolume"
 )
 
@@ -29,7 +33,10 @@ func testAccResourceNcloudNasVolumeBasic(t *testing.T, isVpc bool) {
 	provider := GetTestProvider(isVpc)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccNasVolumeAPI(isVpc, "createNasVolumeInstance"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNasVolumeDestroy(state, provider)
@@ -75,7 +82,7 @@ func testAccResourceNcloudNasVolumeResize(t *testing.T, isVpc bool) {
 	provider := GetTestProvider(isVpc)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, testAccNasVolumeAPI(isVpc, "changeNasVolumeSize")) },
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNasVolumeDestroy(state, provider)
@@ -114,7 +121,10 @@ func TestAccResourceNcloudNasVolume_classic_changeAccessControl(t *testing.T) {
 	resourceName := "ncloud_nas_volume.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccNasVolumeAPI(false, "setNasVolumeAccessControl"))
+		},
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNasVolumeDestroy(state, GetTestProvider(false))
@@ -150,7 +160,10 @@ func TestAccResourceNcloudNasVolume_vpc_changeAccessControl(t *testing.T) {
 	resourceName := "ncloud_nas_volume.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, "vserver/getServerImageProductList", testAccNasVolumeAPI(true, "setNasVolumeAccessControl"))
+		},
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(state *terraform.State) error {
 			return testAccCheckNasVolumeDestroy(state, GetTestProvider(true))
@@ -237,6 +250,14 @@ func testAccCheckNasVolumeNotRecreated(t *testing.T,
 	}
 }
 
+// testAccNasVolumeAPI returns the API of a NAS volume action on the classic or VPC platform
+func testAccNasVolumeAPI(isVpc bool, action string) string {
+	if isVpc {
+		return "vnas/" + action
+	}
+	return "server/" + action
+}
+
 func testAccNasVolumeConfig(volumeNamePostfix string) string {
 	return fmt.Sprintf(`
 resource "ncloud_nas_volume" "test" {
@@ -334,3 +355,71 @@ resource "ncloud_nas_volume" "test" {
 	server_instance_no_list = [ncloud_server.server-foo.id,ncloud_server.server-bar.id]
 }`, volumeNamePostfix)
 }
+
+func TestResourceNcloudNasVolume_deletionProtection(t *testing.T) {
+	for _, argument := range []string{"deletion_protection", "is_return_protection"} {
+		r := nasvolume.ResourceNcloudNasVolume()
+		d := r.TestResourceData()
+		d.SetId("1234")
+		d.Set(argument, true)
+
+		// The config has no client, so reaching the API would panic
+		err := r.Delete(d, &conn.ProviderConfig{SupportVPC: true})
+		if err == nil || !regexp.MustCompile(fmt.Sprintf("protected from deletion. Set `%s = false`", argument)).MatchString(err.Error()) {
+			t.Errorf("%s: expected delete to be refused, got %v", argument, err)
+		}
+	}
+}
+
+func TestResourceNcloudNasVolume_upgradeDeletionProtection(t *testing.T) {
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := config.NewClient(conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	resp, err := config.Client.Vnas.V2Api.CreateNasVolumeInstance(&vnas.CreateNasVolumeInstanceRequest{
+		RegionCode:                      ncloud.String("KR"),
+		VolumeName:                      ncloud.String("tfacc"),
+		VolumeSize:                      ncloud.Int32(500),
+		VolumeAllotmentProtocolTypeCode: ncloud.String("NFS"),
+	})
+	if err != nil {
+		t.Fatalf("creating volume: %s", err)
+	}
+	id := *resp.NasVolumeInstanceList[0].NasVolumeInstanceNo
+
+	// State written before deletion_protection existed
+	r := nasvolume.ResourceNcloudNasVolume()
+	state := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id":                             id,
+			"volume_name_postfix":            "tfacc",
+			"volume_size":                    "500",
+			"volume_allotment_protocol_type": "NFS",
+		},
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, config)
+	if diags.HasError() {
+		t.Fatalf("refreshing: %v", diags)
+	}
+	if got := state.Attributes["deletion_protection"]; got != "false" {
+		t.Errorf("expected deletion_protection to be stored as false, got %q", got)
+	}
+
+	raw := map[string]interface{}{
+		"volume_name_postfix":            "tfacc",
+		"volume_size":                    500,
+		"volume_allotment_protocol_type": "NFS",
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	if err != nil {
+		t.Fatalf("diffing: %s", err)
+	}
+	if diff != nil && diff.Attributes["deletion_protection"] != nil {
+		t.Errorf("expected no deletion_protection diff, got %v", diff.Attributes["deletion_protection"])
+	}
+}
@@ -44,7 +44,8 @@ func DataSourceNcloudNasVolumes() *schema.Resource {
 				Optional:    true,
 				Description: "Zone code. Get available values using the `data ncloud_zones`.",
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
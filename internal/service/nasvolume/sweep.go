@@ -0,0 +1,49 @@
+//go:build sweep
+
+package nasvolume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_nas_volume", &resource.Sweeper{
+		Name: "ncloud_nas_volume",
+		F:    sweepNasVolumes,
+	})
+}
+
+func sweepNasVolumes(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vnas.V2Api.GetNasVolumeInstanceList(&vnas.GetNasVolumeInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing nas volumes: %w", err)
+	}
+
+	r := ResourceNcloudNasVolume()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.NasVolumeInstanceList {
+		// volume names are "n{member no}_{volume_name_postfix}"
+		if v.VolumeName == nil {
+			continue
+		}
+		_, postfix, _ := strings.Cut(*v.VolumeName, "_")
+		if sweep.IsTestPrefix(&postfix) && !ncloud.BoolValue(v.IsReturnProtection) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.NasVolumeInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -277,3 +277,39 @@ func flattenNKSWorkerNodes(wns []*vnks.WorkerNode) []map[string]interface{} {
 
 	return res
 }
+
+func flattenNKSAddonVersions(addonConfigs []*vnks.AddonConfigRes) []map[string]interface{} {
+	resources := []map[string]interface{}{}
+
+	for _, addon := range addonConfigs {
+		if addon == nil {
+			continue
+		}
+		for _, v := range addon.Versions {
+			instance := map[string]interface{}{
+				"addon_name":   ncloud.StringValue(addon.Name),
+				"display_name": ncloud.StringValue(addon.DisplayName),
+				"category":     ncloud.StringValue(addon.Category),
+				"version":      ncloud.StringValue(v),
+			}
+
+			resources = append(resources, instance)
+		}
+	}
+
+	return resources
+}
+
+func flattenNKSExecConfig(exec *KubeConfigExec) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	if exec == nil {
+		return res
+	}
+
+	res = append(res, map[string]interface{}{
+		"api_version": exec.APIVersion,
+		"command":     exec.Command,
+		"args":        exec.Args,
+	})
+	return res
+}
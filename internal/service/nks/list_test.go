@@ -2,11 +2,14 @@ package nks
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
 
 func TestFlattenInt32ListToStringList(t *testing.T) {
@@ -478,3 +481,103 @@ func TestExpandNKSNodePoolAutoScale(t *testing.T) {
 		t.Fatalf("expected result 2, but got %d", ncloud.Int32Value(result.Max))
 	}
 }
+
+func TestFlattenNKSAddonVersions(t *testing.T) {
+	addonConfigs := []*vnks.AddonConfigRes{
+		{
+			Name:        ncloud.String("nks-nas-csi"),
+			DisplayName: ncloud.String("NAS CSI"),
+			Category:    ncloud.String("storage"),
+			Versions:    []*string{ncloud.String("1.0.0"), ncloud.String("1.1.0")},
+		},
+		nil,
+	}
+
+	result := flattenNKSAddonVersions(addonConfigs)
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 versions, but got %d", len(result))
+	}
+
+	r := result[1]
+	if r["addon_name"].(string) != "nks-nas-csi" {
+		t.Fatalf("expected result addon_name to be nks-nas-csi, but was %s", r["addon_name"])
+	}
+
+	if r["category"].(string) != "storage" {
+		t.Fatalf("expected result category to be storage, but was %s", r["category"])
+	}
+
+	if r["version"].(string) != "1.1.0" {
+		t.Fatalf("expected result version to be 1.1.0, but was %s", r["version"])
+	}
+}
+
+func TestFlattenNKSExecConfig(t *testing.T) {
+	exec := newNKSExecConfig("a80d6cbb-fdaa-4fdf-a3d9-063b6ffd5e", "KR", "")
+
+	result := flattenNKSExecConfig(exec)
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 exec config, but got %d", len(result))
+	}
+
+	r := result[0]
+	if r["api_version"].(string) != NKSExecAPIVersion {
+		t.Fatalf("expected result api_version to be %s, but was %s", NKSExecAPIVersion, r["api_version"])
+	}
+
+	if r["command"].(string) != NKSExecCommand {
+		t.Fatalf("expected result command to be %s, but was %s", NKSExecCommand, r["command"])
+	}
+
+	expectedArgs := []string{"token", "--clusterUuid", "a80d6cbb-fdaa-4fdf-a3d9-063b6ffd5e", "--region", "KR"}
+	if !reflect.DeepEqual(r["args"], expectedArgs) {
+		t.Fatalf("expected result args to be %v, but was %v", expectedArgs, r["args"])
+	}
+}
+
+func TestNewNKSExecConfig_site(t *testing.T) {
+	cases := []struct {
+		site         string
+		regionCode   string
+		expectedArgs []string
+	}{
+		{"public", "KR", []string{"token", "--clusterUuid", "uuid", "--region", "KR"}},
+		{"gov", "KRS", []string{"token", "--clusterUuid", "uuid", "--region", "KRS", "--url", "https://nks.apigw.gov-ntruss.com"}},
+		{"fin", "FKR", []string{"token", "--clusterUuid", "uuid", "--region", "FKR", "--url", "https://nks.apigw.fin-ntruss.com"}},
+	}
+
+	for _, tc := range cases {
+		exec := newNKSExecConfig("uuid", tc.regionCode, conn.SiteServiceGateway(tc.site, "nks"))
+		if !reflect.DeepEqual(exec.Args, tc.expectedArgs) {
+			t.Fatalf("%s: expected args to be %v, but was %v", tc.site, tc.expectedArgs, exec.Args)
+		}
+	}
+}
+
+func TestBuildNKSExecKubeConfig(t *testing.T) {
+	exec := newNKSExecConfig("a80d6cbb-fdaa-4fdf-a3d9-063b6ffd5e", "KR", "")
+
+	result, err := buildNKSExecKubeConfig("a80d6cbb-fdaa-4fdf-a3d9-063b6ffd5e", "https://a80d6cbb.kr.vnks.ntruss.com", "Y2EtZGF0YQ==", exec)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, expected := range []string{
+		"server: https://a80d6cbb.kr.vnks.ntruss.com",
+		"certificate-authority-data: Y2EtZGF0YQ==",
+		"command: ncp-iam-authenticator",
+		"current-context: nks_a80d6cbb-fdaa-4fdf-a3d9-063b6ffd5e",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected kubeconfig to contain %q, but was:\n%s", expected, result)
+		}
+	}
+
+	for _, unexpected := range []string{"client-certificate-data", "client-key-data", "token:"} {
+		if strings.Contains(result, unexpected) {
+			t.Fatalf("expected kubeconfig not to contain %q, but was:\n%s", unexpected, result)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func DataSourceNcloudNKSAddonVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudNKSAddonVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": DataSourceFiltersSchema(),
+			"k8s_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"addon_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addon_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNcloudNKSAddonVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return NotSupportClassic("datasource `ncloud_nks_addon_versions`")
+	}
+
+	resources, err := getNKSAddonVersions(config, d)
+	if err != nil {
+		return err
+	}
+
+	if f, ok := d.GetOk("filter"); ok {
+		resources = ApplyFilters(f.(*schema.Set), resources, DataSourceNcloudNKSAddonVersions().Schema["versions"].Elem.(*schema.Resource).Schema)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("versions", resources); err != nil {
+		return fmt.Errorf("Error setting Versions: %s", err)
+	}
+
+	return nil
+}
+
+func getNKSAddonVersions(config *conn.ProviderConfig, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	k8sVersion := ncloud.String(d.Get("k8s_version").(string))
+
+	var addonConfigs []*vnks.AddonConfigRes
+	if addonName, ok := d.GetOk("addon_name"); ok {
+		LogCommonRequest("GetNKSAddonConfig", addonName)
+		resp, err := config.Client.Vnks.V2Api.AddonConfigsAddonNameGet(context.Background(), ncloud.String(addonName.(string)), k8sVersion)
+		if err != nil {
+			LogErrorResponse("GetNKSAddonConfig", err, addonName)
+			return nil, err
+		}
+		LogResponse("GetNKSAddonConfig", resp)
+
+		addonConfigs = append(addonConfigs, resp)
+	} else {
+		LogCommonRequest("GetNKSAddonConfigs", k8sVersion)
+		resp, err := config.Client.Vnks.V2Api.AddonConfigsGet(context.Background(), k8sVersion, map[string]interface{}{})
+		if err != nil {
+			LogErrorResponse("GetNKSAddonConfigs", err, k8sVersion)
+			return nil, err
+		}
+		LogResponse("GetNKSAddonConfigs", resp)
+
+		addonConfigs = resp.AddonConfigs
+	}
+
+	return flattenNKSAddonVersions(addonConfigs), nil
+}
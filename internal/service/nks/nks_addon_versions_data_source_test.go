@@ -0,0 +1,61 @@
+package nks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudNKSAddonVersions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vnks") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudNKSAddonVersionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID("data.ncloud_nks_addon_versions.addons"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNcloudNKSAddonVersions_addonName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vnks") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudNKSAddonVersionsConfig_addonName,
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID("data.ncloud_nks_addon_versions.addons"),
+					resource.TestCheckResourceAttr("data.ncloud_nks_addon_versions.addons", "versions.0.addon_name", "nks-nas-csi"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudNKSAddonVersionsConfig = `
+data "ncloud_nks_versions" "versions" {
+  hypervisor_code = "KVM"
+}
+
+data "ncloud_nks_addon_versions" "addons" {
+  k8s_version = data.ncloud_nks_versions.versions.versions.0.value
+}
+`
+
+var testAccDataSourceNcloudNKSAddonVersionsConfig_addonName = `
+data "ncloud_nks_versions" "versions" {
+  hypervisor_code = "KVM"
+}
+
+data "ncloud_nks_addon_versions" "addons" {
+  k8s_version = data.ncloud_nks_versions.versions.versions.0.value
+  addon_name  = "nks-nas-csi"
+}
+`
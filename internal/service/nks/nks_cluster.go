@@ -219,6 +219,11 @@ func ResourceNcloudNKSCluster() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -306,6 +311,12 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if v, ok := d.GetOk("deletion_protection"); ok && v.(bool) {
+		if err := setNKSClusterReturnProtection(ctx, config, uuid, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNcloudNKSClusterRead(ctx, d, meta)
 }
 
@@ -348,6 +359,7 @@ func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("lb_private_subnet_no", strconv.Itoa(int(ncloud.Int32Value(cluster.SubnetLbNo))))
 	d.Set("kube_network_plugin", cluster.KubeNetworkPlugin)
 	d.Set("acg_no", strconv.Itoa(int(ncloud.Int32Value(cluster.AcgNo))))
+	d.Set("deletion_protection", ncloud.BoolValue(cluster.ReturnProtection))
 
 	if cluster.LbPublicSubnetNo != nil {
 		d.Set("lb_public_subnet_no", strconv.Itoa(int(ncloud.Int32Value(cluster.LbPublicSubnetNo))))
@@ -502,6 +514,14 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 	}
 
+	// deletion_protection is the cluster's return protection, which can also
+	// be set outside Terraform. It is only changed when the config sets it.
+	if d.HasChanges("deletion_protection") && !d.GetRawConfig().GetAttr("deletion_protection").IsNull() {
+		if err := setNKSClusterReturnProtection(ctx, config, *cluster.Uuid, d.Get("deletion_protection").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNcloudNKSClusterRead(ctx, d, config)
 }
 
@@ -511,6 +531,10 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.FromErr(ErrorDeletionProtected("ncloud_nks_cluster", d.Id(), "deletion_protection"))
+	}
+
 	if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -528,6 +552,21 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+func setNKSClusterReturnProtection(ctx context.Context, config *conn.ProviderConfig, uuid string, protect bool) error {
+	reqParams := &vnks.ReturnProtectionDto{
+		ReturnProtection: ncloud.Bool(protect),
+	}
+
+	LogCommonRequest("setNKSClusterReturnProtection", reqParams)
+	if _, err := config.Client.Vnks.V2Api.ClustersUuidReturnProtectionPatch(ctx, reqParams, ncloud.String(uuid)); err != nil {
+		LogErrorResponse("setNKSClusterReturnProtection", err, reqParams)
+		return err
+	}
+	LogResponse("setNKSClusterReturnProtection", reqParams)
+
+	return nil
+}
+
 func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{NKSStatusDeletingCode},
@@ -622,6 +661,7 @@ func getNKSClusterFromList(ctx context.Context, config *conn.ProviderConfig, uui
 }
 
 func GetNKSClusters(ctx context.Context, config *conn.ProviderConfig) ([]*vnks.Cluster, error) {
+	// GET /clusters takes no paging parameters and returns every cluster
 	resp, err := config.Client.Vnks.V2Api.ClustersGet(ctx)
 	if err != nil {
 		return nil, err
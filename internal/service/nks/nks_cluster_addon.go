@@ -0,0 +1,354 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+const (
+	NKSAddonStatusInstallingCode = "INSTALLING"
+	NKSAddonStatusUpdatingCode   = "UPDATING"
+	NKSAddonStatusDeletingCode   = "DELETING"
+	NKSAddonStatusActiveCode     = "ACTIVE"
+	NKSAddonStatusDegradedCode   = "DEGRADED"
+	NKSAddonIDSeparator          = ":"
+)
+
+func ResourceNcloudNKSClusterAddon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNcloudNKSClusterAddonCreate,
+		ReadContext:   resourceNcloudNKSClusterAddonRead,
+		UpdateContext: resourceNcloudNKSClusterAddonUpdate,
+		DeleteContext: resourceNcloudNKSClusterAddonDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultTimeout),
+			Update: schema.DefaultTimeout(conn.DefaultTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"addon_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"configuration_values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				DiffSuppressFunc: suppressEquivalentNKSAddonConfiguration,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resolve_conflicts": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"NONE", "OVERWRITE", "PRESERVE"}, false)),
+			},
+			"addon_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"k8s_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudNKSClusterAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster_addon`"))
+	}
+
+	clusterUuid := d.Get("cluster_uuid").(string)
+	addonName := d.Get("addon_name").(string)
+
+	if err := waitForNKSClusterActive(ctx, d, config, clusterUuid); err != nil {
+		return diag.FromErr(err)
+	}
+
+	reqParams := []*vnks.InstallAddonDto{
+		{
+			AddonName:           ncloud.String(addonName),
+			Version:             StringPtrOrNil(d.GetOk("version")),
+			ConfigurationValues: StringPtrOrNil(d.GetOk("configuration_values")),
+			ResolveConflicts:    StringPtrOrNil(d.GetOk("resolve_conflicts")),
+		},
+	}
+
+	LogCommonRequest("resourceNcloudNKSClusterAddonCreate", reqParams)
+	resp, err := config.Client.Vnks.V2Api.ClustersUuidAddonsPost(ctx, reqParams, ncloud.String(clusterUuid))
+	if err != nil {
+		LogErrorResponse("resourceNcloudNKSClusterAddonCreate", err, reqParams)
+		return diag.FromErr(err)
+	}
+	LogResponse("resourceNcloudNKSClusterAddonCreate", resp)
+
+	d.SetId(NKSAddonCreateResourceID(clusterUuid, addonName))
+
+	if err := waitForNKSClusterAddonActive(ctx, d, config, clusterUuid, addonName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNcloudNKSClusterAddonRead(ctx, d, meta)
+}
+
+func resourceNcloudNKSClusterAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster_addon`"))
+	}
+
+	clusterUuid, addonName, err := NKSAddonParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	addon, err := GetNKSClusterAddon(ctx, config, clusterUuid, addonName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if addon == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_uuid", clusterUuid)
+	d.Set("addon_name", addon.AddonName)
+	d.Set("version", addon.Version)
+	d.Set("addon_uuid", addon.Uuid)
+	d.Set("display_name", addon.DisplayName)
+	d.Set("category", addon.Category)
+	d.Set("status", addon.Status)
+	d.Set("latest_version", addon.LatestVersion)
+	d.Set("k8s_version", addon.K8sVersion)
+	// resolve_conflicts only applies to the install and update calls and is
+	// not returned by the API, so the configured value is kept as is.
+
+	if values := ncloud.StringValue(addon.ConfigurationValues); values != "" {
+		json, err := structure.NormalizeJsonString(values)
+		if err != nil {
+			return diag.Errorf("configuration_values (%s) is not valid JSON: %s", values, err)
+		}
+		d.Set("configuration_values", json)
+	} else {
+		d.Set("configuration_values", nil)
+	}
+
+	return nil
+}
+
+func resourceNcloudNKSClusterAddonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster_addon`"))
+	}
+
+	clusterUuid, addonName, err := NKSAddonParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("version", "configuration_values") {
+		reqParams := &vnks.UpdateAddonDto{
+			ResolveConflicts: StringPtrOrNil(d.GetOk("resolve_conflicts")),
+		}
+
+		if d.HasChange("version") {
+			reqParams.Version = StringPtrOrNil(d.GetOk("version"))
+		}
+
+		if d.HasChange("configuration_values") {
+			reqParams.ConfigurationValues = ncloud.String(d.Get("configuration_values").(string))
+		}
+
+		LogCommonRequest("resourceNcloudNKSClusterAddonUpdate", reqParams)
+		resp, err := config.Client.Vnks.V2Api.ClustersUuidAddonsAddonRefPatch(ctx, reqParams, ncloud.String(clusterUuid), ncloud.String(addonName))
+		if err != nil {
+			LogErrorResponse("resourceNcloudNKSClusterAddonUpdate", err, reqParams)
+			return diag.FromErr(err)
+		}
+		LogResponse("resourceNcloudNKSClusterAddonUpdate", resp)
+
+		if err := waitForNKSClusterAddonActive(ctx, d, config, clusterUuid, addonName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNcloudNKSClusterAddonRead(ctx, d, meta)
+}
+
+func resourceNcloudNKSClusterAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster_addon`"))
+	}
+
+	clusterUuid, addonName, err := NKSAddonParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	LogCommonRequest("resourceNcloudNKSClusterAddonDelete", d.Id())
+	if _, err := config.Client.Vnks.V2Api.ClustersUuidAddonsAddonRefDelete(ctx, ncloud.String(clusterUuid), ncloud.String(addonName)); err != nil {
+		LogErrorResponse("resourceNcloudNKSClusterAddonDelete", err, d.Id())
+		return diag.FromErr(err)
+	}
+
+	if err := waitForNKSClusterAddonDeletion(ctx, d, config, clusterUuid, addonName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func waitForNKSClusterAddonActive(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, clusterUuid, addonName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		// a new add-on may not be listed yet right after the install call
+		Pending: []string{NKSStatusNullCode, NKSAddonStatusInstallingCode, NKSAddonStatusUpdatingCode},
+		Target:  []string{NKSAddonStatusActiveCode},
+		Refresh: func() (result interface{}, state string, err error) {
+			addon, err := GetNKSClusterAddon(ctx, config, clusterUuid, addonName)
+			if err != nil {
+				return nil, "", err
+			}
+			if addon == nil {
+				return addonName, NKSStatusNullCode, nil
+			}
+			if ncloud.StringValue(addon.Status) == NKSAddonStatusDegradedCode {
+				return addon, NKSAddonStatusDegradedCode, fmt.Errorf("add-on is degraded: %s", ncloud.StringValue(addon.Message))
+			}
+			return addon, ncloud.StringValue(addon.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for NKS Cluster Addon (%s) to become active: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func waitForNKSClusterAddonDeletion(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, clusterUuid, addonName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{NKSAddonStatusDeletingCode, NKSAddonStatusActiveCode, NKSAddonStatusDegradedCode},
+		Target:  []string{NKSStatusNullCode},
+		Refresh: func() (result interface{}, state string, err error) {
+			addon, err := GetNKSClusterAddon(ctx, config, clusterUuid, addonName)
+			if err != nil {
+				return nil, "", err
+			}
+			if addon == nil {
+				return addonName, NKSStatusNullCode, nil
+			}
+			return addon, ncloud.StringValue(addon.Status), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for NKS Cluster Addon (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func GetNKSClusterAddon(ctx context.Context, config *conn.ProviderConfig, clusterUuid, addonName string) (*vnks.ClusterAddonRes, error) {
+	addons, err := getNKSClusterAddons(ctx, config, clusterUuid)
+	if err != nil {
+		return nil, err
+	}
+	for _, addon := range addons {
+		if ncloud.StringValue(addon.AddonName) == addonName {
+			return addon, nil
+		}
+	}
+	return nil, nil
+}
+
+func getNKSClusterAddons(ctx context.Context, config *conn.ProviderConfig, clusterUuid string) ([]*vnks.ClusterAddonRes, error) {
+	resp, err := config.Client.Vnks.V2Api.ClustersUuidAddonsGet(ctx, ncloud.String(clusterUuid), map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	LogResponse("getNKSClusterAddons", resp)
+
+	return resp.Addons, nil
+}
+
+func NKSAddonCreateResourceID(clusterUuid, addonName string) string {
+	return strings.Join([]string{clusterUuid, addonName}, NKSAddonIDSeparator)
+}
+
+func NKSAddonParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, NKSAddonIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-uuid%[2]saddon-name", id, NKSAddonIDSeparator)
+}
+
+func suppressEquivalentNKSAddonConfiguration(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	oldJson, err := structure.NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJson, err := structure.NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJson == newJson
+}
@@ -0,0 +1,140 @@
+package nks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
+)
+
+func TestAccResourceNcloudNKSClusterAddon_basic(t *testing.T) {
+	validateAcctestEnvironment(t)
+
+	var addon vnks.ClusterAddonRes
+	name := GetTestClusterName()
+	resourceName := "ncloud_nks_cluster_addon.addon"
+
+	nksInfo, err := getNKSTestInfo("KVM")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNKSClusterAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNKSClusterAddonConfig(name, TF_TEST_NKS_LOGIN_KEY, nksInfo, `{"replicaCount":1}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_uuid", "ncloud_nks_cluster.cluster", "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "addon_name", "nks-nas-csi"),
+					resource.TestCheckResourceAttr(resourceName, "status", nks.NKSAddonStatusActiveCode),
+					resource.TestCheckResourceAttr(resourceName, "configuration_values", `{"replicaCount":1}`),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNKSClusterAddonConfig(name, TF_TEST_NKS_LOGIN_KEY, nksInfo, `{"replicaCount":2}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "configuration_values", `{"replicaCount":2}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudNKSClusterAddonConfig(name string, loginKeyName string, nksInfo *NKSTestInfo, configurationValues string) string {
+	return testAccResourceNcloudNKSClusterDefaultConfig(name, loginKeyName, true, nksInfo) + fmt.Sprintf(`
+data "ncloud_nks_addon_versions" "nas_csi" {
+  k8s_version = ncloud_nks_cluster.cluster.k8s_version
+  addon_name  = "nks-nas-csi"
+}
+
+resource "ncloud_nks_cluster_addon" "addon" {
+  cluster_uuid         = ncloud_nks_cluster.cluster.uuid
+  addon_name           = "nks-nas-csi"
+  version              = data.ncloud_nks_addon_versions.nas_csi.versions.0.version
+  configuration_values = jsonencode(%[1]s)
+  resolve_conflicts    = "OVERWRITE"
+}
+`, configurationValues)
+}
+
+func testAccCheckNKSClusterAddonExists(n string, addon *vnks.ClusterAddonRes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No addon id is set")
+		}
+
+		clusterUuid, addonName, err := nks.NKSAddonParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Id(%s) is not [ClusterUuid:AddonName] ", rs.Primary.ID)
+		}
+
+		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+		resp, err := nks.GetNKSClusterAddon(context.Background(), config, clusterUuid, addonName)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Addon not found: %s", rs.Primary.ID)
+		}
+
+		*addon = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckNKSClusterAddonDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_nks_cluster_addon" {
+			continue
+		}
+
+		clusterUuid, addonName, err := nks.NKSAddonParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Id(%s) is not [ClusterUuid:AddonName] ", rs.Primary.ID)
+		}
+
+		cluster, err := nks.GetNKSCluster(context.Background(), config, clusterUuid)
+		if err != nil || cluster == nil {
+			continue
+		}
+
+		addon, err := nks.GetNKSClusterAddon(context.Background(), config, clusterUuid, addonName)
+		if err != nil {
+			return err
+		}
+
+		if addon != nil {
+			return errors.New("Addon still exists")
+		}
+	}
+
+	return testAccCheckNKSClusterDestroy(s)
+}
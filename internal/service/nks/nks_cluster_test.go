@@ -45,6 +45,8 @@ func validateAcctestEnvironment(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("Accetance Test skipped unless env 'TF_ACC' is set")
 	}
+	// Runs before the tests read NKS settings of the account
+	TestAccPreCheckMock(t, "vnks")
 }
 
 func TestAccResourceNcloudNKSCluster_basic_XEN(t *testing.T) {
@@ -621,3 +623,51 @@ func getNKSTestInfo(hypervisor string) (*NKSTestInfo, error) {
 	return nksInfo, nil
 
 }
+
+func TestResourceNcloudNKSCluster_deletionProtectionDiff(t *testing.T) {
+	r := nks.ResourceNcloudNKSCluster()
+	config := &conn.ProviderConfig{SupportVPC: true, Site: "public"}
+
+	// Return protection turned on outside Terraform
+	state := &terraform.InstanceState{
+		ID:         "uuid",
+		Attributes: map[string]string{"id": "uuid", "name": "tf-nks", "deletion_protection": "true"},
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected *terraform.ResourceAttrDiff
+	}{
+		{"unset", map[string]interface{}{"name": "tf-nks"}, nil},
+		{"set", map[string]interface{}{"name": "tf-nks", "deletion_protection": false}, &terraform.ResourceAttrDiff{Old: "true", New: "false"}},
+	}
+
+	for _, tc := range cases {
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.raw), config)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+
+		var got *terraform.ResourceAttrDiff
+		if diff != nil {
+			got = diff.Attributes["deletion_protection"]
+		}
+		if (got == nil) != (tc.expected == nil) || got != nil && (got.Old != tc.expected.Old || got.New != tc.expected.New) {
+			t.Errorf("%s: expected deletion_protection diff %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestResourceNcloudNKSCluster_deletionProtectionDelete(t *testing.T) {
+	r := nks.ResourceNcloudNKSCluster()
+	d := r.TestResourceData()
+	d.SetId("uuid")
+	d.Set("deletion_protection", true)
+
+	// The config has no client, so reaching the API would panic
+	diags := r.DeleteContext(context.Background(), d, &conn.ProviderConfig{SupportVPC: true})
+	if !diags.HasError() || !regexp.MustCompile("is protected from deletion").MatchString(diags[0].Summary) {
+		t.Errorf("expected delete to be refused, got %v", diags)
+	}
+}
@@ -7,12 +7,21 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"gopkg.in/yaml.v3"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
 
+const (
+	NKSKubeConfigAuthTypeStatic = "static"
+	NKSKubeConfigAuthTypeExec   = "exec"
+
+	NKSExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	NKSExecCommand    = "ncp-iam-authenticator"
+)
+
 func DataSourceNcloudNKSKubeConfig() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceNcloudNKSKubeConfigRead,
@@ -21,6 +30,12 @@ func DataSourceNcloudNKSKubeConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"auth_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          NKSKubeConfigAuthTypeStatic,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{NKSKubeConfigAuthTypeStatic, NKSKubeConfigAuthTypeExec}, false)),
+			},
 			"host": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -37,6 +52,31 @@ func DataSourceNcloudNKSKubeConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"exec": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"exec_kubeconfig": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -48,12 +88,46 @@ func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceDa
 	}
 	clusterUuid := d.Get("cluster_uuid").(string)
 
-	kubeConfig, err := getNKSKubeConfig(ctx, config, clusterUuid)
-	if err != nil {
+	if d.Get("auth_type").(string) == NKSKubeConfigAuthTypeExec {
+		// The CA certificate is only returned in the kubeconfig, but only its
+		// clusters are decoded so the client key never leaves the response.
+		var kubeConfig KubeConfigClusters
+		if _, err := getNKSKubeConfig(ctx, config, clusterUuid, &kubeConfig); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(kubeConfig.Clusters) == 0 {
+			d.SetId("")
+			return nil
+		}
+
+		cluster := kubeConfig.Clusters[0].Cluster
+		d.SetId(clusterUuid)
+		d.Set("host", cluster.Server)
+		d.Set("cluster_ca_certificate", cluster.ClusterCaCertificate)
+
+		exec := newNKSExecConfig(clusterUuid, config.RegionCode, conn.SiteServiceGateway(config.Site, "nks"))
+		if err := d.Set("exec", flattenNKSExecConfig(exec)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		execKubeConfig, err := buildNKSExecKubeConfig(clusterUuid, cluster.Server, cluster.ClusterCaCertificate, exec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("exec_kubeconfig", execKubeConfig)
+		d.Set("client_certificate", nil)
+		d.Set("client_key", nil)
+
+		return nil
+	}
+
+	var kubeConfig KubeConfig
+	if _, err := getNKSKubeConfig(ctx, config, clusterUuid, &kubeConfig); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if kubeConfig == nil {
+	if len(kubeConfig.Clusters) == 0 {
 		d.SetId("")
 		return nil
 	}
@@ -62,37 +136,112 @@ func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("host", kubeConfig.Clusters[0].Cluster.Server)
 	d.Set("cluster_ca_certificate", kubeConfig.Clusters[0].Cluster.ClusterCaCertificate)
 
-	if kubeConfig.Users != nil {
+	if len(kubeConfig.Users) > 0 {
 		d.Set("client_certificate", kubeConfig.Users[0].User.ClientCertificateData)
 		d.Set("client_key", kubeConfig.Users[0].User.ClientKeyData)
 	}
+	d.Set("exec", nil)
+	d.Set("exec_kubeconfig", nil)
 
 	return nil
 }
 
-func getNKSKubeConfig(ctx context.Context, config *conn.ProviderConfig, uuid string) (kc *KubeConfig, err error) {
+// getNKSKubeConfig decodes the kubeconfig of a cluster into kc, and returns it as it was received
+func getNKSKubeConfig(ctx context.Context, config *conn.ProviderConfig, uuid string, kc interface{}) (string, error) {
 	resp, err := config.Client.Vnks.V2Api.ClustersUuidKubeconfigGet(ctx, ncloud.String(uuid))
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	raw := ncloud.StringValue(resp.Kubeconfig)
+	if err := yaml.Unmarshal([]byte(raw), kc); err != nil {
+		return "", fmt.Errorf("error parsing kubeconfig of NKS Cluster (%s): %w", uuid, err)
+	}
+	return raw, nil
+}
+
+// newNKSExecConfig returns the ncp-iam-authenticator call for a cluster. gateway
+// is the NKS API gateway of the gov and fin sites, empty on the public site.
+func newNKSExecConfig(clusterUuid, regionCode, gateway string) *KubeConfigExec {
+	args := []string{"token", "--clusterUuid", clusterUuid, "--region", regionCode}
+	if gateway != "" {
+		args = append(args, "--url", gateway)
+	}
+
+	return &KubeConfigExec{
+		APIVersion: NKSExecAPIVersion,
+		Command:    NKSExecCommand,
+		Args:       args,
+	}
+}
+
+// buildNKSExecKubeConfig renders a kubeconfig whose user authenticates through the exec credential plugin,
+// so that no client certificate or key is included.
+func buildNKSExecKubeConfig(clusterUuid, host, caCertificate string, exec *KubeConfigExec) (string, error) {
+	name := fmt.Sprintf("nks_%s", clusterUuid)
+
+	kc := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Config",
+		"clusters": []map[string]interface{}{
+			{
+				"name": name,
+				"cluster": map[string]interface{}{
+					"server":                     host,
+					"certificate-authority-data": caCertificate,
+				},
+			},
+		},
+		"users": []map[string]interface{}{
+			{
+				"name": NKSExecCommand,
+				"user": map[string]interface{}{
+					"exec": exec,
+				},
+			},
+		},
+		"contexts": []map[string]interface{}{
+			{
+				"name": name,
+				"context": map[string]interface{}{
+					"cluster": name,
+					"user":    NKSExecCommand,
+				},
+			},
+		},
+		"current-context": name,
 	}
-	err = yaml.Unmarshal([]byte(ncloud.StringValue(resp.Kubeconfig)), &kc)
+
+	out, err := yaml.Marshal(kc)
 	if err != nil {
-		fmt.Printf("%s", err)
+		return "", err
 	}
-	return kc, nil
+	return string(out), nil
 }
 
-type KubeConfig struct {
-	Clusters []struct {
-		Cluster struct {
-			Server               string `yaml:"server"`
-			ClusterCaCertificate string `yaml:"certificate-authority-data"`
-		}
+type KubeConfigCluster struct {
+	Cluster struct {
+		Server               string `yaml:"server"`
+		ClusterCaCertificate string `yaml:"certificate-authority-data"`
 	}
-	Users []struct {
+}
+
+// KubeConfigClusters is the part of a kubeconfig without user credentials
+type KubeConfigClusters struct {
+	Clusters []KubeConfigCluster
+}
+
+type KubeConfig struct {
+	Clusters []KubeConfigCluster
+	Users    []struct {
 		User struct {
 			ClientCertificateData string `yaml:"client-certificate-data"`
 			ClientKeyData         string `yaml:"client-key-data"`
 		}
 	}
 }
+
+type KubeConfigExec struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
+}
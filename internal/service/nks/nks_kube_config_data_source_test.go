@@ -37,6 +37,42 @@ func TestAccDataSourceNcloudNKSKubeConfig(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudNKSKubeConfig_exec(t *testing.T) {
+	validateAcctestEnvironment(t)
+
+	dataName := "data.ncloud_nks_kube_config.kube_config_exec"
+	resourceName := "ncloud_nks_cluster.cluster"
+	name := GetTestClusterName()
+	nksInfo, err := getNKSTestInfo("XEN")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNKSKubeConfigConfig(name, TF_TEST_NKS_LOGIN_KEY, true, nksInfo) + `
+	data "ncloud_nks_kube_config" "kube_config_exec" {
+		cluster_uuid = ncloud_nks_cluster.cluster.uuid
+		auth_type    = "exec"
+	}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttrPair(dataName, "host", resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(dataName, "client_key", ""),
+					resource.TestCheckResourceAttr(dataName, "client_certificate", ""),
+					resource.TestCheckResourceAttr(dataName, "exec.0.command", "ncp-iam-authenticator"),
+					resource.TestCheckResourceAttrPair(dataName, "exec.0.args.2", resourceName, "uuid"),
+					resource.TestCheckResourceAttrSet(dataName, "exec_kubeconfig"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceNKSKubeConfigConfig(name string, loginKeyName string, auditLog bool, nksInfo *NKSTestInfo) string {
 	var b bytes.Buffer
 	b.WriteString(fmt.Sprintf(`
@@ -0,0 +1,206 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &nksKubeConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &nksKubeConfigEphemeralResource{}
+)
+
+var nksExecConfigAttrTypes = map[string]attr.Type{
+	"api_version": types.StringType,
+	"command":     types.StringType,
+	"args":        types.ListType{ElemType: types.StringType},
+}
+
+// NewNKSKubeConfigEphemeralResource looks up the same kubeconfig as the
+// ncloud_nks_kube_config data source, without storing it in the plan or state.
+func NewNKSKubeConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &nksKubeConfigEphemeralResource{}
+}
+
+type nksKubeConfigEphemeralResource struct {
+	config *conn.ProviderConfig
+}
+
+func (e *nksKubeConfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nks_kube_config"
+}
+
+func (e *nksKubeConfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_uuid": schema.StringAttribute{
+				Required: true,
+			},
+			"auth_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(NKSKubeConfigAuthTypeStatic, NKSKubeConfigAuthTypeExec),
+				},
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+			"exec": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"command": schema.StringAttribute{
+							Computed: true,
+						},
+						"args": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"kube_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubeconfig as returned by NKS, or one that authenticates through `exec` when `auth_type` is `exec`.",
+			},
+		},
+	}
+}
+
+func (e *nksKubeConfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *nksKubeConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data nksKubeConfigEphemeralResourceModel
+
+	if !e.config.SupportVPC {
+		resp.Diagnostics.AddError("NOT SUPPORTED", NotSupportClassic("ephemeral resource `ncloud_nks_kube_config`").Error())
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterUuid := data.ClusterUuid.ValueString()
+	execType := types.ObjectType{AttrTypes: nksExecConfigAttrTypes}
+
+	if data.AuthType.ValueString() == NKSKubeConfigAuthTypeExec {
+		var kubeConfig KubeConfigClusters
+		if _, err := getNKSKubeConfig(ctx, e.config, clusterUuid, &kubeConfig); err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+		if len(kubeConfig.Clusters) == 0 {
+			resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no cluster in the kubeconfig of NKS Cluster (%s)", clusterUuid))
+			return
+		}
+
+		cluster := kubeConfig.Clusters[0].Cluster
+		exec := newNKSExecConfig(clusterUuid, e.config.RegionCode, conn.SiteServiceGateway(e.config.Site, "nks"))
+		execKubeConfig, err := buildNKSExecKubeConfig(clusterUuid, cluster.Server, cluster.ClusterCaCertificate, exec)
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		args, diags := types.ListValueFrom(ctx, types.StringType, exec.Args)
+		resp.Diagnostics.Append(diags...)
+		execValue, diags := types.ObjectValue(nksExecConfigAttrTypes, map[string]attr.Value{
+			"api_version": types.StringValue(exec.APIVersion),
+			"command":     types.StringValue(exec.Command),
+			"args":        args,
+		})
+		resp.Diagnostics.Append(diags...)
+		execList, diags := types.ListValue(execType, []attr.Value{execValue})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Host = types.StringValue(cluster.Server)
+		data.ClusterCaCertificate = types.StringValue(cluster.ClusterCaCertificate)
+		data.ClientCertificate = types.StringNull()
+		data.ClientKey = types.StringNull()
+		data.Exec = execList
+		data.KubeConfig = types.StringValue(execKubeConfig)
+
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	var kubeConfig KubeConfig
+	raw, err := getNKSKubeConfig(ctx, e.config, clusterUuid, &kubeConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+	if len(kubeConfig.Clusters) == 0 {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no cluster in the kubeconfig of NKS Cluster (%s)", clusterUuid))
+		return
+	}
+
+	data.Host = types.StringValue(kubeConfig.Clusters[0].Cluster.Server)
+	data.ClusterCaCertificate = types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterCaCertificate)
+	data.ClientCertificate = types.StringNull()
+	data.ClientKey = types.StringNull()
+	if len(kubeConfig.Users) > 0 {
+		data.ClientCertificate = types.StringValue(kubeConfig.Users[0].User.ClientCertificateData)
+		data.ClientKey = types.StringValue(kubeConfig.Users[0].User.ClientKeyData)
+	}
+	data.Exec = types.ListNull(execType)
+	data.KubeConfig = types.StringValue(raw)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type nksKubeConfigEphemeralResourceModel struct {
+	ClusterUuid          types.String `tfsdk:"cluster_uuid"`
+	AuthType             types.String `tfsdk:"auth_type"`
+	Host                 types.String `tfsdk:"host"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Exec                 types.List   `tfsdk:"exec"`
+	KubeConfig           types.String `tfsdk:"kube_config"`
+}
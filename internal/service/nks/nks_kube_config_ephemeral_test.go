@@ -0,0 +1,118 @@
+package nks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+const testNKSKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: nks_kr_test
+  cluster:
+    server: https://test.kr.vnks.ntruss.com
+    certificate-authority-data: Y2EtZGF0YQ==
+users:
+- name: kubernetes-admin
+  user:
+    client-certificate-data: Y2VydC1kYXRh
+    client-key-data: a2V5LWRhdGE=
+`
+
+func openNKSKubeConfig(t *testing.T, e *nksKubeConfigEphemeralResource, clusterUuid, authType string) nksKubeConfigEphemeralResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	config := map[string]tftypes.Value{}
+	for name, typ := range objectType.(tftypes.Object).AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+	}
+	config["cluster_uuid"] = tftypes.NewValue(tftypes.String, clusterUuid)
+	if authType != "" {
+		config["auth_type"] = tftypes.NewValue(tftypes.String, authType)
+	}
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	e.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, config),
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("opening: %v", resp.Diagnostics)
+	}
+
+	var result nksKubeConfigEphemeralResourceModel
+	if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("reading result: %v", diags)
+	}
+
+	return result
+}
+
+func TestNKSKubeConfigEphemeralResource_open(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vnks/v2/clusters/test-uuid/kubeconfig" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"kubeconfig": testNKSKubeConfig})
+	}))
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := config.NewClient(conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	e := &nksKubeConfigEphemeralResource{config: config}
+
+	result := openNKSKubeConfig(t, e, "test-uuid", "")
+	if !result.Host.Equal(types.StringValue("https://test.kr.vnks.ntruss.com")) {
+		t.Errorf("unexpected host %s", result.Host)
+	}
+	if !result.ClusterCaCertificate.Equal(types.StringValue("Y2EtZGF0YQ==")) {
+		t.Errorf("unexpected cluster_ca_certificate %s", result.ClusterCaCertificate)
+	}
+	if !result.ClientKey.Equal(types.StringValue("a2V5LWRhdGE=")) {
+		t.Errorf("unexpected client_key %s", result.ClientKey)
+	}
+	if !result.KubeConfig.Equal(types.StringValue(testNKSKubeConfig)) {
+		t.Errorf("expected the kubeconfig as returned, got %s", result.KubeConfig)
+	}
+	if !result.Exec.IsNull() {
+		t.Errorf("expected no exec, got %s", result.Exec)
+	}
+
+	result = openNKSKubeConfig(t, e, "test-uuid", NKSKubeConfigAuthTypeExec)
+	if !result.ClientKey.IsNull() || !result.ClientCertificate.IsNull() {
+		t.Error("expected no client credentials with exec")
+	}
+	if len(result.Exec.Elements()) != 1 {
+		t.Fatalf("expected an exec, got %s", result.Exec)
+	}
+	if kc := result.KubeConfig.ValueString(); strings.Contains(kc, "a2V5LWRhdGE=") || !strings.Contains(kc, NKSExecCommand) {
+		t.Errorf("expected a kubeconfig authenticating through %s, got %s", NKSExecCommand, kc)
+	}
+}
@@ -12,7 +12,7 @@ func TestAccDataSourceNcloudNKSServerImages(t *testing.T) {
 	dataName := "data.ncloud_nks_server_images.images"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vnks") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -29,7 +29,7 @@ func TestAccDataSourceNcloudNKSServerImagesFilter(t *testing.T) {
 	dataName := "data.ncloud_nks_server_images.filter"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vnks") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
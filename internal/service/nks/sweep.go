@@ -0,0 +1,43 @@
+//go:build sweep
+
+package nks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	// node pools and add-ons go away with their cluster
+	resource.AddTestSweepers("ncloud_nks_cluster", &resource.Sweeper{
+		Name: "ncloud_nks_cluster",
+		F:    sweepNKSClusters,
+	})
+}
+
+func sweepNKSClusters(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	clusters, err := GetNKSClusters(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("listing nks clusters: %w", err)
+	}
+
+	r := ResourceNcloudNKSCluster()
+	var sweepables []sweep.Sweepable
+	for _, v := range clusters {
+		if sweep.IsTestName(v.Name) && !ncloud.BoolValue(v.ReturnProtection) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.Uuid, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -19,7 +19,7 @@ import (
 
 func TestAccResourceNcloudObjectStorage_bucket_acl_basic(t *testing.T) {
 	var aclOutput s3.GetBucketAclOutput
-	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	aclOptions := []string{string(awsTypes.BucketCannedACLPrivate),
 		string(awsTypes.BucketCannedACLPublicRead),
 		string(awsTypes.BucketCannedACLPublicReadWrite),
@@ -50,7 +50,7 @@ func TestAccResourceNcloudObjectStorage_bucket_acl_basic(t *testing.T) {
 
 func TestAccResourceNcloudObjectStorage_bucket_acl_update(t *testing.T) {
 	var aclOutput s3.GetBucketAclOutput
-	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 
 	acl := "public-read"
 	newACL := "private"
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_bucket_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	resourceName := "ncloud_objectstorage_bucket.testing_bucket"
 
 	resource.Test(t, resource.TestCase{
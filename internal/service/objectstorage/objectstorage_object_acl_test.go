@@ -20,7 +20,7 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_acl_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	key := "test/key/path" + sourceName
 	content := "content for file upload testing"
@@ -57,7 +57,7 @@ func TestAccResourceNcloudObjectStorage_object_acl_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_acl_update(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	key := "test/key/path" + sourceName
 	content := "content for file upload testing"
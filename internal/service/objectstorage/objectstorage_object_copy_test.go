@@ -19,7 +19,7 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_copy_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
 	content := "content for file upload testing"
@@ -54,7 +54,7 @@ func TestAccResourceNcloudObjectStorage_object_copy_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_copy_update_source(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	content := "content for file upload testing"
@@ -103,7 +103,7 @@ func TestAccResourceNcloudObjectStorage_object_copy_update_source(t *testing.T)
 }
 
 func TestAccResourceNcloudObjectStorage_object_copy_update_content_type(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	content := "content for file upload testing"
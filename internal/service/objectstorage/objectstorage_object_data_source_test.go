@@ -12,7 +12,7 @@ import (
 )
 
 func TestAccDataSourceNcloudObjectStorage_object_basic(t *testing.T) {
-	bucket := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucket := RandomName(t, "bucket")
 	key := fmt.Sprintf("%s.md", acctest.RandString(5))
 	dataName := "data.ncloud_objectstorage_object.by_id"
 	resourceName := "ncloud_objectstorage_object.testing_object"
@@ -19,7 +19,7 @@ import (
 )
 
 func TestAccResourceNcloudObjectStorage_object_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	resourceName := "ncloud_objectstorage_object.testing_object"
 	content := "content for file upload testing"
@@ -55,7 +55,7 @@ func TestAccResourceNcloudObjectStorage_object_basic(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_update_source(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	newSourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	key := "test/key/" + sourceName
@@ -107,7 +107,7 @@ func TestAccResourceNcloudObjectStorage_object_update_source(t *testing.T) {
 }
 
 func TestAccResourceNcloudObjectStorage_object_update_content_type(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	bucketName := RandomName(t, "bucket")
 	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
 	content := "content for file upload testing"
 	resourceName := "ncloud_objectstorage_object.testing_object"
@@ -0,0 +1,76 @@
+//go:build sweep
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	// objects, ACLs and copies go away with their bucket
+	resource.AddTestSweepers("ncloud_objectstorage_bucket", &resource.Sweeper{
+		Name: "ncloud_objectstorage_bucket",
+		F:    sweepBuckets,
+	})
+}
+
+func sweepBuckets(region string) error {
+	ctx := context.Background()
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	output, err := config.Client.ObjectStorage.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return fmt.Errorf("listing buckets: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range output.Buckets {
+		if !sweep.IsTestName(v.Name) {
+			continue
+		}
+		sweepables = append(sweepables, &bucketSweepable{
+			config: config,
+			name:   *v.Name,
+			bucket: sweep.NewSweepFrameworkResource(NewBucketResource, map[string]string{
+				"id":          *v.Name,
+				"bucket_name": *v.Name,
+			}, config),
+		})
+	}
+
+	return sweep.SweepOrchestrator(ctx, sweepables)
+}
+
+// bucketSweepable empties a bucket before deleting it, as DeleteBucket fails on non-empty buckets.
+type bucketSweepable struct {
+	config *conn.ProviderConfig
+	name   string
+	bucket sweep.Sweepable
+}
+
+func (b *bucketSweepable) Delete(ctx context.Context) error {
+	paginator := s3.NewListObjectsV2Paginator(b.config.Client.ObjectStorage, &s3.ListObjectsV2Input{Bucket: &b.name})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing objects of bucket %s: %w", b.name, err)
+		}
+		for _, object := range page.Contents {
+			if _, err := b.config.Client.ObjectStorage.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: &b.name, Key: object.Key}); err != nil {
+				return fmt.Errorf("deleting object %s of bucket %s: %w", *object.Key, b.name, err)
+			}
+		}
+	}
+
+	return b.bucket.Delete(ctx)
+}
@@ -86,9 +86,19 @@ func (r *postgresqlResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+		verifystring.NotContain(path.MatchRoot("user_name").String()),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": framework.IDAttribute(),
+			"id":                  framework.IDAttribute(),
+			"deletion_protection": framework.DeletionProtectionAttribute(),
 			"service_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -129,22 +139,18 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-						verifystring.NotContain(path.MatchRoot("user_name").String()),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `user_password`, which is not stored in state.",
+				userPasswordValidator,
+			),
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -158,10 +164,7 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"client_cidr": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Required:   true,
 				Validators: verify.CidrBlockValidator(),
 			},
 			"database_name": schema.StringAttribute{
@@ -225,6 +228,7 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 				Default: booldefault.StaticBool(true),
@@ -265,6 +269,7 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 				Default: booldefault.StaticBool(true),
@@ -447,12 +452,18 @@ func (r *postgresqlResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vpostgresql.CreateCloudPostgresqlInstanceRequest{
 		RegionCode:                      &r.config.RegionCode,
 		CloudPostgresqlServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudPostgresqlServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudPostgresqlUserName:         plan.UserName.ValueStringPointer(),
-		CloudPostgresqlUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudPostgresqlUserPassword:     password.ValueStringPointer(),
 		VpcNo:                           plan.VpcNo.ValueStringPointer(),
 		SubnetNo:                        plan.SubnetNo.ValueStringPointer(),
 		ClientCidr:                      plan.ClientCidr.ValueStringPointer(),
@@ -625,13 +636,68 @@ func (r *postgresqlResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
+	// The client CIDR belongs to the admin user, not to the instance
+	if !state.UserName.IsNull() {
+		user, err := GetPostgresqlUser(ctx, r.config, state.ID.ValueString(), state.UserName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+		if user != nil {
+			state.ClientCidr = types.StringPointerValue(user.ClientCidr)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (r *postgresqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *postgresqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state postgresqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) || !plan.ClientCidr.Equal(state.ClientCidr) {
+		password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := changePostgresqlUser(ctx, r.config, state.ID.ValueString(), state.UserName.ValueString(), password.ValueString(), plan.ClientCidr.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	output, err := GetPostgresqlInstance(ctx, r.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, output); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *postgresqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -642,6 +708,11 @@ func (r *postgresqlResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_postgresql", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vpostgresql.DeleteCloudPostgresqlInstanceRequest{
 		RegionCode:                &r.config.RegionCode,
 		CloudPostgresqlInstanceNo: state.ID.ValueStringPointer(),
@@ -761,10 +832,13 @@ func waitPostgresqlDeletion(ctx context.Context, config *conn.ProviderConfig, id
 
 type postgresqlResourceModel struct {
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 	ServiceName               types.String `tfsdk:"service_name"`
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	VpcNo                     types.String `tfsdk:"vpc_no"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
 	ClientCidr                types.String `tfsdk:"client_cidr"`
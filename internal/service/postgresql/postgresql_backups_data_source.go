@@ -0,0 +1,238 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &postgresqlBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &postgresqlBackupsDataSource{}
+)
+
+func NewPostgresqlBackupsDataSource() datasource.DataSource {
+	return &postgresqlBackupsDataSource{}
+}
+
+type postgresqlBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *postgresqlBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_backups"
+}
+
+func (d *postgresqlBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *postgresqlBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"postgresql_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"archived_wal_file_size": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *postgresqlBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data postgresqlBackupsDataSourceModel
+
+	if !d.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetPostgresqlBackupDetailList(ctx, d.config, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	backupList := flattenPostgresqlBackups(output)
+	filteredList := common.FilterModels(ctx, data.Filters, backupList)
+	if diags := data.refreshFromOutput(ctx, filteredList, data.ID.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		if err := common.WriteToFile(data.OutputFile.ValueString(), convertPostgresqlBackupsToJsonStruct(filteredList)); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func GetPostgresqlBackupDetailList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vpostgresql.CloudPostgresqlBackupDetail, error) {
+	reqParams := &vpostgresql.GetCloudPostgresqlBackupDetailListRequest{
+		RegionCode:                &config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetPostgresqlBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlBackupDetailList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetPostgresqlBackupDetailList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudPostgresqlBackupDetailList, nil
+}
+
+type postgresqlBackupsDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PostgresqlBackupList types.List   `tfsdk:"postgresql_backup_list"`
+	OutputFile           types.String `tfsdk:"output_file"`
+	Filters              types.Set    `tfsdk:"filter"`
+}
+
+type postgresqlBackup struct {
+	FileName            types.String `tfsdk:"file_name"`
+	StartTime           types.String `tfsdk:"start_time"`
+	EndTime             types.String `tfsdk:"end_time"`
+	BackupSize          types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize     types.Int64  `tfsdk:"data_storage_size"`
+	ArchivedWalFileSize types.Int64  `tfsdk:"archived_wal_file_size"`
+}
+
+type postgresqlBackupToJsonConvert struct {
+	FileName            string `json:"file_name"`
+	StartTime           string `json:"start_time"`
+	EndTime             string `json:"end_time"`
+	BackupSize          int64  `json:"backup_size"`
+	DataStorageSize     int64  `json:"data_storage_size"`
+	ArchivedWalFileSize int64  `json:"archived_wal_file_size"`
+}
+
+func (b postgresqlBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name":              types.StringType,
+		"start_time":             types.StringType,
+		"end_time":               types.StringType,
+		"backup_size":            types.Int64Type,
+		"data_storage_size":      types.Int64Type,
+		"archived_wal_file_size": types.Int64Type,
+	}
+}
+
+func convertPostgresqlBackupsToJsonStruct(backups []*postgresqlBackup) []postgresqlBackupToJsonConvert {
+	var backupsToConvert = []postgresqlBackupToJsonConvert{}
+
+	for _, b := range backups {
+		backupsToConvert = append(backupsToConvert, postgresqlBackupToJsonConvert{
+			FileName:            b.FileName.ValueString(),
+			StartTime:           b.StartTime.ValueString(),
+			EndTime:             b.EndTime.ValueString(),
+			BackupSize:          b.BackupSize.ValueInt64(),
+			DataStorageSize:     b.DataStorageSize.ValueInt64(),
+			ArchivedWalFileSize: b.ArchivedWalFileSize.ValueInt64(),
+		})
+	}
+
+	return backupsToConvert
+}
+
+func flattenPostgresqlBackups(list []*vpostgresql.CloudPostgresqlBackupDetail) []*postgresqlBackup {
+	var outputs []*postgresqlBackup
+
+	for _, v := range list {
+		var output postgresqlBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *postgresqlBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*postgresqlBackup, id string) diag.Diagnostics {
+	d.ID = types.StringValue(id)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: postgresqlBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.PostgresqlBackupList = backupListValue
+
+	return nil
+}
+
+func (b *postgresqlBackup) refreshFromOutput(output *vpostgresql.CloudPostgresqlBackupDetail) {
+	b.FileName = types.StringPointerValue(output.FileName)
+	b.StartTime = types.StringPointerValue(output.StartTime)
+	b.EndTime = types.StringPointerValue(output.EndTime)
+	b.BackupSize = types.Int64PointerValue(output.BackupSize)
+	b.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+	b.ArchivedWalFileSize = types.Int64PointerValue(output.ArchivedWalFileSize)
+}
@@ -0,0 +1,60 @@
+package postgresql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudPostgresqlBackups_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_postgresql_backups.all"
+	resourceName := "ncloud_postgresql.postgresql"
+	testName := RandomName(t, "pg")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePostgresqlBackupsConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataName, "postgresql_backup_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePostgresqlBackupsConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+resource "ncloud_postgresql" "postgresql" {
+    vpc_no = ncloud_vpc.test_vpc.vpc_no
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "t123456789!a"
+	client_cidr = "0.0.0.0/0"
+	database_name = "test_db"
+}
+
+data "ncloud_postgresql_backups" "all" {
+	id = ncloud_postgresql.postgresql.id
+}
+`, testName)
+}
@@ -0,0 +1,34 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// NewPostgresqlConnectionInfoEphemeralResource points at the primary server of an instance
+var NewPostgresqlConnectionInfoEphemeralResource = framework.NewConnectionInfoEphemeralResource("postgresql", "postgresql", getPostgresqlConnectionInfo)
+
+func getPostgresqlConnectionInfo(ctx context.Context, config *conn.ProviderConfig, no string) (*framework.ConnectionInfo, error) {
+	output, err := GetPostgresqlInstance(ctx, config, no)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	server := output.CloudPostgresqlServerInstanceList[0]
+	for _, s := range output.CloudPostgresqlServerInstanceList {
+		if ncloud.StringValue(common.GetCodePtrByCommonCode(s.CloudPostgresqlServerRole)) == "M" {
+			server = s
+			break
+		}
+	}
+
+	return &framework.ConnectionInfo{
+		Host: ncloud.StringValue(server.PrivateDomain),
+		Port: ncloud.Int32Value(output.CloudPostgresqlPort),
+	}, nil
+}
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -13,10 +12,10 @@ import (
 func TestAccDataSourceNcloudPostgresql_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_postgresql.by_id"
 	resourceName := "ncloud_postgresql.postgresql"
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", randacctest.RandString(5))
+	testPostgresqlName := RandomName(t, "pg")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -16,12 +15,12 @@ import (
 )
 
 func TestAccResourceNcloudPostgresqlDatabases_vpc_basic(t *testing.T) {
-	testName := fmt.Sprintf("tf-postgresqldb-%s", acctest.RandString(5))
+	testName := RandomName(t, "pg")
 	resourceName := "ncloud_postgresql_databases.postgresql_db"
 	dbResourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPostgresqlDestroy,
 		Steps: []resource.TestStep{
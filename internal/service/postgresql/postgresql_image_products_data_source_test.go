@@ -12,7 +12,7 @@ func TestAccDataSourceNcloudPostgresqlImageProducts_basic(t *testing.T) {
 	dataName := "data.ncloud_postgresql_image_products.all"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,11 +18,11 @@ import (
 
 func TestAccResourceNcloudPostgresqlReadReplica_vpc_basic(t *testing.T) {
 	var postgresqlServerInstance vpostgresql.CloudPostgresqlServerInstance
-	testName := fmt.Sprintf("tf-postgresqlrr-%s", acctest.RandString(5))
+	testName := RandomName(t, "pg")
 	resourceName := "ncloud_postgresql_read_replica.postgresql_rr"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPostgresqlReadReplicaDestroy,
 		Steps: []resource.TestStep{
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,11 +20,11 @@ import (
 
 func TestAccResourceNcloudPostgresql_vpc_basic(t *testing.T) {
 	var postgresqlInstance vpostgresql.CloudPostgresqlInstance
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := RandomName(t, "pg")
 	resourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPostgresqlDestroy,
 		Steps: []resource.TestStep{
@@ -44,14 +43,82 @@ func TestAccResourceNcloudPostgresql_vpc_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudPostgresql_vpc_updatePassword(t *testing.T) {
+	var before, after vpostgresql.CloudPostgresqlInstance
+	testPostgresqlName := RandomName(t, "pg")
+	resourceName := "ncloud_postgresql.postgresql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPostgresqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlVpcConfigPassword(testPostgresqlName, "t123456789!a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t123456789!a"),
+				),
+			},
+			{
+				Config: testAccPostgresqlVpcConfigPassword(testPostgresqlName, "t987654321!b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "user_password", "t987654321!b"),
+					func(*terraform.State) error {
+						if *before.CloudPostgresqlInstanceNo != *after.CloudPostgresqlInstanceNo {
+							return fmt.Errorf("postgresql instance was replaced on password change")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudPostgresql_vpc_updateClientCidr(t *testing.T) {
+	var before, after vpostgresql.CloudPostgresqlInstance
+	testPostgresqlName := RandomName(t, "pg")
+	resourceName := "ncloud_postgresql.postgresql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPostgresqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlVpcConfigClientCidr(testPostgresqlName, "0.0.0.0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "client_cidr", "0.0.0.0/0"),
+				),
+			},
+			{
+				Config: testAccPostgresqlVpcConfigClientCidr(testPostgresqlName, "10.5.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "client_cidr", "10.5.0.0/24"),
+					func(*terraform.State) error {
+						if *before.CloudPostgresqlInstanceNo != *after.CloudPostgresqlInstanceNo {
+							return fmt.Errorf("postgresql instance was replaced on client CIDR change")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Available only `pub` and 'fin' site.
 func TestAccResourceNcloudPostgresql_vpc_multizone(t *testing.T) {
 	var postgresqlInstance vpostgresql.CloudPostgresqlInstance
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := RandomName(t, "pg")
 	resourceName := "ncloud_postgresql.postgresql"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPostgresqlDestroy,
 		Steps: []resource.TestStep{
@@ -71,7 +138,7 @@ func TestAccResourceNcloudPostgresql_vpc_multizone(t *testing.T) {
 }
 
 func TestAccResourceNcloudPostgresql_vpc_error(t *testing.T) {
-	testPostgresqlName := fmt.Sprintf("tf-postgresql-%s", acctest.RandString(5))
+	testPostgresqlName := RandomName(t, "pg")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -237,6 +304,36 @@ resource "ncloud_subnet" "test_subnet" {
 `, name)
 }
 
+func testAccPostgresqlVpcConfigPassword(name string, password string) string {
+	return testAccPostgresqlVpcConfigBase(name) + fmt.Sprintf(`
+resource "ncloud_postgresql" "postgresql" {
+	vpc_no = ncloud_vpc.test_vpc.vpc_no
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "%[2]s"
+	client_cidr = "0.0.0.0/0"
+	database_name = "test_db"
+}
+`, name, password)
+}
+
+func testAccPostgresqlVpcConfigClientCidr(name string, clientCidr string) string {
+	return testAccPostgresqlVpcConfigBase(name) + fmt.Sprintf(`
+resource "ncloud_postgresql" "postgresql" {
+	vpc_no = ncloud_vpc.test_vpc.vpc_no
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "t123456789!a"
+	client_cidr = "%[2]s"
+	database_name = "test_db"
+}
+`, name, clientCidr)
+}
+
 func testAccPostgresqlVpcConfigErrorCaseBackupFalse(name string) string {
 	return testAccPostgresqlVpcConfigBase(name) + fmt.Sprintf(`
 resource "ncloud_postgresql" "postgresql" {
@@ -0,0 +1,353 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
+)
+
+var (
+	_ resource.Resource                = &postgresqlUserResource{}
+	_ resource.ResourceWithConfigure   = &postgresqlUserResource{}
+	_ resource.ResourceWithImportState = &postgresqlUserResource{}
+)
+
+// postgresqlInstanceMutexKV serializes user changes, as an instance rejects them while another one is being applied
+var postgresqlInstanceMutexKV common.MutexKV
+
+func NewPostgresqlUserResource() resource.Resource {
+	return &postgresqlUserResource{}
+}
+
+type postgresqlUserResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *postgresqlUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: postgresql_instance_no:name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("postgresql_instance_no"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (r *postgresqlUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *postgresqlUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_user"
+}
+
+func (r *postgresqlUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"postgresql_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 16),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z]+[a-z0-9_]+$`),
+						"Composed of lowercase alphabets, numbers, underbar (_). Must start with an alphabetic character.",
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(8, 20),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+						verifystring.NotContain(path.MatchRoot("name").String()),
+					),
+				},
+			},
+			"client_cidr": schema.StringAttribute{
+				Required: true,
+			},
+			"replication_role": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *postgresqlUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan postgresqlUserResourceModel
+
+	if !r.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"resource does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := plan.PostgresqlInstanceNo.ValueString()
+	postgresqlInstanceMutexKV.Lock(instanceNo)
+	defer postgresqlInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vpostgresql.AddCloudPostgresqlUserListRequest{
+		RegionCode:                &r.config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(instanceNo),
+		CloudPostgresqlUserList:   []*vpostgresql.CloudPostgresqlUserParameter{plan.toParameter()},
+	}
+	tflog.Info(ctx, "AddCloudPostgresqlUserList instanceNo="+instanceNo)
+
+	response, err := r.config.Client.Vpostgresql.V2Api.AddCloudPostgresqlUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "AddCloudPostgresqlUserList response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", instanceNo, plan.Name.ValueString()))
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	output, err := GetPostgresqlUser(ctx, r.config, instanceNo, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("postgresql user %s not found after creation", plan.Name.ValueString()))
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *postgresqlUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state postgresqlUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetPostgresqlUser(ctx, r.config, state.PostgresqlInstanceNo.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *postgresqlUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state postgresqlUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := state.PostgresqlInstanceNo.ValueString()
+	postgresqlInstanceMutexKV.Lock(instanceNo)
+	defer postgresqlInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vpostgresql.ChangeCloudPostgresqlUserListRequest{
+		RegionCode:                &r.config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(instanceNo),
+		CloudPostgresqlUserList:   []*vpostgresql.CloudPostgresqlUserParameter{plan.toParameter()},
+	}
+
+	response, err := r.config.Client.Vpostgresql.V2Api.ChangeCloudPostgresqlUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "ChangeCloudPostgresqlUserList response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+		return
+	}
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+		return
+	}
+
+	output, err := GetPostgresqlUser(ctx, r.config, instanceNo, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *postgresqlUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state postgresqlUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceNo := state.PostgresqlInstanceNo.ValueString()
+	postgresqlInstanceMutexKV.Lock(instanceNo)
+	defer postgresqlInstanceMutexKV.Unlock(instanceNo)
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vpostgresql.DeleteCloudPostgresqlUserListRequest{
+		RegionCode:                &r.config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(instanceNo),
+		CloudPostgresqlUserList: []*vpostgresql.CloudPostgresqlUserKeyParameter{
+			{Name: state.Name.ValueStringPointer()},
+		},
+	}
+	tflog.Info(ctx, "DeleteCloudPostgresqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteCloudPostgresqlUserList response="+common.MarshalUncheckedString(response))
+
+	if _, err := WaitPostgresqlCreation(ctx, r.config, instanceNo); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+		return
+	}
+}
+
+func GetPostgresqlUser(ctx context.Context, config *conn.ProviderConfig, id string, name string) (*vpostgresql.CloudPostgresqlUser, error) {
+	users, err := GetPostgresqlUserList(ctx, config, id, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	return users[0], nil
+}
+
+type postgresqlUserResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PostgresqlInstanceNo types.String `tfsdk:"postgresql_instance_no"`
+	Name                 types.String `tfsdk:"name"`
+	Password             types.String `tfsdk:"password"`
+	ClientCidr           types.String `tfsdk:"client_cidr"`
+	ReplicationRole      types.Bool   `tfsdk:"replication_role"`
+}
+
+func (r *postgresqlUserResourceModel) toParameter() *vpostgresql.CloudPostgresqlUserParameter {
+	return &vpostgresql.CloudPostgresqlUserParameter{
+		Name:              r.Name.ValueStringPointer(),
+		Password:          r.Password.ValueStringPointer(),
+		ClientCidr:        r.ClientCidr.ValueStringPointer(),
+		IsReplicationRole: r.ReplicationRole.ValueBoolPointer(),
+	}
+}
+
+func (r *postgresqlUserResourceModel) refreshFromOutput(output *vpostgresql.CloudPostgresqlUser) {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", r.PostgresqlInstanceNo.ValueString(), *output.UserName))
+	r.Name = types.StringPointerValue(output.UserName)
+	r.ClientCidr = types.StringPointerValue(output.ClientCidr)
+	r.ReplicationRole = types.BoolPointerValue(output.IsReplicationRole)
+}
@@ -0,0 +1,63 @@
+package postgresql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudPostgresqlUser_vpc_basic(t *testing.T) {
+	testName := RandomName(t, "pg")
+	resourceName := "ncloud_postgresql_user.test"
+	dbResourceName := "ncloud_postgresql.postgresql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpostgresql") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPostgresqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlUserConfig(testName, "0.0.0.0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "postgresql_instance_no", dbResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "client_cidr", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_role", "false"),
+				),
+			},
+			{
+				Config: testAccPostgresqlUserConfig(testName, "10.5.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_cidr", "10.5.0.0/24"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccPostgresqlUsersRemoveConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					tesetAccPostgresqlUsersNotExists(dbResourceName, []string{"test1"}, GetTestProvider(true)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPostgresqlUserConfig(testName string, clientCidr string) string {
+	return testAccPostgresqlUsersRemoveConfig(testName) + fmt.Sprintf(`
+resource "ncloud_postgresql_user" "test" {
+	postgresql_instance_no = ncloud_postgresql.postgresql.id
+	name                   = "test1"
+	password               = "t123456789!"
+	client_cidr            = "%[1]s"
+	replication_role       = false
+}
+`, clientCidr)
+}
@@ -452,3 +452,48 @@ func convertToCloudPostgresqlUserKeyParameter(values basetypes.ListValue) []*vpo
 
 	return result
 }
+
+// changePostgresqlUser changes the password and client CIDR of an existing user, keeping its replication role.
+func changePostgresqlUser(ctx context.Context, config *conn.ProviderConfig, id string, name string, password string, clientCidr string) error {
+	postgresqlInstanceMutexKV.Lock(id)
+	defer postgresqlInstanceMutexKV.Unlock(id)
+
+	user, err := GetPostgresqlUser(ctx, config, id, name)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return fmt.Errorf("postgresql user %s not found", name)
+	}
+
+	reqParams := &vpostgresql.ChangeCloudPostgresqlUserListRequest{
+		RegionCode:                &config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(id),
+		CloudPostgresqlUserList: []*vpostgresql.CloudPostgresqlUserParameter{
+			{
+				Name:              user.UserName,
+				Password:          ncloud.String(password),
+				ClientCidr:        ncloud.String(clientCidr),
+				IsReplicationRole: user.IsReplicationRole,
+			},
+		},
+	}
+	tflog.Info(ctx, "ChangePostgresqlUser instanceNo="+id+", userName="+name)
+
+	response, err := config.Client.Vpostgresql.V2Api.ChangeCloudPostgresqlUserList(reqParams)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "ChangePostgresqlUser response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		return fmt.Errorf("ChangeCloudPostgresqlUserList response invalid")
+	}
+
+	if _, err := WaitPostgresqlCreation(ctx, config, id); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+//go:build sweep
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_postgresql", &resource.Sweeper{
+		Name: "ncloud_postgresql",
+		F:    sweepPostgresqls,
+	})
+}
+
+func sweepPostgresqls(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	instances, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vpostgresql.CloudPostgresqlInstance, *int32, error) {
+		resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceList(&vpostgresql.GetCloudPostgresqlInstanceListRequest{
+			RegionCode: &config.RegionCode,
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CloudPostgresqlInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing postgresql instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range instances {
+		if sweep.IsTestName(v.CloudPostgresqlServiceName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewPostgresqlResource, map[string]string{
+				"id": *v.CloudPostgresqlInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -51,6 +51,14 @@ func (r *redisResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (r *redisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -97,17 +105,18 @@ func (r *redisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ConflictsWith(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
-			"id": framework.IDAttribute(),
+			"user_password_wo": framework.WriteOnlyAttribute(
+				"Write-only form of `user_password`, which is not stored in state.",
+				userPasswordValidator,
+			),
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo", int64planmodifier.RequiresReplace()),
+			"id":                       framework.IDAttribute(),
+			"deletion_protection":      framework.DeletionProtectionAttribute(),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -452,8 +461,13 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Available only `gov` site
-	if !plan.UserPassword.IsNull() {
-		reqParams.CloudRedisUserPassword = plan.UserPassword.ValueStringPointer()
+	password, diags := framework.SecretFromConfig(ctx, req.Config, path.Root("user_password_wo"), plan.UserPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !password.IsNull() {
+		reqParams.CloudRedisUserPassword = password.ValueStringPointer()
 	}
 
 	response, err := r.config.Client.Vredis.V2Api.CreateCloudRedisInstance(reqParams)
@@ -503,13 +517,29 @@ func (r *redisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	state.refreshFromOutput(ctx, output)
 
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (r *redisResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state redisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeletionProtection = plan.DeletionProtection
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -520,6 +550,11 @@ func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("DELETING ERROR", common.ErrorDeletionProtected("ncloud_redis", state.ID.ValueString(), "deletion_protection").Error())
+		return
+	}
+
 	reqParams := &vredis.DeleteCloudRedisInstanceRequest{
 		RegionCode:           &r.config.RegionCode,
 		CloudRedisInstanceNo: state.ID.ValueStringPointer(),
@@ -636,7 +671,10 @@ type redisResourceModel struct {
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ID                        types.String `tfsdk:"id"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 	VpcNo                     types.String `tfsdk:"vpc_no"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
 	ConfigGroupNo             types.String `tfsdk:"config_group_no"`
@@ -0,0 +1,227 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &redisBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &redisBackupsDataSource{}
+)
+
+func NewRedisBackupsDataSource() datasource.DataSource {
+	return &redisBackupsDataSource{}
+}
+
+type redisBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *redisBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redis_backups"
+}
+
+func (d *redisBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *redisBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"redis_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"redis_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *redisBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data redisBackupsDataSourceModel
+
+	if !d.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetRedisBackupDetailList(ctx, d.config, data.RedisInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	backupList := flattenRedisBackups(output)
+	filteredList := common.FilterModels(ctx, data.Filters, backupList)
+	if diags := data.refreshFromOutput(ctx, filteredList, data.RedisInstanceNo.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		if err := common.WriteToFile(data.OutputFile.ValueString(), convertRedisBackupsToJsonStruct(filteredList)); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func GetRedisBackupDetailList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vredis.CloudRedisBackupDetail, error) {
+	reqParams := &vredis.GetCloudRedisBackupDetailListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudRedisInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetRedisBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vredis.V2Api.GetCloudRedisBackupDetailList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetRedisBackupDetailList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudRedisBackupDetailList, nil
+}
+
+type redisBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	RedisInstanceNo types.String `tfsdk:"redis_instance_no"`
+	RedisBackupList types.List   `tfsdk:"redis_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type redisBackup struct {
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+}
+
+type redisBackupToJsonConvert struct {
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+}
+
+func (b redisBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+	}
+}
+
+func convertRedisBackupsToJsonStruct(backups []*redisBackup) []redisBackupToJsonConvert {
+	var backupsToConvert = []redisBackupToJsonConvert{}
+
+	for _, b := range backups {
+		backupsToConvert = append(backupsToConvert, redisBackupToJsonConvert{
+			StartTime:       b.StartTime.ValueString(),
+			EndTime:         b.EndTime.ValueString(),
+			BackupSize:      b.BackupSize.ValueInt64(),
+			DataStorageSize: b.DataStorageSize.ValueInt64(),
+		})
+	}
+
+	return backupsToConvert
+}
+
+func flattenRedisBackups(list []*vredis.CloudRedisBackupDetail) []*redisBackup {
+	var outputs []*redisBackup
+
+	for _, v := range list {
+		var output redisBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *redisBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*redisBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.RedisInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: redisBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.RedisBackupList = backupListValue
+
+	return nil
+}
+
+func (b *redisBackup) refreshFromOutput(output *vredis.CloudRedisBackupDetail) {
+	b.StartTime = types.StringPointerValue(output.StartTime)
+	b.EndTime = types.StringPointerValue(output.EndTime)
+	b.BackupSize = types.Int64PointerValue(output.BackupSize)
+	b.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+}
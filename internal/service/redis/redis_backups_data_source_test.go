@@ -0,0 +1,68 @@
+package redis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudRedisBackups_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_redis_backups.all"
+	resourceName := "ncloud_redis.test"
+	testName := RandomName(t, "rd")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vredis/createCloudRedisInstance") },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRedisBackupsConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataName, "redis_backup_list.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRedisBackupsConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+}
+
+resource "ncloud_redis_config_group" "example" {
+    name               = "%[1]s"
+    redis_version      = "7.0.13-simple"
+    description        = "ACC TEST"
+}
+
+resource "ncloud_redis" "test" {
+    service_name       = "%[1]s"
+    server_name_prefix = "ex-svr"
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+    subnet_no          = ncloud_subnet.test_subnet.id
+    config_group_no    = ncloud_redis_config_group.example.id
+	image_product_code  = "SW.VRDS.OS.LNX64.ROCKY.0810.REDIS.B050"
+	engine_version_code = "7.0.13"
+    mode = "SIMPLE"
+}
+
+data "ncloud_redis_backups" "all" {
+	redis_instance_no = ncloud_redis.test.id
+}
+`, testName)
+}
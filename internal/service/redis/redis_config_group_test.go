@@ -7,7 +7,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccResourceNcloudRedisConfigGroup_vpc_basic(t *testing.T) {
-	testConfigGroupName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	testConfigGroupName := RandomName(t, "cg")
 	resourceName := "ncloud_redis_config_group.test"
 	version := "7.0.13-simple"
 
@@ -0,0 +1,34 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// NewRedisConnectionInfoEphemeralResource points at the first server that is
+// not a replica
+var NewRedisConnectionInfoEphemeralResource = framework.NewConnectionInfoEphemeralResource("redis", "redis", getRedisConnectionInfo)
+
+func getRedisConnectionInfo(ctx context.Context, config *conn.ProviderConfig, no string) (*framework.ConnectionInfo, error) {
+	output, err := GetRedisDetail(ctx, config, no)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	for _, s := range output.CloudRedisServerInstanceList {
+		if s.CloudRedisServerRole != nil && ncloud.StringValue(s.CloudRedisServerRole.CodeName) == "Slave" {
+			continue
+		}
+
+		return &framework.ConnectionInfo{
+			Host: ncloud.StringValue(s.PrivateDomain),
+			Port: ncloud.Int32Value(output.CloudRedisPort),
+		}, nil
+	}
+
+	return nil, nil
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
@@ -12,10 +11,10 @@ import (
 func TestAccDataSourceNcloudRedis_vpc_basic(t *testing.T) {
 	dataName := "data.ncloud_redis.by_id"
 	resourceName := "ncloud_redis.test"
-	testRedisName := fmt.Sprintf("tf-redis-%s", acctest.RandString(5))
+	testRedisName := RandomName(t, "rd")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vredis/createCloudRedisInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
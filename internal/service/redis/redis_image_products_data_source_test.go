@@ -12,7 +12,10 @@ func TestAccDataSourceNcloudRedisImageProducts_vpc_basic(t *testing.T) {
 	productType := "LINUX"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+			acctest.TestAccPreCheckMock(t, "vredis/getCloudRedisImageProductList")
+		},
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -9,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,11 +19,11 @@ import (
 
 func TestAccResourceNcloudRedis_vpc_basic(t *testing.T) {
 	var redisInstance vredis.CloudRedisInstance
-	testRedisName := fmt.Sprintf("tf-redis-%s", acctest.RandString(5))
+	testRedisName := RandomName(t, "rd")
 	resourceName := "ncloud_redis.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vredis/createCloudRedisInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRedisDestroy,
 		Steps: []resource.TestStep{
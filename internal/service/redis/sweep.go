@@ -0,0 +1,86 @@
+//go:build sweep
+
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_redis", &resource.Sweeper{
+		Name: "ncloud_redis",
+		F:    sweepRedises,
+	})
+
+	resource.AddTestSweepers("ncloud_redis_config_group", &resource.Sweeper{
+		Name:         "ncloud_redis_config_group",
+		F:            sweepRedisConfigGroups,
+		Dependencies: []string{"ncloud_redis"},
+	})
+}
+
+func sweepRedises(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	instances, err := common.ListAllPages(0, func(pageNo, pageSize int32) ([]*vredis.CloudRedisInstance, *int32, error) {
+		resp, err := config.Client.Vredis.V2Api.GetCloudRedisInstanceList(&vredis.GetCloudRedisInstanceListRequest{
+			RegionCode: &config.RegionCode,
+			// Cloud DB list APIs count pages from 0
+			PageNo:   ncloud.Int32(pageNo - 1),
+			PageSize: ncloud.Int32(pageSize),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.CloudRedisInstanceList, resp.TotalRows, nil
+	})
+	if err != nil {
+		return fmt.Errorf("listing redis instances: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range instances {
+		if sweep.IsTestName(v.CloudRedisServiceName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewRedisResource, map[string]string{
+				"id": *v.CloudRedisInstanceNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepRedisConfigGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vredis.V2Api.GetCloudRedisConfigGroupList(&vredis.GetCloudRedisConfigGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing redis config groups: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.CloudRedisConfigGroupList {
+		if sweep.IsTestName(v.ConfigGroupName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewRedisConfigGroupResource, map[string]string{
+				"id":   *v.ConfigGroupNo,
+				"name": *v.ConfigGroupName,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
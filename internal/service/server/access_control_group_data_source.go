@@ -103,17 +103,27 @@ func getVpcAccessControlGroupList(d *schema.ResourceData, config *conn.ProviderC
 		reqParams.AccessControlGroupNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcAccessControlGroup", reqParams)
+	groups, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vserver.AccessControlGroup, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		LogCommonRequest("getVpcAccessControlGroup", pageParams)
+		resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getVpcAccessControlGroup", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getVpcAccessControlGroup", resp)
 
-	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(reqParams)
+		return resp.AccessControlGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcAccessControlGroup", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcAccessControlGroup", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range groups {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupNo,
 			"access_control_group_no": *r.AccessControlGroupNo,
@@ -148,19 +158,27 @@ func getClassicAccessControlGroupList(d *schema.ResourceData, config *conn.Provi
 		reqParams.IsDefault = ncloud.Bool(v.(bool))
 	}
 
-	reqParams.PageNo = ncloud.Int32(1)
+	groups, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*server.AccessControlGroup, *int32, error) {
+		pageParams := reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
-	LogCommonRequest("getClassicAccessControlGroupList", reqParams)
+		LogCommonRequest("getClassicAccessControlGroupList", pageParams)
+		resp, err := client.Server.V2Api.GetAccessControlGroupList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getClassicAccessControlGroupList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getClassicAccessControlGroupList", resp)
 
-	resp, err := client.Server.V2Api.GetAccessControlGroupList(&reqParams)
+		return resp.AccessControlGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicAccessControlGroupList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getClassicAccessControlGroupList", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range groups {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupConfigurationNo,
 			"access_control_group_no": *r.AccessControlGroupConfigurationNo,
@@ -5,8 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -18,7 +16,7 @@ func TestAccDataSourceNcloudVpcAccessControlGroupBasic(t *testing.T) {
 	*/
 	t.Skip()
 
-	name := fmt.Sprintf("tf-ds-acg-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "acg")
 	dataName := "data.ncloud_access_control_group.by_id"
 	resourceName := "ncloud_access_control_group.test"
 
@@ -46,7 +44,7 @@ func TestAccDataSourceNcloudVpcAccessControlGroupBasic(t *testing.T) {
 func TestAccDataSourceNcloudClassicAccessControlGroup_basic(t *testing.T) {
 	dataName := "data.ncloud_access_control_group.by_name"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "server/getAccessControlGroupList") },
 		ProtoV6ProviderFactories: ClassicProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,11 +18,11 @@ import (
 
 func TestAccResourceNcloudAccessControlGroupRule_basic(t *testing.T) {
 	var AccessControlGroupRule []*vserver.AccessControlGroupRule
-	name := fmt.Sprintf("tf-acg-rule-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "acg")
 	resourceName := "ncloud_access_control_group_rule.acg_rule_foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/addAccessControlGroupInboundRule") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckAccessControlGroupRuleDestroy,
 		Steps: []resource.TestStep{
@@ -42,11 +41,11 @@ func TestAccResourceNcloudAccessControlGroupRule_basic(t *testing.T) {
 
 func TestAccResourceNcloudAccessControlGroupRule_disappears(t *testing.T) {
 	var AccessControlGroupRule []*vserver.AccessControlGroupRule
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "nic")
 	resourceName := "ncloud_access_control_group_rule.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/addAccessControlGroupInboundRule") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckAccessControlGroupRuleDestroy,
 		Steps: []resource.TestStep{
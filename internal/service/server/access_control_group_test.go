@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,10 +18,10 @@ import (
 
 func TestAccResourceNcloudAccessControlGroup_basic(t *testing.T) {
 	var AccessControlGroup vserver.AccessControlGroup
-	name := fmt.Sprintf("tf-acg-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "acg")
 	resourceName := "ncloud_access_control_group.foo"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckAccessControlGroupDestroy,
@@ -50,7 +49,7 @@ func TestAccResourceNcloudAccessControlGroup_basic(t *testing.T) {
 
 func TestAccResourceNcloudAccessControlGroup_disappears(t *testing.T) {
 	var AccessControlGroup vserver.AccessControlGroup
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "nic")
 	resourceName := "ncloud_access_control_group.foo"
 
 	resource.Test(t, resource.TestCase{
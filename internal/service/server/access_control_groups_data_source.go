@@ -48,6 +48,7 @@ func DataSourceNcloudAccessControlGroups() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"max_results": MaxResultsSchema(),
 		},
 	}
 }
@@ -26,7 +26,7 @@ func TestAccDataSourceNcloudAccessControlGroups_vpc_default(t *testing.T) {
 
 func testAccDataSourceNcloudAccessControlGroupsBasic(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheckAccessControlGroups(t) },
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -41,7 +41,7 @@ func testAccDataSourceNcloudAccessControlGroupsBasic(t *testing.T, isVpc bool) {
 
 func testAccDataSourceNcloudAccessControlGroupsDefault(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheckAccessControlGroups(t) },
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -54,6 +54,15 @@ func testAccDataSourceNcloudAccessControlGroupsDefault(t *testing.T, isVpc bool)
 	})
 }
 
+// testAccPreCheckAccessControlGroups skips tests that list the access
+// control groups already in the account
+func testAccPreCheckAccessControlGroups(t *testing.T) {
+	TestAccPreCheck(t)
+	if IsMock() {
+		t.Skip("the mock server starts without access control groups")
+	}
+}
+
 var testAccDataSourceNcloudAccessControlGroupsConfig = `
 data "ncloud_access_control_groups" "test" {}
 `
@@ -41,6 +41,7 @@ func DataSourceNcloudAccessControlRules() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"max_results": MaxResultsSchema(),
 		},
 	}
 }
@@ -67,7 +68,8 @@ func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interfa
 	}
 	LogCommonResponse("GetAccessControlRuleList", GetCommonResponse(resp))
 
-	allAccessControlRuleList := resp.AccessControlRuleList
+	// The rule list API has no paging parameters and returns every rule at once
+	allAccessControlRuleList := LimitResults(resp.AccessControlRuleList, GetMaxResults(d))
 	var filteredAccessControlRuleList []*server.AccessControlRule
 	nameRegex, nameRegexOk := d.GetOk("source_name_regex")
 	if nameRegexOk {
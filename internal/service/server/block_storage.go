@@ -1,23 +1,34 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"time"
 
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-
-	"log"
-
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 )
 
 const (
@@ -38,297 +49,577 @@ const (
 	BlockStorageHypervisorTypeKvm    = "KVM"
 )
 
-func ResourceNcloudBlockStorage() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudBlockStorageCreate,
-		Read:   resourceNcloudBlockStorageRead,
-		Update: resourceNcloudBlockStorageUpdate,
-		Delete: resourceNcloudBlockStorageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+var (
+	_ resource.Resource                 = &blockStorageResource{}
+	_ resource.ResourceWithConfigure    = &blockStorageResource{}
+	_ resource.ResourceWithImportState  = &blockStorageResource{}
+	_ resource.ResourceWithUpgradeState = &blockStorageResource{}
+)
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
-			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
-		},
+func NewBlockStorageResource() resource.Resource {
+	return &blockStorageResource{}
+}
+
+type blockStorageResource struct {
+	config *conn.ProviderConfig
+}
 
-		Schema: map[string]*schema.Schema{
-			"server_instance_no": {
-				Type:     schema.TypeString,
+func (r *blockStorageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *blockStorageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_storage"
+}
+
+func (r *blockStorageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = blockStorageResourceSchema(ctx)
+}
+
+// blockStorageResourceSchema keeps the attributes and types of the SDKv2
+// implementation, see serverResourceSchema.
+func blockStorageResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"server_instance_no": schema.StringAttribute{
 				Optional: true,
 			},
-			"size": {
-				Type:             schema.TypeInt,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(10)),
+			"size": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(10),
+				},
 			},
-			"name": {
-				Type:     schema.TypeString,
+			"name": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-					validation.StringLenBetween(3, 30),
-					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-_]+$`), "Allows only alphabets, numbers, hyphen (-) and underbar (_). Must start with an alphabetic character"),
-				)),
-			},
-			"description": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-			},
-			"disk_detail_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ConflictsWith:    []string{"volume_type"},
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{BlockStorageVolumeTypeHdd, BlockStorageVolumeTypeSsd}, false)),
-			},
-			"hypervisor_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				RequiredWith:     []string{"volume_type"},
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{BlockStorageHypervisorTypeXen, BlockStorageHypervisorTypeKvm}, false)),
-			},
-			"volume_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ConflictsWith:    []string{"disk_detail_type"},
-				RequiredWith:     []string{"hypervisor_type"},
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{BlockStorageVolumeTypeHdd, BlockStorageVolumeTypeSsd, BlockStorageVolumeTypeFb1, BlockStorageVolumeTypeCb1}, false)),
-			},
-			"zone": {
-				Type:     schema.TypeString,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 30),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-_]+$`), "Allows only alphabets, numbers, hyphen (-) and underbar (_). Must start with an alphabetic character"),
+				},
+			},
+			"description": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+				},
 			},
-			"snapshot_no": {
-				Type:     schema.TypeString,
+			"disk_detail_type": schema.StringAttribute{
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(BlockStorageVolumeTypeHdd, BlockStorageVolumeTypeSsd),
+					stringvalidator.ConflictsWith(path.MatchRoot("volume_type")),
+				},
 			},
-			"stop_instance_before_detaching": {
-				Type:     schema.TypeBool,
+			"hypervisor_type": schema.StringAttribute{
 				Optional: true,
-				Default:  false,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(BlockStorageHypervisorTypeXen, BlockStorageHypervisorTypeKvm),
+					stringvalidator.AlsoRequires(path.MatchRoot("volume_type")),
+				},
 			},
-			"return_protection": {
-				Type:     schema.TypeBool,
+			"volume_type": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(BlockStorageVolumeTypeHdd, BlockStorageVolumeTypeSsd, BlockStorageVolumeTypeFb1, BlockStorageVolumeTypeCb1),
+					stringvalidator.ConflictsWith(path.MatchRoot("disk_detail_type")),
+					stringvalidator.AlsoRequires(path.MatchRoot("hypervisor_type")),
+				},
 			},
-			"block_storage_no": {
-				Type:     schema.TypeString,
+			"zone": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"server_name": {
-				Type:     schema.TypeString,
+			"snapshot_no": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stop_instance_before_detaching": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"return_protection": schema.BoolAttribute{
+				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"type": {
-				Type:     schema.TypeString,
+			"block_storage_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"device_name": {
-				Type:     schema.TypeString,
+			"server_name": schema.StringAttribute{
 				Computed: true,
 			},
-			"product_code": {
-				Type:     schema.TypeString,
+			"type": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"status": {
-				Type:     schema.TypeString,
+			"device_name": schema.StringAttribute{
 				Computed: true,
 			},
-			"disk_type": {
-				Type:     schema.TypeString,
+			"product_code": schema.StringAttribute{
 				Computed: true,
 			},
-			"max_iops": {
-				Type:     schema.TypeInt,
+			"status": schema.StringAttribute{
 				Computed: true,
 			},
-			"encrypted_volume": {
-				Type:     schema.TypeBool,
+			"disk_type": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_iops": schema.Int64Attribute{
+				Computed: true,
+			},
+			"encrypted_volume": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
-
-	if len(d.Get("server_instance_no").(string)) == 0 {
-		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created.")
+func (r *blockStorageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	id, err := createBlockStorage(d, config)
-	if err != nil {
-		return err
-	}
+	r.config = req.ProviderData.(*conn.ProviderConfig)
+}
 
-	d.SetId(ncloud.StringValue(id))
-	log.Printf("[INFO] Block Storage ID: %s", d.Id())
+func (r *blockStorageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan blockStorageResourceModel
 
-	return resourceNcloudBlockStorageRead(d, meta)
-}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+	if plan.ServerInstanceNo.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_instance_no"),
+			"CREATING ERROR",
+			"'server_instance_no' has to be present when ncloud_block_storage is first created.",
+		)
+		return
+	}
 
-	r, err := GetBlockStorage(config, d.Id())
-	if err != nil {
-		return err
+	createTimeout, diags := plan.Timeouts.Create(ctx, conn.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if r == nil {
-		d.SetId("")
-		return nil
+	var id *string
+	var err error
+	if r.config.SupportVPC {
+		id, err = createVpcBlockStorage(r.config, &plan, createTimeout)
+	} else {
+		id, err = createClassicBlockStorage(r.config, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
 	}
 
-	instance := ConvertToMap(r)
+	plan.ID = types.StringPointerValue(id)
+	tflog.Info(ctx, "Block Storage ID", map[string]any{"id": plan.ID.ValueString()})
 
-	SetSingularResourceDataFromMapSchema(ResourceNcloudBlockStorage(), d, instance)
+	output, err := GetBlockStorage(r.config, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
 
-	if err := d.Set("server_instance_no", r.ServerInstanceNo); err != nil {
-		return err
+	if output == nil {
+		resp.Diagnostics.AddError("CREATING ERROR", fmt.Sprintf("fail to get BlockStorage instance, %s doesn't exist", plan.ID.ValueString()))
+		return
 	}
 
-	return nil
+	plan.refreshFromOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (r *blockStorageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state blockStorageResourceModel
 
-	if d.Get("stop_instance_before_detaching").(bool) {
-		log.Printf("[INFO] Stopping Instance %s for destroying block storage", d.Get("server_instance_no").(string))
-		if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-			return err
-		}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := deleteBlockStorage(d, config, d.Id()); err != nil {
-		return err
+	output, err := GetBlockStorage(r.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	d.SetId("")
-	return nil
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+func (r *blockStorageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state blockStorageResourceModel
 
-	if d.HasChange("server_instance_no") {
-		o, n := d.GetChange("server_instance_no")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// If server instance attached block storage, detach first
-		if len(o.(string)) > 0 {
-			if d.Get("stop_instance_before_detaching").(bool) {
-				log.Printf("[INFO] Start Instance %s after detaching block storage", o.(string))
-				if err := stopThenWaitServerInstance(config, o.(string)); err != nil {
-					return err
-				}
-			}
+	id := state.ID.ValueString()
+	stopInstance := plan.StopInstanceBeforeDetaching.ValueBool()
 
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
-			}
+	if !plan.ServerInstanceNo.Equal(state.ServerInstanceNo) {
+		o, n := state.ServerInstanceNo.ValueString(), plan.ServerInstanceNo.ValueString()
 
-			if err := detachThenWaitServerInstance(config, o.(string)); err != nil {
-				return err
+		// If server instance attached block storage, detach first
+		if len(o) > 0 {
+			if err := detachBlockStorageFromServer(r.config, id, o, stopInstance); err != nil {
+				resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+				return
 			}
 		}
 
-		if len(n.(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+		if len(n) > 0 {
+			if err := attachBlockStorage(r.config, id, n); err != nil {
+				resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+				return
 			}
 		}
 	}
 
-	if d.HasChange("size") {
-		o, n := d.GetChange("size")
+	if !plan.Size.Equal(state.Size) {
+		o, n := state.Size.ValueInt64(), plan.Size.ValueInt64()
 
-		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
+		if o >= n {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("size"),
+				"UPDATING ERROR",
+				fmt.Sprintf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o),
+			)
+			return
 		}
 
-		// If server instance attached block storage, detach first
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if d.Get("stop_instance_before_detaching").(bool) {
-				log.Printf("[INFO] Start Instance %s after detaching block storage", d.Get("server_instance_no").(string))
-				if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-					return err
-				}
-			}
-
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
-			}
+		serverInstanceNo := plan.ServerInstanceNo.ValueString()
 
-			if err := detachThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-				return err
+		// If server instance attached block storage, detach first
+		if len(serverInstanceNo) > 0 {
+			if err := detachBlockStorageFromServer(r.config, id, serverInstanceNo, stopInstance); err != nil {
+				resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+				return
 			}
 		}
 
-		if err := changeBlockStorageSize(d, config); err != nil {
-			return err
+		if err := changeBlockStorageSize(r.config, id, state.HypervisorType.ValueString(), n); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
 
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+		if len(serverInstanceNo) > 0 {
+			if err := attachBlockStorage(r.config, id, serverInstanceNo); err != nil {
+				resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+				return
 			}
 		}
 	}
 
-	if d.HasChange("return_protection") {
-		if !config.SupportVPC {
-			return fmt.Errorf("`return_protection` only available in VPC environments")
+	if !plan.ReturnProtection.IsUnknown() && !plan.ReturnProtection.Equal(state.ReturnProtection) {
+		if !r.config.SupportVPC {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("return_protection"),
+				"UPDATING ERROR",
+				"`return_protection` only available in VPC environments",
+			)
+			return
 		}
 
-		if err := changeVpcBlockStorageReturnProtection(d, config); err != nil {
-			return err
+		if err := changeVpcBlockStorageReturnProtection(r.config, id, plan.ReturnProtection.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
 	}
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	output, err := GetBlockStorage(r.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", fmt.Sprintf("fail to get BlockStorage instance, %s doesn't exist", id))
+		return
+	}
+
+	// description can't be changed on an existing block storage; keep the
+	// planned value until the next refresh like the SDKv2 resource did.
+	description := plan.Description
+
+	plan.refreshFromOutput(output)
+	plan.Description = description
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func createBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	var id *string
-	var err error
+func (r *blockStorageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state blockStorageResourceModel
 
-	if config.SupportVPC {
-		id, err = createVpcBlockStorage(d, config)
-	} else {
-		id, err = createClassicBlockStorage(d, config)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err != nil {
-		return nil, err
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, conn.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return id, nil
+	if state.StopInstanceBeforeDetaching.ValueBool() {
+		serverInstanceNo := state.ServerInstanceNo.ValueString()
+		tflog.Info(ctx, "Stopping Instance for destroying block storage", map[string]any{"server_instance_no": serverInstanceNo})
+		if err := stopThenWaitServerInstance(r.config, serverInstanceNo); err != nil {
+			resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+			return
+		}
+	}
+
+	if err := deleteBlockStorage(r.config, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
 }
 
-func createClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+// blockStorageResourceSchemaV0 is the schema of the SDKv2
+// ncloud_block_storage, whose state is version 0.
+func blockStorageResourceSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"server_instance_no": schema.StringAttribute{
+				Optional: true,
+			},
+			"size": schema.Int64Attribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"disk_detail_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"hypervisor_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"volume_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"snapshot_no": schema.StringAttribute{
+				Optional: true,
+			},
+			"stop_instance_before_detaching": schema.BoolAttribute{
+				Optional: true,
+			},
+			"return_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"block_storage_no": schema.StringAttribute{
+				Computed: true,
+			},
+			"server_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
+			"device_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"product_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"disk_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"max_iops": schema.Int64Attribute{
+				Computed: true,
+			},
+			"encrypted_volume": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *blockStorageResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := blockStorageResourceSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state blockStorageResourceModel
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// SDKv2 stored "" for optional arguments that were never set.
+				state.ServerInstanceNo = framework.EmptyStringToNull(state.ServerInstanceNo)
+				state.SnapshotNo = framework.EmptyStringToNull(state.SnapshotNo)
+				if state.StopInstanceBeforeDetaching.IsNull() {
+					state.StopInstanceBeforeDetaching = types.BoolValue(false)
+				}
+
+				// Computed values left null would force a replacement, see
+				// serverResource.UpgradeState.
+				for _, v := range []*types.String{&state.Name, &state.DiskDetailType, &state.HypervisorType, &state.VolumeType, &state.Zone} {
+					if v.IsNull() {
+						*v = types.StringValue("")
+					}
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
+		},
+	}
+}
+
+type blockStorageResourceModel struct {
+	ID                          types.String   `tfsdk:"id"`
+	ServerInstanceNo            types.String   `tfsdk:"server_instance_no"`
+	Size                        types.Int64    `tfsdk:"size"`
+	Name                        types.String   `tfsdk:"name"`
+	Description                 types.String   `tfsdk:"description"`
+	DiskDetailType              types.String   `tfsdk:"disk_detail_type"`
+	HypervisorType              types.String   `tfsdk:"hypervisor_type"`
+	VolumeType                  types.String   `tfsdk:"volume_type"`
+	Zone                        types.String   `tfsdk:"zone"`
+	SnapshotNo                  types.String   `tfsdk:"snapshot_no"`
+	StopInstanceBeforeDetaching types.Bool     `tfsdk:"stop_instance_before_detaching"`
+	ReturnProtection            types.Bool     `tfsdk:"return_protection"`
+	BlockStorageNo              types.String   `tfsdk:"block_storage_no"`
+	ServerName                  types.String   `tfsdk:"server_name"`
+	Type                        types.String   `tfsdk:"type"`
+	DeviceName                  types.String   `tfsdk:"device_name"`
+	ProductCode                 types.String   `tfsdk:"product_code"`
+	Status                      types.String   `tfsdk:"status"`
+	DiskType                    types.String   `tfsdk:"disk_type"`
+	MaxIops                     types.Int64    `tfsdk:"max_iops"`
+	EncryptedVolume             types.Bool     `tfsdk:"encrypted_volume"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// refreshFromOutput follows the SDKv2 resource, see
+// serverResourceModel.refreshFromOutput.
+func (m *blockStorageResourceModel) refreshFromOutput(output *BlockStorage) {
+	m.ID = types.StringPointerValue(output.BlockStorageInstanceNo)
+	m.ServerInstanceNo = framework.EmptyStringToNull(types.StringPointerValue(output.ServerInstanceNo))
+	refreshInt64(&m.Size, output.BlockStorageSize)
+	refreshString(&m.Name, output.BlockStorageName)
+	refreshString(&m.Description, output.Description)
+	refreshString(&m.DiskDetailType, output.DiskDetailType)
+	refreshString(&m.HypervisorType, output.HypervisorType)
+	refreshString(&m.VolumeType, output.VolumeType)
+	refreshString(&m.Zone, output.ZoneCode)
+	refreshBool(&m.ReturnProtection, output.ReturnProtection)
+	refreshString(&m.BlockStorageNo, output.BlockStorageInstanceNo)
+	refreshString(&m.ServerName, output.ServerName)
+	refreshString(&m.Type, output.BlockStorageType)
+	refreshString(&m.DeviceName, output.DeviceName)
+	refreshString(&m.ProductCode, output.BlockStorageProductCode)
+	refreshString(&m.Status, output.Status)
+	refreshString(&m.DiskType, output.DiskType)
+	refreshInt64(&m.MaxIops, int32PtrToInt64Ptr(output.MaxIops))
+	refreshBool(&m.EncryptedVolume, output.EncryptedVolume)
+}
+
+func createClassicBlockStorage(config *conn.ProviderConfig, plan *blockStorageResourceModel) (*string, error) {
 	reqParams := &server.CreateBlockStorageInstanceRequest{
-		ServerInstanceNo:        ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageSize:        ncloud.Int64(int64(d.Get("size").(int))),
-		BlockStorageName:        StringPtrOrNil(d.GetOk("name")),
-		BlockStorageDescription: StringPtrOrNil(d.GetOk("description")),
-		DiskDetailTypeCode:      StringPtrOrNil(d.GetOk("disk_detail_type")),
+		ServerInstanceNo:        plan.ServerInstanceNo.ValueStringPointer(),
+		BlockStorageSize:        plan.Size.ValueInt64Pointer(),
+		BlockStorageName:        framework.EmptyStringToNull(plan.Name).ValueStringPointer(),
+		BlockStorageDescription: framework.EmptyStringToNull(plan.Description).ValueStringPointer(),
+		DiskDetailTypeCode:      framework.EmptyStringToNull(plan.DiskDetailType).ValueStringPointer(),
 	}
 
 	LogCommonRequest("createClassicBlockStorage", reqParams)
@@ -348,25 +639,26 @@ func createClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConf
 	return instance.BlockStorageInstanceNo, nil
 }
 
-func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func createVpcBlockStorage(config *conn.ProviderConfig, plan *blockStorageResourceModel, timeout time.Duration) (*string, error) {
 	reqParams := &vserver.CreateBlockStorageInstanceRequest{
 		RegionCode:                     &config.RegionCode,
-		BlockStorageSize:               ncloud.Int32(int32(d.Get("size").(int))),
-		BlockStorageName:               StringPtrOrNil(d.GetOk("name")),
-		BlockStorageDescription:        StringPtrOrNil(d.GetOk("description")),
-		BlockStorageDiskDetailTypeCode: StringPtrOrNil(d.GetOk("disk_detail_type")),
-		BlockStorageSnapshotInstanceNo: StringPtrOrNil(d.GetOk("snapshot_no")),
-		BlockStorageVolumeTypeCode:     StringPtrOrNil(d.GetOk("volume_type")),
-		ZoneCode:                       StringPtrOrNil(d.GetOk("zone")),
-		IsReturnProtection:             BoolPtrOrNil(d.GetOk("return_protection")),
+		BlockStorageSize:               ncloud.Int32(int32(plan.Size.ValueInt64())),
+		BlockStorageName:               framework.EmptyStringToNull(plan.Name).ValueStringPointer(),
+		BlockStorageDescription:        framework.EmptyStringToNull(plan.Description).ValueStringPointer(),
+		BlockStorageDiskDetailTypeCode: framework.EmptyStringToNull(plan.DiskDetailType).ValueStringPointer(),
+		BlockStorageSnapshotInstanceNo: framework.EmptyStringToNull(plan.SnapshotNo).ValueStringPointer(),
+		BlockStorageVolumeTypeCode:     framework.EmptyStringToNull(plan.VolumeType).ValueStringPointer(),
+		ZoneCode:                       framework.EmptyStringToNull(plan.Zone).ValueStringPointer(),
+		IsReturnProtection:             plan.ReturnProtection.ValueBoolPointer(),
 	}
 
-	hypervisorType, hypervisorTypeOk := d.GetOk("hypervisor_type")
-	_, diskTypeOk := d.GetOk("disk_detail_type")
-	volumeType := d.Get("volume_type").(string)
+	hypervisorType := plan.HypervisorType.ValueString()
+	hypervisorTypeOk := hypervisorType != ""
+	diskTypeOk := plan.DiskDetailType.ValueString() != ""
+	volumeType := plan.VolumeType.ValueString()
 
 	if (!hypervisorTypeOk && !diskTypeOk) || diskTypeOk || (hypervisorType == BlockStorageHypervisorTypeXen) {
-		reqParams.ServerInstanceNo = ncloud.String(d.Get("server_instance_no").(string))
+		reqParams.ServerInstanceNo = plan.ServerInstanceNo.ValueStringPointer()
 	}
 
 	if (hypervisorType == BlockStorageHypervisorTypeXen) && ((volumeType == BlockStorageVolumeTypeFb1) || (volumeType == BlockStorageVolumeTypeCb1)) {
@@ -382,14 +674,14 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 	}
 
 	if hypervisorType == BlockStorageHypervisorTypeKvm {
-		zone := d.Get("zone").(string)
+		zone := plan.Zone.ValueString()
 		if len(zone) == 0 {
 			err := fmt.Errorf("`zone` is required for KVM type")
 			LogErrorResponse("createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
 
-		server, err := GetServerInstance(config, d.Get("server_instance_no").(string))
+		server, err := GetServerInstance(config, plan.ServerInstanceNo.ValueString())
 		if err == nil && server == nil {
 			err = fmt.Errorf("fail to get serverInstance")
 		}
@@ -421,15 +713,14 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 	}
 
 	instance := resp.BlockStorageInstanceList[0]
-	output, err := waitForBlockStorageCreation(config, *instance.BlockStorageInstanceNo)
+	output, err := waitForBlockStorageCreation(config, *instance.BlockStorageInstanceNo, timeout)
 	if err != nil {
 		LogErrorResponse("createVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
 
 	if *output.StatusName == BlockStorageStatusNameDetach {
-		d.SetId(*instance.BlockStorageInstanceNo)
-		if err := attachBlockStorage(d, config); err != nil {
+		if err := attachBlockStorage(config, *instance.BlockStorageInstanceNo, plan.ServerInstanceNo.ValueString()); err != nil {
 			return nil, err
 		}
 	}
@@ -528,14 +819,13 @@ func getVpcBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage,
 	return nil, nil
 }
 
-func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
-
+func deleteBlockStorage(config *conn.ProviderConfig, id string, timeout time.Duration) error {
 	var err error
 
 	if config.SupportVPC {
-		err = deleteVpcBlockStorage(d, config, id)
+		err = deleteVpcBlockStorage(config, id)
 	} else {
-		err = deleteClassicBlockStorage(d, config, id)
+		err = deleteClassicBlockStorage(config, id)
 	}
 
 	if err != nil {
@@ -555,7 +845,7 @@ func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id
 			}
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    conn.DefaultTimeout,
+		Timeout:    timeout,
 		Delay:      2 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -568,7 +858,7 @@ func deleteBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id
 	return nil
 }
 
-func deleteClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteClassicBlockStorage(config *conn.ProviderConfig, id string) error {
 	reqParams := server.DeleteBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
@@ -586,7 +876,7 @@ func deleteClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConf
 	return nil
 }
 
-func deleteVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, id string) error {
+func deleteVpcBlockStorage(config *conn.ProviderConfig, id string) error {
 	reqParams := vserver.DeleteBlockStorageInstancesRequest{
 		RegionCode:                 &config.RegionCode,
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
@@ -625,6 +915,23 @@ func detachBlockStorage(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
+// detachBlockStorageFromServer detaches the block storage and waits for the
+// server to settle, stopping it first when asked to.
+func detachBlockStorageFromServer(config *conn.ProviderConfig, id, serverInstanceNo string, stopInstance bool) error {
+	if stopInstance {
+		log.Printf("[INFO] Stopping Instance %s for detaching block storage", serverInstanceNo)
+		if err := stopThenWaitServerInstance(config, serverInstanceNo); err != nil {
+			return err
+		}
+	}
+
+	if err := detachBlockStorage(config, id); err != nil {
+		return err
+	}
+
+	return detachThenWaitServerInstance(config, serverInstanceNo)
+}
+
 func detachClassicBlockStorage(config *conn.ProviderConfig, id string) error {
 	reqParams := &server.DetachBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
@@ -687,29 +994,29 @@ func waitForBlockStorageDetachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func attachBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachBlockStorage(config *conn.ProviderConfig, id, serverInstanceNo string) error {
 	var err error
 	if config.SupportVPC {
-		err = attachVpcBlockStorage(d, config)
+		err = attachVpcBlockStorage(config, id, serverInstanceNo)
 	} else {
-		err = attachClassicBlockStorage(d, config)
+		err = attachClassicBlockStorage(config, id, serverInstanceNo)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageAttachment(config, d.Id()); err != nil {
+	if err = waitForBlockStorageAttachment(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachClassicBlockStorage(config *conn.ProviderConfig, id, serverInstanceNo string) error {
 	reqParams := &server.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	LogCommonRequest("attachClassicBlockStorage", reqParams)
@@ -724,10 +1031,10 @@ func attachClassicBlockStorage(d *schema.ResourceData, config *conn.ProviderConf
 	return nil
 }
 
-func attachVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachVpcBlockStorage(config *conn.ProviderConfig, id, serverInstanceNo string) error {
 	reqParams := &vserver.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	LogCommonRequest("attachVpcBlockStorage", reqParams)
@@ -742,7 +1049,7 @@ func attachVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 	return nil
 }
 
-func waitForBlockStorageCreation(config *conn.ProviderConfig, id string) (*BlockStorage, error) {
+func waitForBlockStorageCreation(config *conn.ProviderConfig, id string, timeout time.Duration) (*BlockStorage, error) {
 	var blockStorageInstance *BlockStorage
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{BlockStorageStatusNameInit, BlockStorageStatusNameCreating, BlockStorageStatusNameAttaching},
@@ -760,7 +1067,7 @@ func waitForBlockStorageCreation(config *conn.ProviderConfig, id string) (*Block
 
 			return resp, *resp.StatusName, nil
 		},
-		Timeout:    conn.DefaultTimeout,
+		Timeout:    timeout,
 		Delay:      2 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -800,34 +1107,34 @@ func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeBlockStorageSize(config *conn.ProviderConfig, id, hypervisorType string, size int64) error {
 	var err error
 	if config.SupportVPC {
-		if d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeXen {
-			err = changeVpcBlockStorageVolumeSize(d, config)
+		if hypervisorType == BlockStorageHypervisorTypeXen {
+			err = changeVpcBlockStorageVolumeSize(config, id, size)
 		} else {
-			err = changeVpcBlockStorageInstance(d, config)
+			err = changeVpcBlockStorageInstance(config, id, size)
 		}
 	} else {
-		err = changeClassicBlockStorageSize(d, config)
+		err = changeClassicBlockStorageSize(config, id, size)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageOperationIsNull(config, d.Id()); err != nil {
+	if err = waitForBlockStorageOperationIsNull(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeVpcBlockStorageVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageVolumeSize(config *conn.ProviderConfig, id string, size int64) error {
 	reqParams := &vserver.ChangeBlockStorageVolumeSizeRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int32(int32(size)),
 	}
 
 	LogCommonRequest("changeVpcBlockStorageVolumeSize", reqParams)
@@ -841,11 +1148,11 @@ func changeVpcBlockStorageVolumeSize(d *schema.ResourceData, config *conn.Provid
 	return nil
 }
 
-func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageInstance(config *conn.ProviderConfig, id string, size int64) error {
 	reqParams := &vserver.ChangeBlockStorageInstanceRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int32(int32(size)),
 	}
 
 	LogCommonRequest("changeVpcBlockStorageInstance", reqParams)
@@ -859,10 +1166,10 @@ func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.Provider
 	return nil
 }
 
-func changeClassicBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeClassicBlockStorageSize(config *conn.ProviderConfig, id string, size int64) error {
 	reqParams := &server.ChangeBlockStorageVolumeSizeRequest{
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int64(int64(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int64(size),
 	}
 
 	LogCommonRequest("changeClassicBlockStorageSize", reqParams)
@@ -904,11 +1211,11 @@ func waitForBlockStorageOperationIsNull(config *conn.ProviderConfig, id string)
 	return nil
 }
 
-func changeVpcBlockStorageReturnProtection(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageReturnProtection(config *conn.ProviderConfig, id string, returnProtection bool) error {
 	reqParams := &vserver.SetBlockStorageReturnProtectionRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		IsReturnProtection:     ncloud.Bool(d.Get("return_protection").(bool)),
+		BlockStorageInstanceNo: ncloud.String(id),
+		IsReturnProtection:     ncloud.Bool(returnProtection),
 	}
 
 	LogCommonRequest("changeVpcBlockStorageReturnProtection", reqParams)
@@ -29,7 +29,84 @@ func DataSourceNcloudBlockStorage() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudBlockStorage(), fieldMap, dataSourceNcloudBlockStorageRead)
+	return GetSingularDataSourceItemSchema(blockStorageDataSourceItem(), fieldMap, dataSourceNcloudBlockStorageRead)
+}
+
+// blockStorageDataSourceItem lists the block storage attributes the data
+// sources expose.
+func blockStorageDataSourceItem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_detail_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hypervisor_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"return_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"block_storage_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"encrypted_volume": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
 }
 
 func dataSourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
@@ -68,7 +145,7 @@ func getBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([
 }
 
 func getClassicBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,8 @@
 package server_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -18,7 +16,7 @@ func TestAccDataSourceNcloudBlockStorage_classic_basic(t *testing.T) {
 
 	resourceName := "ncloud_block_storage.storage"
 	dataName := "data.ncloud_block_storage.by_id"
-	name := fmt.Sprintf("tf-ds-storage-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -58,7 +56,7 @@ func TestAccDataSourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 
 	resourceName := "ncloud_block_storage.storage"
 	dataName := "data.ncloud_block_storage.by_id"
-	name := fmt.Sprintf("tf-ds-storage-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 )
 
 func TestAccResourceNcloudBlockStorageSnapshot_vpc_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-snap-%s", acctest.RandString(5))
+	name := RandomName(t, "snap")
 	resourceName := "ncloud_block_storage_snapshot.snapshot"
 	hypervisorType := "KVM"
 	serverSpec := "s2-g3"
@@ -27,7 +26,7 @@ func TestAccResourceNcloudBlockStorageSnapshot_vpc_basic(t *testing.T) {
 	volumeType := "CB1"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/createBlockStorageSnapshotInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckVpcBlockStorageSnapshotDestroy,
 		Steps: []resource.TestStep{
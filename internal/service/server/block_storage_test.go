@@ -5,7 +5,7 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +21,7 @@ func TestAccResourceNcloudBlockStorage_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -61,7 +61,7 @@ func TestAccResourceNcloudBlockStorage_classic_basic(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +98,7 @@ func TestAccResourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_kvm(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-kvm-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 	zone := "KR-2"
 	volumeType := "CB1"
@@ -138,7 +138,7 @@ func TestAccResourceNcloudBlockStorage_classic_ChangeServerInstance(t *testing.T
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-update-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -166,7 +166,7 @@ func TestAccResourceNcloudBlockStorage_classic_ChangeServerInstance(t *testing.T
 
 func TestAccResourceNcloudBlockStorage_vpc_ChangeServerInstance(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-update-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -195,7 +195,7 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 	t.Skip()
 
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-size-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -207,7 +207,7 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccBlockStorageClassicConfigWithSize(name, 5),
-				ExpectError: regexp.MustCompile(`expected size to be at least \(10\), got 5`),
+				ExpectError: regexp.MustCompile(`Attribute size value must be at least 10, got: 5`),
 			},
 			{
 				Config: testAccBlockStorageClassicConfigWithSize(name, 10),
@@ -240,7 +240,7 @@ func TestAccResourceNcloudBlockStorage_classic_size(t *testing.T) {
 
 func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 	var storageInstance server.BlockStorage
-	name := fmt.Sprintf("tf-storage-size-%s", acctest.RandString(5))
+	name := RandomName(t, "storage")
 	resourceName := "ncloud_block_storage.storage"
 
 	resource.Test(t, resource.TestCase{
@@ -249,8 +249,8 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 		CheckDestroy:             testAccCheckBlockStorageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBlockStorageVpcConfigWithSize(name+acctest.RandString(5), 5),
-				ExpectError: regexp.MustCompile(`expected size to be at least \(10\), got 5`),
+				Config:      testAccBlockStorageVpcConfigWithSize(RandomName(t, "storage"), 5),
+				ExpectError: regexp.MustCompile(`Attribute size value must be at least 10, got: 5`),
 			},
 			{
 				Config: testAccBlockStorageVpcConfigWithSize(name, 10),
@@ -271,7 +271,7 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 				ExpectError: regexp.MustCompile("The storage size is only expandable, not shrinking."),
 			},
 			{
-				Config: testAccBlockStorageVpcConfigWithSize(name+acctest.RandString(5), 2000),
+				Config: testAccBlockStorageVpcConfigWithSize(RandomName(t, "storage"), 2000),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, GetTestProvider(true)),
 					resource.TestCheckResourceAttr(resourceName, "size", "2000"),
@@ -281,6 +281,32 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 	})
 }
 
+func TestResourceNcloudBlockStorage_upgradeStateV0(t *testing.T) {
+	state := testUpgradeResourceStateV0(t, "ncloud_block_storage", `{
+		"id": "1234",
+		"region": "FKR",
+		"size": 10,
+		"server_instance_no": "",
+		"snapshot_no": "",
+		"return_protection": false
+	}`)
+
+	for _, name := range []string{"server_instance_no", "snapshot_no"} {
+		if !state[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, state[name])
+		}
+	}
+	if !state["volume_type"].Equal(tftypes.NewValue(tftypes.String, "")) {
+		t.Errorf("expected volume_type to be empty, got %s", state["volume_type"])
+	}
+	if !state["stop_instance_before_detaching"].Equal(tftypes.NewValue(tftypes.Bool, false)) {
+		t.Errorf("expected stop_instance_before_detaching to default to false, got %s", state["stop_instance_before_detaching"])
+	}
+	if !state["region"].Equal(tftypes.NewValue(tftypes.String, "FKR")) {
+		t.Errorf("expected region to be kept, got %s", state["region"])
+	}
+}
+
 func testAccCheckBlockStorageExistsWithProvider(n string, i *server.BlockStorage, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,10 +18,10 @@ import (
 
 func TestAccResourceNcloudInitScript_basic(t *testing.T) {
 	var InitScript vserver.InitScript
-	name := fmt.Sprintf("tf-init-script-basic-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "initscript")
 	resourceName := "ncloud_init_script.foo"
 
-	resource.Test(t, resource.TestCase{
+	acctest.VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckInitScriptDestroy,
@@ -44,7 +43,7 @@ func TestAccResourceNcloudInitScript_basic(t *testing.T) {
 
 func TestAccResourceNcloudInitScript_disappears(t *testing.T) {
 	var InitScript vserver.InitScript
-	name := fmt.Sprintf("tf-init-script-disappear-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "initscript")
 	resourceName := "ncloud_init_script.foo"
 
 	resource.Test(t, resource.TestCase{
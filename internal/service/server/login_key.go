@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -34,6 +35,7 @@ var (
 
 type loginKeyResourceModel struct {
 	KeyName     types.String `tfsdk:"key_name"`
+	PublicKey   types.String `tfsdk:"public_key"`
 	PrivateKey  types.String `tfsdk:"private_key"`
 	Fingerprint types.String `tfsdk:"fingerprint"`
 	ID          types.String `tfsdk:"id"`
@@ -68,6 +70,20 @@ func (l *loginKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 				Description: "Key name to generate. If the generated key name exists, an error occurs.",
 			},
+			"public_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						loginKeyPublicKeyRequiresReplace,
+						"Replaces the login key unless the new public key matches the existing fingerprint.",
+						"Replaces the login key unless the new public key matches the existing fingerprint.",
+					),
+				},
+				Validators: []validator.String{
+					publicKeyValidator{},
+				},
+				Description: "OpenSSH public key to import instead of generating a new key pair. The private key never leaves the caller and is not stored in state.",
+			},
 			"private_key": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
@@ -111,6 +127,11 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 
 	keyName := plan.KeyName.ValueStringPointer()
 
+	if !plan.PublicKey.IsNull() {
+		l.importLoginKey(ctx, &plan, resp)
+		return
+	}
+
 	if l.config.SupportVPC {
 		privatekey, err = createVpcLoginKey(ctx, l.config, keyName)
 	} else {
@@ -160,6 +181,18 @@ func (l *loginKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	state.refreshFromOutput(output)
 
+	// The API only returns the fingerprint, so a key replaced outside Terraform
+	// shows up as a mismatch. Dropping public_key from state plans a replacement.
+	if !state.PublicKey.IsNull() {
+		if ok, _ := loginKeyFingerprintMatches(state.PublicKey.ValueString(), state.Fingerprint.ValueString()); !ok {
+			tflog.Warn(ctx, "LoginKey fingerprint no longer matches public_key", map[string]any{
+				"KeyName":     state.KeyName.ValueString(),
+				"Fingerprint": state.Fingerprint.ValueString(),
+			})
+			state.PublicKey = types.StringNull()
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -168,6 +201,24 @@ func (l *loginKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (l *loginKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state loginKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only public_key can change in place, and only to a key that matches the
+	// existing fingerprint (e.g. after import), so nothing is sent to the API.
+	plan.ID = state.ID
+	plan.Fingerprint = state.Fingerprint
+	plan.PrivateKey = state.PrivateKey
+	if !plan.PublicKey.IsNull() {
+		plan.PrivateKey = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (l *loginKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -215,6 +266,155 @@ func createVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName
 	return resp.PrivateKey, err
 }
 
+func (l *loginKeyResource) importLoginKey(ctx context.Context, plan *loginKeyResourceModel, resp *resource.CreateResponse) {
+	var err error
+
+	keyName := plan.KeyName.ValueString()
+	publicKey := strings.TrimSpace(plan.PublicKey.ValueString())
+
+	if l.config.SupportVPC {
+		err = importVpcLoginKey(ctx, l.config, keyName, publicKey)
+	} else {
+		err = importClassicLoginKey(ctx, l.config, keyName, publicKey)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing LoginKey",
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := waitForNcloudLoginKeyCreation(l.config, keyName)
+	if err != nil {
+		resp.Diagnostics.AddError("waiting for LoginKey creation", err.Error())
+		return
+	}
+
+	ok, err := loginKeyFingerprintMatches(publicKey, ncloud.StringValue(output.Fingerprint))
+	if err == nil && !ok {
+		err = fmt.Errorf("fingerprint %q returned for login key %s does not match the supplied public_key", ncloud.StringValue(output.Fingerprint), keyName)
+	}
+	if err != nil {
+		if l.config.SupportVPC {
+			_ = deleteVpcLoginKey(ctx, l.config, keyName)
+		} else {
+			_ = deleteClassicLoginKey(ctx, l.config, keyName)
+		}
+		resp.Diagnostics.AddError("Error Importing LoginKey", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	plan.PrivateKey = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func importVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName, publicKey string) error {
+	reqParams := &vserver.ImportLoginKeyRequest{
+		RegionCode: &config.RegionCode,
+		KeyName:    ncloud.String(keyName),
+		PublicKey:  ncloud.String(publicKey),
+	}
+	tflog.Info(ctx, "ImportVpcLoginKey", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+
+	resp, err := config.Client.Vserver.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		common.LogErrorResponse("importVpcLoginKey", err, keyName)
+		return err
+	}
+	tflog.Info(ctx, "ImportVpcLoginKey response", map[string]any{
+		"importVpcLoginKeyResponse": common.MarshalUncheckedString(resp),
+	})
+
+	return nil
+}
+
+func importClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName, publicKey string) error {
+	reqParams := &server.ImportLoginKeyRequest{
+		KeyName:   ncloud.String(keyName),
+		PublicKey: ncloud.String(publicKey),
+	}
+	tflog.Info(ctx, "ImportClassicLoginKey", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+
+	resp, err := config.Client.Server.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		common.LogErrorResponse("importClassicLoginKey", err, keyName)
+		return err
+	}
+	tflog.Info(ctx, "ImportClassicLoginKey response", map[string]any{
+		"importClassicLoginKeyResponse": common.MarshalUncheckedString(resp),
+	})
+
+	return nil
+}
+
+// loginKeyFingerprintMatches reports whether fingerprint belongs to the given
+// OpenSSH public key. Both the colon separated MD5 form and the SHA256 form
+// are accepted.
+func loginKeyFingerprintMatches(publicKey, fingerprint string) (bool, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return false, fmt.Errorf("parsing public_key: %w", err)
+	}
+
+	fingerprint = strings.TrimSpace(fingerprint)
+	if strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), ssh.FingerprintLegacyMD5(key)) {
+		return true, nil
+	}
+
+	sha := ssh.FingerprintSHA256(key)
+	return fingerprint == sha || fingerprint == strings.TrimPrefix(sha, "SHA256:"), nil
+}
+
+func loginKeyPublicKeyRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = true
+
+	if !req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var fingerprint types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fingerprint"), &fingerprint)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ok, _ := loginKeyFingerprintMatches(req.PlanValue.ValueString(), fingerprint.ValueString()); ok {
+		resp.RequiresReplace = false
+	}
+}
+
+type publicKeyValidator struct{}
+
+func (v publicKeyValidator) Description(ctx context.Context) string {
+	return "Value must be an OpenSSH public key."
+}
+
+func (v publicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Public Key",
+			fmt.Sprintf("Value must be an OpenSSH public key (e.g. the contents of id_rsa.pub): %s", err),
+		)
+	}
+}
+
 func waitForNcloudLoginKeyCreation(config *conn.ProviderConfig, keyName string) (*LoginKey, error) {
 	var loginkey *LoginKey
 
@@ -1,13 +1,18 @@
 package server_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/ssh"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -24,8 +29,7 @@ func TestAccResourceNcloudLoginKey_vpc_basic(t *testing.T) {
 
 func testAccResourceNcloudLoginKeyBasic(t *testing.T, isVpc bool) {
 	var loginKey *server.LoginKey
-	prefix := GetTestPrefix()
-	testKeyName := prefix + "-key"
+	testKeyName := RandomName(t, "key")
 
 	testCheck := func() func(*terraform.State) error {
 		return func(*terraform.State) error {
@@ -37,7 +41,7 @@ func testAccResourceNcloudLoginKeyBasic(t *testing.T, isVpc bool) {
 	}
 	provider := GetTestProvider(isVpc)
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: getProvidersBasedOnVpc(isVpc),
 		CheckDestroy: func(state *terraform.State) error {
@@ -65,6 +69,54 @@ func testAccResourceNcloudLoginKeyBasic(t *testing.T, isVpc bool) {
 	})
 }
 
+func TestAccResourceNcloudLoginKey_vpc_publicKey(t *testing.T) {
+	var loginKey *server.LoginKey
+	testKeyName := RandomName(t, "key")
+	publicKey, fingerprint := testAccLoginKeyPublicKey(t)
+	provider := GetTestProvider(true)
+
+	VCRTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckLoginKeyDestroyWithProvider(state, provider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoginKeyPublicKeyConfig(testKeyName, "ssh-ed25519 not-a-key"),
+				ExpectError: regexp.MustCompile("Invalid Public Key"),
+			},
+			{
+				Config: testAccLoginKeyPublicKeyConfig(testKeyName, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoginKeyExistsWithProvider("ncloud_login_key.loginkey", loginKey, provider),
+					resource.TestCheckResourceAttr("ncloud_login_key.loginkey", "key_name", testKeyName),
+					resource.TestCheckResourceAttr("ncloud_login_key.loginkey", "fingerprint", fingerprint),
+					resource.TestCheckNoResourceAttr("ncloud_login_key.loginkey", "private_key"),
+				),
+			},
+			{
+				ResourceName:            "ncloud_login_key.loginkey",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_name", "public_key"},
+			},
+		},
+	})
+}
+
+func testAccLoginKeyPublicKey(t *testing.T) (string, string) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))), ssh.FingerprintLegacyMD5(key)
+}
+
 func getProvidersBasedOnVpc(isVpc bool) map[string]func() (tfprotov6.ProviderServer, error) {
 	if isVpc {
 		return ProtoV6ProviderFactories
@@ -129,3 +181,12 @@ resource "ncloud_login_key" "loginkey" {
 }
 `, keyName)
 }
+
+func testAccLoginKeyPublicKeyConfig(keyName, publicKey string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name   = "%s"
+	public_key = "%s"
+}
+`, keyName, publicKey)
+}
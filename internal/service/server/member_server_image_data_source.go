@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -156,18 +157,28 @@ func getClassicMemberServerImage(d *schema.ResourceData, config *conn.ProviderCo
 		reqParams.PlatformTypeCodeList = ExpandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	LogCommonRequest("getClassicMemberServerImage", reqParams)
+	images, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*server.MemberServerImage, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
-	resp, err := client.Server.V2Api.GetMemberServerImageList(reqParams)
+		LogCommonRequest("getClassicMemberServerImage", pageParams)
+		resp, err := client.Server.V2Api.GetMemberServerImageList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getClassicMemberServerImage", err, pageParams)
+			return nil, nil, err
+		}
+		LogCommonResponse("getClassicMemberServerImage", GetCommonResponse(resp))
+
+		return resp.MemberServerImageList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getClassicMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageList {
+	for _, r := range images {
 		instance := map[string]interface{}{
 			"id":                                    *r.MemberServerImageNo,
 			"no":                                    *r.MemberServerImageNo,
@@ -213,18 +224,28 @@ func getVpcMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig
 		reqParams.PlatformTypeCodeList = ExpandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	LogCommonRequest("getVpcMemberServerImage", reqParams)
+	images, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vserver.MemberServerImageInstance, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		LogCommonRequest("getVpcMemberServerImage", pageParams)
+		resp, err := client.Vserver.V2Api.GetMemberServerImageInstanceList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getVpcMemberServerImage", err, pageParams)
+			return nil, nil, err
+		}
+		LogCommonResponse("getVpcMemberServerImage", GetCommonResponse(resp))
 
-	resp, err := client.Vserver.V2Api.GetMemberServerImageInstanceList(reqParams)
+		return resp.MemberServerImageInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	LogCommonResponse("getVpcMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageInstanceList {
+	for _, r := range images {
 		instance := map[string]interface{}{
 			"id":                                 *r.MemberServerImageInstanceNo,
 			"no":                                 *r.MemberServerImageInstanceNo,
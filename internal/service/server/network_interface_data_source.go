@@ -68,18 +68,28 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.Pro
 		reqParams.NetworkInterfaceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("getVpcNetworkInterfaceList", reqParams)
-	resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+	networkInterfaces, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vserver.NetworkInterface, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		LogCommonRequest("getVpcNetworkInterfaceList", pageParams)
+		resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getVpcNetworkInterfaceList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getVpcNetworkInterfaceList", resp)
 
+		return resp.NetworkInterfaceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcNetworkInterfaceList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcNetworkInterfaceList", resp)
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkInterfaceList {
+	for _, r := range networkInterfaces {
 		instance := map[string]interface{}{
 			"id":                   *r.NetworkInterfaceNo,
 			"network_interface_no": *r.NetworkInterfaceNo,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,7 +18,7 @@ import (
 
 func TestAccresourceNcloudNetworkInterface_basic(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
-	name := fmt.Sprintf("tf-nic-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "nic")
 	resourceName := "ncloud_network_interface.foo"
 
 	resource.Test(t, resource.TestCase{
@@ -54,7 +53,7 @@ func TestAccresourceNcloudNetworkInterface_basic(t *testing.T) {
 func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
 	resourceName := "ncloud_network_interface.foo"
-	name := fmt.Sprintf("tf-nic-update-%s", acctest.RandString(5))
+	name := RandomName(t, "nic")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -81,7 +80,7 @@ func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 
 func TestAccresourceNcloudNetworkInterface_disappears(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
-	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "nic")
 	resourceName := "ncloud_network_interface.foo"
 
 	resource.Test(t, resource.TestCase{
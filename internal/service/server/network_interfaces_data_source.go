@@ -25,7 +25,8 @@ func DataSourceNcloudNetworkInterfaces() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 
 			"network_interfaces": {
 				Type:     schema.TypeList,
@@ -4,20 +4,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
 )
 
 func TestAccDataSourceNcloudPlacementGroup_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-pl-group-data-%s", acctest.RandString(5))
+	name := RandomName(t, "plgroup")
 	resourceName := "ncloud_placement_group.foo"
 	dataName := "data.ncloud_placement_group.by_id"
 	dataNameFilter := "data.ncloud_placement_group.by_filter"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/createPlacementGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,10 +18,10 @@ import (
 func TestAccResourceNcloudPlacementGroup_basic(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "plgroup")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/createPlacementGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPlacementGroupDestroy,
 		Steps: []resource.TestStep{
@@ -47,10 +46,10 @@ func TestAccResourceNcloudPlacementGroup_basic(t *testing.T) {
 func TestAccResourceNcloudPlacementGroup_disappears(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "plgroup")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/createPlacementGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPlacementGroupDestroy,
 		Steps: []resource.TestStep{
@@ -69,10 +68,10 @@ func TestAccResourceNcloudPlacementGroup_disappears(t *testing.T) {
 func TestAccResourceNcloudPlacementGroup_updateName(t *testing.T) {
 	var PlacementGroup vserver.PlacementGroup
 	resourceName := "ncloud_placement_group.test"
-	name := fmt.Sprintf("tf-pl-group-update-%s", acctest.RandString(5))
+	name := RandomName(t, "plgroup")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/createPlacementGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckPlacementGroupDestroy,
 		Steps: []resource.TestStep{
@@ -93,7 +93,7 @@ func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interf
 		return NotSupportVpc("data source `ncloud_port_forwarding_rules`")
 	}
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return err
 	}
@@ -101,6 +101,7 @@ func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
+	// GetPortForwardingRuleList takes no paging parameters and returns every rule
 	reqParams := &server.GetPortForwardingRuleListRequest{
 		RegionNo: regionNo,
 		ZoneNo:   zoneNo,
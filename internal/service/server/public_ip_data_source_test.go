@@ -5,8 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -18,7 +16,7 @@ func TestAccDataSourceNcloudPublicIp_classic_basic(t *testing.T) {
 
 	resourceName := "ncloud_public_ip.public_ip"
 	dataName := "data.ncloud_public_ip.test"
-	name := fmt.Sprintf("tf-public-ip-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "pip")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -51,7 +49,7 @@ func TestAccDataSourceNcloudPublicIp_vpc_basic(t *testing.T) {
 
 	resourceName := "ncloud_public_ip.public_ip"
 	dataName := "data.ncloud_public_ip.test"
-	name := fmt.Sprintf("tf-public-ip-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "pip")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
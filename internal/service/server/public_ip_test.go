@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,7 +20,7 @@ func TestAccResourceNcloudPublicIpInstance_classic_basic(t *testing.T) {
 	t.Skip()
 
 	var instance *server.PublicIpInstance
-	description := fmt.Sprintf("test-public-ip-basic-%s", acctest.RandString(5))
+	description := RandomName(t, "pip")
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -54,11 +53,11 @@ func TestAccResourceNcloudPublicIpInstance_classic_basic(t *testing.T) {
 func TestAccResourceNcloudPublicIpInstance_vpc_basic(t *testing.T) {
 	var instance *server.PublicIpInstance
 
-	name := fmt.Sprintf("test-public-ip-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "pip")
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/getServerImageProductList") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(s *terraform.State) error {
 			return testAccCheckPublicIpInstanceDestroy(s, GetTestProvider(true))
@@ -89,8 +88,8 @@ func TestAccResourceNcloudPublicIpInstance_classic_updateServerInstanceNo(t *tes
 	t.Skip()
 
 	var instance *server.PublicIpInstance
-	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", acctest.RandString(5))
-	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", acctest.RandString(5))
+	serverNameFoo := RandomName(t, "pip")
+	serverNameBar := RandomName(t, "pip")
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
@@ -122,12 +121,12 @@ func TestAccResourceNcloudPublicIpInstance_classic_updateServerInstanceNo(t *tes
 
 func TestAccResourceNcloudPublicIpInstance_vpc_updateServerInstanceNo(t *testing.T) {
 	var instance *server.PublicIpInstance
-	serverNameFoo := fmt.Sprintf("test-public-ip-foo-%s", acctest.RandString(5))
-	serverNameBar := fmt.Sprintf("test-public-ip-bar-%s", acctest.RandString(5))
+	serverNameFoo := RandomName(t, "pip")
+	serverNameBar := RandomName(t, "pip")
 	resourceName := "ncloud_public_ip.public_ip"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/getServerImageProductList") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy: func(s *terraform.State) error {
 			return testAccCheckPublicIpInstanceDestroy(s, GetTestProvider(true))
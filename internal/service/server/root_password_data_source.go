@@ -36,7 +36,7 @@ func DataSourceNcloudRootPassword() *schema.Resource {
 func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	rootPassword, err := getRootPassword(d, config)
+	rootPassword, err := getRootPassword(config, d.Get("server_instance_no").(string), d.Get("private_key").(string))
 	if err != nil {
 		return err
 	}
@@ -47,18 +47,18 @@ func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getRootPassword(config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	if config.SupportVPC {
-		return getVpcRootPassword(d, config)
+		return getVpcRootPassword(config, serverInstanceNo, privateKey)
 	} else {
-		return getClassicRootPassword(d, config)
+		return getClassicRootPassword(config, serverInstanceNo, privateKey)
 	}
 }
 
-func getClassicRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getClassicRootPassword(config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	reqParams := &server.GetRootPasswordRequest{
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
-		PrivateKey:       ncloud.String(d.Get("private_key").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+		PrivateKey:       ncloud.String(privateKey),
 	}
 
 	LogCommonRequest("getClassicRootPassword", reqParams)
@@ -72,11 +72,11 @@ func getClassicRootPassword(d *schema.ResourceData, config *conn.ProviderConfig)
 	return resp.RootPassword, nil
 }
 
-func getVpcRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getVpcRootPassword(config *conn.ProviderConfig, serverInstanceNo, privateKey string) (*string, error) {
 	reqParams := &vserver.GetRootPasswordRequest{
 		RegionCode:       &config.RegionCode,
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
-		PrivateKey:       ncloud.String(d.Get("private_key").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+		PrivateKey:       ncloud.String(privateKey),
 	}
 
 	LogCommonRequest("getVpcRootPassword", reqParams)
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &rootPasswordEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &rootPasswordEphemeralResource{}
+)
+
+// NewRootPasswordEphemeralResource looks up the same password as the
+// ncloud_root_password data source, without storing it in the plan or state.
+func NewRootPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &rootPasswordEphemeralResource{}
+}
+
+type rootPasswordEphemeralResource struct {
+	config *conn.ProviderConfig
+}
+
+func (e *rootPasswordEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_root_password"
+}
+
+func (e *rootPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"private_key": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"root_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *rootPasswordEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *rootPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data rootPasswordEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rootPassword, err := getRootPassword(e.config, data.ServerInstanceNo.ValueString(), data.PrivateKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	data.RootPassword = types.StringPointerValue(rootPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type rootPasswordEphemeralResourceModel struct {
+	ServerInstanceNo types.String `tfsdk:"server_instance_no"`
+	PrivateKey       types.String `tfsdk:"private_key"`
+	RootPassword     types.String `tfsdk:"root_password"`
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+// newTestServerInstance returns the config of a provider whose client reaches
+// a mock server, along with a server instance created in it
+func newTestServerInstance(t *testing.T) (*conn.ProviderConfig, string) {
+	t.Helper()
+
+	srv := mockncp.NewServer("KR")
+	t.Cleanup(srv.Close)
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := config.NewClient(conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	vpcResp, err := config.Client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		RegionCode:    ncloud.String("KR"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("creating vpc: %s", err)
+	}
+	vpcNo := vpcResp.VpcList[0].VpcNo
+
+	aclResp, err := config.Client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{
+		RegionCode: ncloud.String("KR"),
+		VpcNo:      vpcNo,
+	})
+	if err != nil {
+		t.Fatalf("reading network acls: %s", err)
+	}
+
+	subnetResp, err := config.Client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		RegionCode:     ncloud.String("KR"),
+		ZoneCode:       ncloud.String("KR-1"),
+		VpcNo:          vpcNo,
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   aclResp.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PUBLIC"),
+	})
+	if err != nil {
+		t.Fatalf("creating subnet: %s", err)
+	}
+
+	serverResp, err := config.Client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		RegionCode:             ncloud.String("KR"),
+		VpcNo:                  vpcNo,
+		SubnetNo:               subnetResp.SubnetList[0].SubnetNo,
+		ServerImageProductCode: ncloud.String("SW.VSVR.OS.LNX64.ROCKY.0808.B050"),
+		ServerName:             ncloud.String("tf-acc-server"),
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{
+			{NetworkInterfaceOrder: ncloud.Int32(0)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating server: %s", err)
+	}
+
+	return config, *serverResp.ServerInstanceList[0].ServerInstanceNo
+}
+
+func openRootPassword(t *testing.T, e *rootPasswordEphemeralResource, serverInstanceNo, privateKey string) rootPasswordEphemeralResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"server_instance_no": tftypes.NewValue(tftypes.String, serverInstanceNo),
+				"private_key":        tftypes.NewValue(tftypes.String, privateKey),
+				"root_password":      tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	e.Open(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("opening: %v", resp.Diagnostics)
+	}
+
+	var result rootPasswordEphemeralResourceModel
+	if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("reading result: %v", diags)
+	}
+
+	return result
+}
+
+func TestRootPasswordEphemeralResource_open(t *testing.T) {
+	config, serverInstanceNo := newTestServerInstance(t)
+	e := &rootPasswordEphemeralResource{config: config}
+
+	result := openRootPassword(t, e, serverInstanceNo, "key-1")
+	if result.RootPassword.IsNull() || result.RootPassword.ValueString() == "" {
+		t.Fatal("expected a root password")
+	}
+	if !result.ServerInstanceNo.Equal(types.StringValue(serverInstanceNo)) {
+		t.Errorf("expected server_instance_no %s, got %s", serverInstanceNo, result.ServerInstanceNo)
+	}
+
+	if other := openRootPassword(t, e, serverInstanceNo, "key-2"); other.RootPassword.Equal(result.RootPassword) {
+		t.Error("expected a different private key to give a different password")
+	}
+}
@@ -1,339 +1,1060 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"time"
 
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
-func ResourceNcloudServer() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudServerCreate,
-		Read:   resourceNcloudServerRead,
-		Update: resourceNcloudServerUpdate,
-		Delete: resourceNcloudServerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
-			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
-		},
-		Schema: map[string]*schema.Schema{
-			"server_image_product_code": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"member_server_image_no"},
-			},
-			"member_server_image_no": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"server_image_product_code"},
-			},
-			"server_image_number": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"server_image_product_code"},
-			},
-			"server_product_code": {
-				Type:     schema.TypeString,
+var (
+	_ resource.Resource                   = &serverResource{}
+	_ resource.ResourceWithConfigure      = &serverResource{}
+	_ resource.ResourceWithImportState    = &serverResource{}
+	_ resource.ResourceWithIdentity       = &serverResource{}
+	_ resource.ResourceWithModifyPlan     = &serverResource{}
+	_ resource.ResourceWithUpgradeState   = &serverResource{}
+	_ resource.ResourceWithValidateConfig = &serverResource{}
+)
+
+func NewServerResource() resource.Resource {
+	return &serverResource{}
+}
+
+type serverResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *serverResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = framework.IDIdentitySchema()
+}
+
+func (r *serverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *serverResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = serverResourceSchema(ctx)
+}
+
+// serverResourceSchema keeps the attributes and types of the SDKv2
+// implementation, see serverResourceSchemaV0, so the version 0 state upgrader
+// only fixes up the values that SDKv2 wrote for unset arguments.
+func serverResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"server_image_product_code": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("member_server_image_no")),
+				},
+			},
+			"member_server_image_no": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("server_image_product_code")),
+				},
+			},
+			"server_image_number": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("server_image_product_code")),
+				},
 			},
-			"server_spec_code": {
-				Type:     schema.TypeString,
+			"server_product_code": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"name": {
-				Type:     schema.TypeString,
+			"server_spec_code": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-					validation.StringLenBetween(3, 30),
-					validation.StringMatch(regexp.MustCompile(`^[a-z]+[a-z0-9-]+[a-z0-9]$`), "Allows only lowercase letters(a-z), numbers, hyphen (-). Must start with an alphabetic character, must end with an English letter or number"),
-				)),
-			},
-			"description": {
-				Type:     schema.TypeString,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 30),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+[a-z0-9-]+[a-z0-9]$`), "Allows only lowercase letters(a-z), numbers, hyphen (-). Must start with an alphabetic character, must end with an English letter or number"),
+				},
 			},
-			"login_key_name": {
-				Type:     schema.TypeString,
+			"description": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"is_protect_server_termination": {
-				Type:     schema.TypeBool,
+			"login_key_name": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			// Deprecated
-			"internet_line_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"PUBLC", "GLBL"}, false)),
-				Deprecated:       "This parameter is no longer used.",
-			},
-			"fee_system_type_code": {
-				Type:     schema.TypeString,
+			"is_protect_server_termination": schema.BoolAttribute{
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"zone": {
-				Type:     schema.TypeString,
+			"internet_line_type": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("PUBLC", "GLBL"),
+				},
+				DeprecationMessage: "This parameter is no longer used.",
+			},
+			"fee_system_type_code": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"access_control_group_configuration_no_list": {
-				Type:     schema.TypeList,
+			"zone": schema.StringAttribute{
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
-				MinItems: 1,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_control_group_configuration_no_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
 			},
-			"user_data": {
-				Type:     schema.TypeString,
+			"user_data": schema.StringAttribute{
 				Optional: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"raid_type_name": {
-				Type:     schema.TypeString,
+			"raid_type_name": schema.StringAttribute{
 				Optional: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"tag_list": {
-				Type:     schema.TypeList,
+			"subnet_no": schema.StringAttribute{
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"tag_key": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"tag_value": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-					},
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"subnet_no": {
-				Type:     schema.TypeString,
+			"init_script_no": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"init_script_no": {
-				Type:     schema.TypeString,
+			"placement_group_no": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"placement_group_no": {
-				Type:     schema.TypeString,
+			"is_encrypted_base_block_storage_volume": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"memory_size": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_block_storage_size": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hypervisor_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"network_interface": {
-				Type:          schema.TypeList,
-				ConflictsWith: []string{"access_control_group_configuration_no_list"},
-				Optional:      true,
-				Computed:      true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"network_interface_no": {
-							Type:     schema.TypeString,
+			"public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_image_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_forwarding_public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_forwarding_external_port": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_forwarding_internal_port": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_block_storage_disk_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_block_storage_disk_detail_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_fee_charging_monitoring": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				DeprecationMessage: "This field no longer support",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_list": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Optional: true,
+						},
+						"tag_value": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interface": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"network_interface_no": schema.StringAttribute{
 							Required: true,
-							ForceNew: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
 						},
-						"order": {
-							Type:     schema.TypeInt,
+						"order": schema.Int64Attribute{
 							Required: true,
-							ForceNew: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
+							},
 						},
-						"subnet_no": {
-							Type:     schema.TypeString,
+						"subnet_no": schema.StringAttribute{
 							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
 						},
-						"private_ip": {
-							Type:     schema.TypeString,
+						"private_ip": schema.StringAttribute{
 							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
 						},
 					},
 				},
+				PlanModifiers: []planmodifier.List{
+					useStateForNoBlocks{},
+					listplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && len(req.PlanValue.Elements()) != len(req.StateValue.Elements())
+						},
+						"Adding or removing a network interface requires replacing the server.",
+						"Adding or removing a network interface requires replacing the server.",
+					),
+				},
 			},
-			"is_encrypted_base_block_storage_volume": {
-				Type:     schema.TypeBool,
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// useStateForNoBlocks plans the prior network interfaces when the config has
+// no network_interface blocks. SDKv2 declared the block Optional and Computed,
+// so such servers hold the default interface read back from the API.
+type useStateForNoBlocks struct{}
+
+func (m useStateForNoBlocks) Description(_ context.Context) string {
+	return "Keeps the prior network interfaces when none are configured."
+}
+
+func (m useStateForNoBlocks) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForNoBlocks) PlanModifyList(_ context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || len(req.ConfigValue.Elements()) > 0 {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+func (r *serverResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.config = req.ProviderData.(*conn.ProviderConfig)
+}
+
+// ValidateConfig rejects network_interface blocks next to
+// access_control_group_configuration_no_list. An absent block reads as an
+// empty list rather than null, so listvalidator.ConflictsWith can't tell.
+func (r *serverResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serverResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.NetworkInterface.Elements()) > 0 && !config.AccessControlGroupConfigurationNoList.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network_interface"),
+			"Invalid Attribute Combination",
+			"network_interface conflicts with access_control_group_configuration_no_list.",
+		)
+	}
+}
+
+// ModifyPlan marks the values derived from the server spec as unknown when
+// the spec changes, since only the API knows what the new spec amounts to.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state, config serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	productCodeChanged := !config.ServerProductCode.IsNull() && !plan.ServerProductCode.Equal(state.ServerProductCode)
+	specCodeChanged := !config.ServerSpecCode.IsNull() && !plan.ServerSpecCode.Equal(state.ServerSpecCode)
+	if !productCodeChanged && !specCodeChanged {
+		return
+	}
+
+	if config.ServerProductCode.IsNull() {
+		plan.ServerProductCode = types.StringUnknown()
+	}
+	if config.ServerSpecCode.IsNull() {
+		plan.ServerSpecCode = types.StringUnknown()
+	}
+	plan.CpuCount = types.Int64Unknown()
+	plan.MemorySize = types.Int64Unknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, conn.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id *string
+	var err error
+	if r.config.SupportVPC {
+		id, err = createVpcServerInstance(ctx, r.config, &plan, createTimeout)
+	} else {
+		id, err = createClassicServerInstance(ctx, r.config, &plan, createTimeout)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.ID = types.StringPointerValue(id)
+	tflog.Info(ctx, "Server instance ID", map[string]any{"id": plan.ID.ValueString()})
+
+	output, err := GetServerInstance(r.config, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("CREATING ERROR", fmt.Sprintf("fail to get Server instance, %s doesn't exist", plan.ID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, r.config, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetServerInstance(r.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, r.config, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	productCodeChanged := !plan.ServerProductCode.IsUnknown() && !plan.ServerProductCode.Equal(state.ServerProductCode)
+	specCodeChanged := !plan.ServerSpecCode.IsUnknown() && !plan.ServerSpecCode.Equal(state.ServerSpecCode)
+	if productCodeChanged || specCodeChanged {
+		var productCode, specCode *string
+		if productCodeChanged {
+			productCode = plan.ServerProductCode.ValueStringPointer()
+		}
+		if specCodeChanged {
+			specCode = plan.ServerSpecCode.ValueStringPointer()
+		}
+
+		if err := updateServerInstanceSpec(r.config, id, productCode, specCode); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	if !plan.IsProtectServerTermination.IsUnknown() && !plan.IsProtectServerTermination.Equal(state.IsProtectServerTermination) {
+		if err := updateServerProtectionTermination(r.config, id, plan.IsProtectServerTermination.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	output, err := GetServerInstance(r.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", fmt.Sprintf("fail to get Server instance, %s doesn't exist", id))
+		return
+	}
+
+	// placement_group_no can't be changed on an existing server; keep the
+	// planned value until the next refresh like the SDKv2 resource did.
+	placementGroupNo := plan.PlacementGroupNo
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, r.config, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PlacementGroupNo = placementGroupNo
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, conn.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteServerInstance(ctx, r.config, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+// serverResourceSchemaV0 is the schema of the SDKv2 ncloud_server, whose state
+// is version 0. Its deprecated "region" attribute is added by the region
+// wrapper like the region of the current schema.
+func serverResourceSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"server_image_product_code": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"member_server_image_no": schema.StringAttribute{
+				Optional: true,
+			},
+			"server_image_number": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"server_product_code": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"server_spec_code": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"login_key_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"is_protect_server_termination": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"internet_line_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"fee_system_type_code": schema.StringAttribute{
+				Optional: true,
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"access_control_group_configuration_no_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"user_data": schema.StringAttribute{
+				Optional: true,
+			},
+			"raid_type_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"subnet_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"init_script_no": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
-			"instance_no": {
-				Type:     schema.TypeString,
+			"placement_group_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"is_encrypted_base_block_storage_volume": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"instance_no": schema.StringAttribute{
 				Computed: true,
 			},
-			"vpc_no": {
-				Type:     schema.TypeString,
+			"vpc_no": schema.StringAttribute{
 				Computed: true,
 			},
-			"cpu_count": {
-				Type:     schema.TypeInt,
+			"cpu_count": schema.Int64Attribute{
 				Computed: true,
 			},
-			"memory_size": {
-				Type:     schema.TypeInt,
+			"memory_size": schema.Int64Attribute{
 				Computed: true,
 			},
-			"base_block_storage_size": {
-				Type:     schema.TypeInt,
+			"base_block_storage_size": schema.Int64Attribute{
 				Computed: true,
 			},
-			"platform_type": {
-				Type:     schema.TypeString,
+			"platform_type": schema.StringAttribute{
 				Computed: true,
 			},
-			"hypervisor_type": {
-				Type:     schema.TypeString,
+			"hypervisor_type": schema.StringAttribute{
 				Computed: true,
 			},
-			"public_ip": {
-				Type:     schema.TypeString,
+			"public_ip": schema.StringAttribute{
 				Computed: true,
 			},
-			"private_ip": {
-				Type:     schema.TypeString,
+			"private_ip": schema.StringAttribute{
 				Computed: true,
 			},
-			"server_image_name": {
-				Type:     schema.TypeString,
+			"server_image_name": schema.StringAttribute{
 				Computed: true,
 			},
-			"port_forwarding_public_ip": {
-				Type:     schema.TypeString,
+			"port_forwarding_public_ip": schema.StringAttribute{
 				Computed: true,
 			},
-			"port_forwarding_external_port": {
-				Type:     schema.TypeInt,
+			"port_forwarding_external_port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"port_forwarding_internal_port": {
-				Type:     schema.TypeInt,
+			"port_forwarding_internal_port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"base_block_storage_disk_type": {
-				Type:     schema.TypeString,
+			"base_block_storage_disk_type": schema.StringAttribute{
 				Computed: true,
 			},
-			"base_block_storage_disk_detail_type": {
-				Type:     schema.TypeString,
+			"base_block_storage_disk_detail_type": schema.StringAttribute{
 				Computed: true,
 			},
-			"is_fee_charging_monitoring": {
-				Type:       schema.TypeBool,
-				Computed:   true,
-				Deprecated: "This field no longer support",
+			"is_fee_charging_monitoring": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_list": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Optional: true,
+						},
+						"tag_value": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"network_interface": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"network_interface_no": schema.StringAttribute{
+							Required: true,
+						},
+						"order": schema.Int64Attribute{
+							Required: true,
+						},
+						"subnet_no": schema.StringAttribute{
+							Computed: true,
+						},
+						"private_ip": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
 			},
-			"region": {
-				Type:       schema.TypeString,
-				Computed:   true,
-				Deprecated: "This field no longer support",
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *serverResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := serverResourceSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state serverResourceModel
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// SDKv2 stored zero values for optional arguments that were
+				// never set. Left as is they would differ from the null
+				// config and force a replacement.
+				state.MemberServerImageNo = framework.EmptyStringToNull(state.MemberServerImageNo)
+				state.FeeSystemTypeCode = framework.EmptyStringToNull(state.FeeSystemTypeCode)
+				state.UserData = framework.EmptyStringToNull(state.UserData)
+				state.RaidTypeName = framework.EmptyStringToNull(state.RaidTypeName)
+				if len(state.AccessControlGroupConfigurationNoList.Elements()) == 0 {
+					state.AccessControlGroupConfigurationNoList = types.ListNull(types.StringType)
+				}
+
+				// Computed values the API didn't return were left null, which
+				// refreshFromOutput now stores as zero values. A null here
+				// would plan as unknown on the next update and force a
+				// replacement.
+				for _, v := range []*types.String{
+					&state.ServerImageProductCode, &state.ServerImageNumber, &state.Name, &state.Description,
+					&state.LoginKeyName, &state.InternetLineType, &state.Zone, &state.SubnetNo, &state.InitScriptNo,
+				} {
+					if v.IsNull() {
+						*v = types.StringValue("")
+					}
+				}
+				if state.IsEncryptedBaseBlockStorageVolume.IsNull() {
+					state.IsEncryptedBaseBlockStorageVolume = types.BoolValue(false)
+				}
+
+				if !state.TagList.IsNull() {
+					var tags []serverTagModel
+					resp.Diagnostics.Append(state.TagList.ElementsAs(ctx, &tags, false)...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+
+					for i := range tags {
+						tags[i].TagKey = framework.EmptyStringToNull(tags[i].TagKey)
+						tags[i].TagValue = framework.EmptyStringToNull(tags[i].TagValue)
+					}
+
+					tagList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverTagModel{}.attrTypes()}, tags)
+					resp.Diagnostics.Append(diags...)
+					state.TagList = tagList
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 			},
 		},
 	}
 }
 
-func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+type serverResourceModel struct {
+	ID                                    types.String   `tfsdk:"id"`
+	ServerImageProductCode                types.String   `tfsdk:"server_image_product_code"`
+	MemberServerImageNo                   types.String   `tfsdk:"member_server_image_no"`
+	ServerImageNumber                     types.String   `tfsdk:"server_image_number"`
+	ServerProductCode                     types.String   `tfsdk:"server_product_code"`
+	ServerSpecCode                        types.String   `tfsdk:"server_spec_code"`
+	Name                                  types.String   `tfsdk:"name"`
+	Description                           types.String   `tfsdk:"description"`
+	LoginKeyName                          types.String   `tfsdk:"login_key_name"`
+	IsProtectServerTermination            types.Bool     `tfsdk:"is_protect_server_termination"`
+	InternetLineType                      types.String   `tfsdk:"internet_line_type"`
+	FeeSystemTypeCode                     types.String   `tfsdk:"fee_system_type_code"`
+	Zone                                  types.String   `tfsdk:"zone"`
+	AccessControlGroupConfigurationNoList types.List     `tfsdk:"access_control_group_configuration_no_list"`
+	UserData                              types.String   `tfsdk:"user_data"`
+	RaidTypeName                          types.String   `tfsdk:"raid_type_name"`
+	TagList                               types.List     `tfsdk:"tag_list"`
+	SubnetNo                              types.String   `tfsdk:"subnet_no"`
+	InitScriptNo                          types.String   `tfsdk:"init_script_no"`
+	PlacementGroupNo                      types.String   `tfsdk:"placement_group_no"`
+	NetworkInterface                      types.List     `tfsdk:"network_interface"`
+	IsEncryptedBaseBlockStorageVolume     types.Bool     `tfsdk:"is_encrypted_base_block_storage_volume"`
+	InstanceNo                            types.String   `tfsdk:"instance_no"`
+	VpcNo                                 types.String   `tfsdk:"vpc_no"`
+	CpuCount                              types.Int64    `tfsdk:"cpu_count"`
+	MemorySize                            types.Int64    `tfsdk:"memory_size"`
+	BaseBlockStorageSize                  types.Int64    `tfsdk:"base_block_storage_size"`
+	PlatformType                          types.String   `tfsdk:"platform_type"`
+	HypervisorType                        types.String   `tfsdk:"hypervisor_type"`
+	PublicIp                              types.String   `tfsdk:"public_ip"`
+	PrivateIp                             types.String   `tfsdk:"private_ip"`
+	ServerImageName                       types.String   `tfsdk:"server_image_name"`
+	PortForwardingPublicIp                types.String   `tfsdk:"port_forwarding_public_ip"`
+	PortForwardingExternalPort            types.Int64    `tfsdk:"port_forwarding_external_port"`
+	PortForwardingInternalPort            types.Int64    `tfsdk:"port_forwarding_internal_port"`
+	BaseBlockStorageDiskType              types.String   `tfsdk:"base_block_storage_disk_type"`
+	BaseBlockStorageDiskDetailType        types.String   `tfsdk:"base_block_storage_disk_detail_type"`
+	IsFeeChargingMonitoring               types.Bool     `tfsdk:"is_fee_charging_monitoring"`
+	Timeouts                              timeouts.Value `tfsdk:"timeouts"`
+}
 
-	id, err := createServerInstance(d, config)
+type serverNetworkInterfaceModel struct {
+	NetworkInterfaceNo types.String `tfsdk:"network_interface_no"`
+	Order              types.Int64  `tfsdk:"order"`
+	SubnetNo           types.String `tfsdk:"subnet_no"`
+	PrivateIp          types.String `tfsdk:"private_ip"`
+}
 
-	if err != nil {
-		return err
+func (serverNetworkInterfaceModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"network_interface_no": types.StringType,
+		"order":                types.Int64Type,
+		"subnet_no":            types.StringType,
+		"private_ip":           types.StringType,
 	}
+}
 
-	d.SetId(ncloud.StringValue(id))
-	log.Printf("[INFO] Server instance ID: %s", d.Id())
+type serverTagModel struct {
+	TagKey   types.String `tfsdk:"tag_key"`
+	TagValue types.String `tfsdk:"tag_value"`
+}
 
-	return resourceNcloudServerRead(d, meta)
+func (serverTagModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tag_key":   types.StringType,
+		"tag_value": types.StringType,
+	}
 }
 
-func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
+// refreshFromOutput follows the SDKv2 resource: values the API doesn't return
+// leave the current ones alone, and unknown or null ones get the zero value.
+// A null would make UseStateForUnknown plan the attribute as unknown again
+// and force a replacement on every plan.
+func (m *serverResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, output *ServerInstance) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	r, err := GetServerInstance(config, d.Id())
-	if err != nil {
-		return err
+	if config.SupportVPC {
+		if err := buildNetworkInterfaceList(config, output); err != nil {
+			diags.AddError("READING ERROR", err.Error())
+			return diags
+		}
 	}
 
-	if r == nil {
-		d.SetId("")
-		return nil
-	}
+	m.ID = types.StringPointerValue(output.ServerInstanceNo)
+	refreshString(&m.ServerImageProductCode, output.ServerImageProductCode)
+	refreshString(&m.ServerImageNumber, output.ServerImageNo)
+	refreshString(&m.ServerProductCode, output.ServerProductCode)
+	refreshString(&m.ServerSpecCode, output.ServerSpecCode)
+	refreshString(&m.Name, output.ServerName)
+	refreshString(&m.Description, output.ServerDescription)
+	refreshString(&m.LoginKeyName, output.LoginKeyName)
+	refreshBool(&m.IsProtectServerTermination, output.IsProtectServerTermination)
+	refreshString(&m.InternetLineType, nil)
+	refreshString(&m.Zone, output.Zone)
+	refreshString(&m.SubnetNo, output.SubnetNo)
+	refreshString(&m.InitScriptNo, output.InitScriptNo)
+	refreshString(&m.PlacementGroupNo, output.PlacementGroupNo)
+	refreshBool(&m.IsEncryptedBaseBlockStorageVolume, nil)
+	refreshString(&m.InstanceNo, output.ServerInstanceNo)
+	refreshString(&m.VpcNo, output.VpcNo)
+	refreshInt64(&m.CpuCount, int32PtrToInt64Ptr(output.CpuCount))
+	refreshInt64(&m.MemorySize, output.MemorySize)
+	refreshInt64(&m.BaseBlockStorageSize, output.BaseBlockStorageSize)
+	refreshString(&m.PlatformType, output.PlatformType)
+	refreshString(&m.HypervisorType, output.HypervisorType)
+	refreshString(&m.PublicIp, output.PublicIp)
+	refreshString(&m.PrivateIp, output.PrivateIp)
+	refreshString(&m.ServerImageName, output.ServerImageName)
+	refreshString(&m.PortForwardingPublicIp, output.PortForwardingPublicIp)
+	refreshInt64(&m.PortForwardingExternalPort, int32PtrToInt64Ptr(output.PortForwardingExternalPort))
+	refreshInt64(&m.PortForwardingInternalPort, int32PtrToInt64Ptr(output.PortForwardingInternalPort))
+	refreshString(&m.BaseBlockStorageDiskType, output.BaseBlockStorageDiskType)
+	refreshString(&m.BaseBlockStorageDiskDetailType, output.BaseBlockStorageDiskDetailType)
+	refreshBool(&m.IsFeeChargingMonitoring, output.IsFeeChargingMonitoring)
+
+	networkInterfaces := make([]serverNetworkInterfaceModel, 0, len(output.NetworkInterfaceList))
+	for _, ni := range output.NetworkInterfaceList {
+		networkInterfaces = append(networkInterfaces, serverNetworkInterfaceModel{
+			NetworkInterfaceNo: types.StringPointerValue(ni.NetworkInterfaceNo),
+			Order:              types.Int64PointerValue(int32PtrToInt64Ptr(ni.Order)),
+			SubnetNo:           types.StringPointerValue(ni.SubnetNo),
+			PrivateIp:          types.StringPointerValue(ni.PrivateIp),
+		})
+	}
+	networkInterfaceList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverNetworkInterfaceModel{}.attrTypes()}, networkInterfaces)
+	diags.Append(d...)
+	m.NetworkInterface = networkInterfaceList
+
+	return diags
+}
 
-	if config.SupportVPC {
-		_ = buildNetworkInterfaceList(config, r)
+func refreshString(dst *types.String, v *string) {
+	if v != nil {
+		*dst = types.StringValue(*v)
+	} else if dst.IsUnknown() || dst.IsNull() {
+		*dst = types.StringValue("")
 	}
+}
 
-	instance := ConvertToMap(r)
+func refreshBool(dst *types.Bool, v *bool) {
+	if v != nil {
+		*dst = types.BoolValue(*v)
+	} else if dst.IsUnknown() || dst.IsNull() {
+		*dst = types.BoolValue(false)
+	}
+}
 
-	SetSingularResourceDataFromMapSchema(ResourceNcloudServer(), d, instance)
+func refreshInt64(dst *types.Int64, v *int64) {
+	if v != nil {
+		*dst = types.Int64Value(*v)
+	} else if dst.IsUnknown() || dst.IsNull() {
+		*dst = types.Int64Value(0)
+	}
+}
 
-	return nil
+func int32PtrToInt64Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return ncloud.Int64(int64(*v))
 }
 
-func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
-	serverInstance, err := GetServerInstance(config, d.Id())
+func deleteServerInstance(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	serverInstance, err := GetServerInstance(config, id)
 	if err != nil {
 		return err
 	}
 
 	if serverInstance == nil {
-		d.SetId("")
 		return nil
 	}
 
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
-		log.Printf("[INFO] Stopping Instance %q for terminate", d.Id())
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+		tflog.Info(ctx, "Stopping Instance for terminate", map[string]any{"id": id})
+		if err := stopThenWaitServerInstance(config, id); err != nil {
 			return err
 		}
 	}
 
-	blockStorageList, err := getAdditionalBlockStorageList(config, d.Id())
+	blockStorageList, err := getAdditionalBlockStorageList(config, id)
 	if err != nil {
 		return err
 	}
@@ -349,83 +1070,69 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
-		if err := detachThenWaitServerInstance(config, d.Id()); err != nil {
+		if err := detachThenWaitServerInstance(config, id); err != nil {
 			return err
 		}
 	}
 
-	if err := terminateThenWaitServerInstance(config, d.Id()); err != nil {
-		return err
-	}
-	d.SetId("")
-	return nil
+	return terminateThenWaitServerInstance(config, id, timeout)
 }
 
-func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*conn.ProviderConfig)
-
-	if d.HasChange("server_product_code") || d.HasChange("server_spec_code") {
-		if err := updateServerInstanceSpec(d, config); err != nil {
-			return err
+func createClassicServerInstance(ctx context.Context, config *conn.ProviderConfig, plan *serverResourceModel, timeout time.Duration) (*string, error) {
+	var zoneNo *string
+	if zoneCode := plan.Zone.ValueString(); zoneCode != "" {
+		no := zone.GetZoneNoByCode(config, zoneCode)
+		if no == "" {
+			return nil, fmt.Errorf("no zone data for zone_code `%s`. please change zone_code and try again", zoneCode)
 		}
-	}
-
-	if d.HasChange("is_protect_server_termination") {
-		if err := updateServerProtectionTermination(d, config); err != nil {
-			return err
-		}
-	}
-
-	return resourceNcloudServerRead(d, meta)
-}
-
-func createServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	if config.SupportVPC {
-		return createVpcServerInstance(d, config)
-	}
-
-	return createClassicServerInstance(d, config)
-}
-
-func createClassicServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	zoneNo, err := zone.ParseZoneNoParameter(config, d)
-	if err != nil {
-		return nil, err
+		zoneNo = ncloud.String(no)
 	}
 
 	reqParams := &server.CreateServerInstancesRequest{
 		ZoneNo:                     zoneNo,
-		ServerImageProductCode:     StringPtrOrNil(d.GetOk("server_image_product_code")),
-		ServerProductCode:          StringPtrOrNil(d.GetOk("server_product_code")),
-		MemberServerImageNo:        StringPtrOrNil(d.GetOk("member_server_image_no")),
-		ServerName:                 StringPtrOrNil(d.GetOk("name")),
-		ServerDescription:          StringPtrOrNil(d.GetOk("description")),
-		LoginKeyName:               StringPtrOrNil(d.GetOk("login_key_name")),
-		IsProtectServerTermination: BoolPtrOrNil(d.GetOk("is_protect_server_termination")),
-		FeeSystemTypeCode:          StringPtrOrNil(d.GetOk("fee_system_type_code")),
-		UserData:                   StringPtrOrNil(d.GetOk("user_data")),
-		RaidTypeName:               StringPtrOrNil(d.GetOk("raid_type_name")),
-	}
+		ServerImageProductCode:     framework.EmptyStringToNull(plan.ServerImageProductCode).ValueStringPointer(),
+		ServerProductCode:          framework.EmptyStringToNull(plan.ServerProductCode).ValueStringPointer(),
+		MemberServerImageNo:        framework.EmptyStringToNull(plan.MemberServerImageNo).ValueStringPointer(),
+		ServerName:                 framework.EmptyStringToNull(plan.Name).ValueStringPointer(),
+		ServerDescription:          framework.EmptyStringToNull(plan.Description).ValueStringPointer(),
+		LoginKeyName:               framework.EmptyStringToNull(plan.LoginKeyName).ValueStringPointer(),
+		IsProtectServerTermination: plan.IsProtectServerTermination.ValueBoolPointer(),
+		FeeSystemTypeCode:          framework.EmptyStringToNull(plan.FeeSystemTypeCode).ValueStringPointer(),
+		UserData:                   framework.EmptyStringToNull(plan.UserData).ValueStringPointer(),
+		RaidTypeName:               framework.EmptyStringToNull(plan.RaidTypeName).ValueStringPointer(),
+	}
+
+	if !plan.TagList.IsNull() && !plan.TagList.IsUnknown() {
+		var tags []serverTagModel
+		if diags := plan.TagList.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return nil, fmt.Errorf("reading tag_list: %v", diags)
+		}
 
-	if instanceTagList, err := expandTagListParams(d.Get("tag_list").([]interface{})); err == nil {
-		reqParams.InstanceTagList = instanceTagList
+		for _, tag := range tags {
+			reqParams.InstanceTagList = append(reqParams.InstanceTagList, &server.InstanceTagParameter{
+				TagKey:   ncloud.String(tag.TagKey.ValueString()),
+				TagValue: ncloud.String(tag.TagValue.ValueString()),
+			})
+		}
 	}
 
-	if param, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
-		reqParams.AccessControlGroupConfigurationNoList = ExpandStringInterfaceList(param.([]interface{}))
+	if !plan.AccessControlGroupConfigurationNoList.IsNull() && !plan.AccessControlGroupConfigurationNoList.IsUnknown() {
+		if diags := plan.AccessControlGroupConfigurationNoList.ElementsAs(ctx, &reqParams.AccessControlGroupConfigurationNoList, false); diags.HasError() {
+			return nil, fmt.Errorf("reading access_control_group_configuration_no_list: %v", diags)
+		}
 	}
 
 	var resp *server.CreateServerInstancesResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := sdkresource.Retry(10*time.Minute, func() *sdkresource.RetryError {
 		var err error
 		LogCommonRequest("createClassicServerInstance", reqParams)
 		resp, err = config.Client.Server.V2Api.CreateServerInstances(reqParams)
 		if err != nil {
 			errBody, _ := GetCommonErrorBody(err)
 			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorAuthorityParameter, ApiErrorServerObjectInOperation, ApiErrorPreviousServersHaveNotBeenEntirelyTerminated}) {
-				return resource.RetryableError(err)
+				return sdkresource.RetryableError(err)
 			}
-			return resource.NonRetryableError(err)
+			return sdkresource.NonRetryableError(err)
 		}
 		LogResponse("createClassicServerInstance", resp)
 		return nil
@@ -439,55 +1146,55 @@ func createClassicServerInstance(d *schema.ResourceData, config *conn.ProviderCo
 
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo, timeout); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	if _, ok := d.GetOk("subnet_no"); !ok {
+func createVpcServerInstance(ctx context.Context, config *conn.ProviderConfig, plan *serverResourceModel, timeout time.Duration) (*string, error) {
+	if plan.SubnetNo.ValueString() == "" {
 		return nil, ErrorRequiredArgOnVpc("subnet_no")
 	}
 
-	if _, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
+	if !plan.AccessControlGroupConfigurationNoList.IsNull() {
 		return nil, NotSupportVpc("`access_control_group_configuration_no_list` of ncloud_server")
 	}
 
-	if _, ok := d.GetOk("user_data"); ok {
+	if plan.UserData.ValueString() != "" {
 		return nil, NotSupportVpc("`user_data` of ncloud_server")
 	}
 
-	subnet, err := vpc.GetSubnetInstance(config, d.Get("subnet_no").(string))
+	subnet, err := vpc.GetSubnetInstance(config, plan.SubnetNo.ValueString())
 	if err != nil {
 		return nil, err
 	}
 
 	if subnet == nil {
-		return nil, fmt.Errorf("no matching subnet(%s) found", d.Get("subnet_no"))
+		return nil, fmt.Errorf("no matching subnet(%s) found", plan.SubnetNo.ValueString())
 	}
 
 	reqParams := &vserver.CreateServerInstancesRequest{
 		RegionCode:                        &config.RegionCode,
-		ServerProductCode:                 StringPtrOrNil(d.GetOk("server_product_code")),
-		ServerImageProductCode:            StringPtrOrNil(d.GetOk("server_image_product_code")),
-		MemberServerImageInstanceNo:       StringPtrOrNil(d.GetOk("member_server_image_no")),
-		ServerImageNo:                     StringPtrOrNil(d.GetOk("server_image_number")),
-		ServerSpecCode:                    StringPtrOrNil(d.GetOk("server_spec_code")),
-		ServerName:                        StringPtrOrNil(d.GetOk("name")),
-		ServerDescription:                 StringPtrOrNil(d.GetOk("description")),
-		LoginKeyName:                      StringPtrOrNil(d.GetOk("login_key_name")),
-		IsProtectServerTermination:        BoolPtrOrNil(d.GetOk("is_protect_server_termination")),
-		FeeSystemTypeCode:                 StringPtrOrNil(d.GetOk("fee_system_type_code")),
-		InitScriptNo:                      StringPtrOrNil(d.GetOk("init_script_no")),
+		ServerProductCode:                 framework.EmptyStringToNull(plan.ServerProductCode).ValueStringPointer(),
+		ServerImageProductCode:            framework.EmptyStringToNull(plan.ServerImageProductCode).ValueStringPointer(),
+		MemberServerImageInstanceNo:       framework.EmptyStringToNull(plan.MemberServerImageNo).ValueStringPointer(),
+		ServerImageNo:                     framework.EmptyStringToNull(plan.ServerImageNumber).ValueStringPointer(),
+		ServerSpecCode:                    framework.EmptyStringToNull(plan.ServerSpecCode).ValueStringPointer(),
+		ServerName:                        framework.EmptyStringToNull(plan.Name).ValueStringPointer(),
+		ServerDescription:                 framework.EmptyStringToNull(plan.Description).ValueStringPointer(),
+		LoginKeyName:                      framework.EmptyStringToNull(plan.LoginKeyName).ValueStringPointer(),
+		IsProtectServerTermination:        plan.IsProtectServerTermination.ValueBoolPointer(),
+		FeeSystemTypeCode:                 framework.EmptyStringToNull(plan.FeeSystemTypeCode).ValueStringPointer(),
+		InitScriptNo:                      framework.EmptyStringToNull(plan.InitScriptNo).ValueStringPointer(),
 		VpcNo:                             subnet.VpcNo,
 		SubnetNo:                          subnet.SubnetNo,
-		PlacementGroupNo:                  StringPtrOrNil(d.GetOk("placement_group_no")),
-		IsEncryptedBaseBlockStorageVolume: BoolPtrOrNil(d.GetOk("is_encrypted_base_block_storage_volume")),
+		PlacementGroupNo:                  framework.EmptyStringToNull(plan.PlacementGroupNo).ValueStringPointer(),
+		IsEncryptedBaseBlockStorageVolume: plan.IsEncryptedBaseBlockStorageVolume.ValueBoolPointer(),
 	}
 
-	if networkInterfaceList, ok := d.GetOk("network_interface"); !ok {
+	if len(plan.NetworkInterface.Elements()) == 0 {
 		defaultAcgNo, err := vpc.GetDefaultAccessControlGroup(config, *subnet.VpcNo)
 		if err != nil {
 			return nil, err
@@ -500,10 +1207,13 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 
 		reqParams.NetworkInterfaceList = []*vserver.NetworkInterfaceParameter{niParam}
 	} else {
-		for _, vi := range networkInterfaceList.([]interface{}) {
-			m := vi.(map[string]interface{})
-			order := m["order"].(int)
-			networkInterfaceNo := m["network_interface_no"].(string)
+		var networkInterfaces []serverNetworkInterfaceModel
+		if diags := plan.NetworkInterface.ElementsAs(ctx, &networkInterfaces, false); diags.HasError() {
+			return nil, fmt.Errorf("reading network_interface: %v", diags)
+		}
+
+		for _, ni := range networkInterfaces {
+			networkInterfaceNo := ni.NetworkInterfaceNo.ValueString()
 
 			networkInterface, err := GetNetworkInterface(config, networkInterfaceNo)
 			if err != nil {
@@ -515,7 +1225,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 			}
 
 			niParam := &vserver.NetworkInterfaceParameter{
-				NetworkInterfaceOrder: ncloud.Int32(int32(order)),
+				NetworkInterfaceOrder: ncloud.Int32(int32(ni.Order.ValueInt64())),
 				NetworkInterfaceNo:    networkInterface.NetworkInterfaceNo,
 				SubnetNo:              networkInterface.SubnetNo,
 			}
@@ -533,7 +1243,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 	LogResponse("createVpcServerInstance", resp)
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo, timeout); err != nil {
 		return nil, err
 	}
 
@@ -553,7 +1263,7 @@ func createVpcServerInstance(d *schema.ResourceData, config *conn.ProviderConfig
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) error {
+func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"RUN"},
@@ -569,7 +1279,7 @@ func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) er
 
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultCreateTimeout,
+		Timeout:    timeout,
 		Delay:      2 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -582,41 +1292,41 @@ func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) er
 	return nil
 }
 
-func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	serverInstance, err := GetServerInstance(config, d.Id())
+func updateServerInstanceSpec(config *conn.ProviderConfig, id string, productCode, specCode *string) error {
+	serverInstance, err := GetServerInstance(config, id)
 	if err != nil {
 		return err
 	}
 
 	if serverInstance == nil {
-		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
 	}
 
-	log.Printf("[INFO] Stopping Instance %q for server_product_code change", d.Id())
+	log.Printf("[INFO] Stopping Instance %q for server_product_code change", id)
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+		if err := stopThenWaitServerInstance(config, id); err != nil {
 			return err
 		}
 	}
 
-	if err := changeServerInstanceSpec(d, config); err != nil {
+	if err := changeServerInstanceSpec(config, id, productCode, specCode); err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
-	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
+	log.Printf("[INFO] Start Instance %q for server_product_code change", id)
+	if err := startThenWaitServerInstance(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeServerInstanceSpec(config *conn.ProviderConfig, id string, productCode, specCode *string) error {
 	var err error
 	if config.SupportVPC {
-		err = changeVpcServerInstanceSpec(d, config)
+		err = changeVpcServerInstanceSpec(config, id, productCode, specCode)
 	} else {
-		err = changeClassicServerInstanceSpec(d, config)
+		err = changeClassicServerInstanceSpec(config, id, productCode)
 	}
 
 	if err != nil {
@@ -627,14 +1337,14 @@ func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 		Pending: []string{"CHNG"},
 		Target:  []string{"NULL"},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := GetServerInstance(config, d.Id())
+			instance, err := GetServerInstance(config, id)
 
 			if err != nil {
 				return 0, "", err
 			}
 
 			if instance == nil {
-				return 0, "", fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+				return 0, "", fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
 			}
 
 			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
@@ -652,10 +1362,10 @@ func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
-func changeClassicServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeClassicServerInstanceSpec(config *conn.ProviderConfig, id string, productCode *string) error {
 	reqParams := &server.ChangeServerInstanceSpecRequest{
-		ServerInstanceNo:  ncloud.String(d.Get("instance_no").(string)),
-		ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
+		ServerInstanceNo:  ncloud.String(id),
+		ServerProductCode: productCode,
 	}
 
 	LogCommonRequest("changeClassicServerInstanceSpec", reqParams)
@@ -669,17 +1379,12 @@ func changeClassicServerInstanceSpec(d *schema.ResourceData, config *conn.Provid
 	return nil
 }
 
-func changeVpcServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcServerInstanceSpec(config *conn.ProviderConfig, id string, productCode, specCode *string) error {
 	reqParams := &vserver.ChangeServerInstanceSpecRequest{
-		RegionCode:       &config.RegionCode,
-		ServerInstanceNo: ncloud.String(d.Get("instance_no").(string)),
-	}
-
-	if d.HasChange("server_product_code") {
-		reqParams.ServerProductCode = ncloud.String(d.Get("server_product_code").(string))
-	}
-	if d.HasChange("server_spec_code") {
-		reqParams.ServerSpecCode = ncloud.String(d.Get("server_spec_code").(string))
+		RegionCode:        &config.RegionCode,
+		ServerInstanceNo:  ncloud.String(id),
+		ServerProductCode: productCode,
+		ServerSpecCode:    specCode,
 	}
 
 	LogCommonRequest("changeVpcServerInstanceSpec", reqParams)
@@ -693,19 +1398,19 @@ func changeVpcServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderCo
 	return nil
 }
 
-func updateServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateServerProtectionTermination(config *conn.ProviderConfig, id string, protect bool) error {
 	if config.SupportVPC {
-		return updateVpcServerProtectionTermination(d, config)
+		return updateVpcServerProtectionTermination(config, id, protect)
 	}
 
-	return updateClassicServerProtectionTermination(d, config)
+	return updateClassicServerProtectionTermination(config, id, protect)
 }
 
-func updateVpcServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateVpcServerProtectionTermination(config *conn.ProviderConfig, id string, protect bool) error {
 	reqParams := &vserver.SetProtectServerTerminationRequest{
 		RegionCode:                 &config.RegionCode,
-		ServerInstanceNo:           ncloud.String(d.Id()),
-		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
+		ServerInstanceNo:           ncloud.String(id),
+		IsProtectServerTermination: ncloud.Bool(protect),
 	}
 
 	LogCommonRequest("SetProtectServerTermination", reqParams)
@@ -719,10 +1424,10 @@ func updateVpcServerProtectionTermination(d *schema.ResourceData, config *conn.P
 	return nil
 }
 
-func updateClassicServerProtectionTermination(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func updateClassicServerProtectionTermination(config *conn.ProviderConfig, id string, protect bool) error {
 	reqParams := &server.SetProtectServerTerminationRequest{
-		ServerInstanceNo:           ncloud.String(d.Id()),
-		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
+		ServerInstanceNo:           ncloud.String(id),
+		IsProtectServerTermination: ncloud.Bool(protect),
 	}
 
 	LogCommonRequest("SetProtectServerTermination", reqParams)
@@ -1105,7 +1810,7 @@ func stopVpcServerInstance(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
+func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string, timeout time.Duration) error {
 	var err error
 	if config.SupportVPC {
 		err = terminateVpcServerInstance(config, id)
@@ -1131,7 +1836,7 @@ func terminateThenWaitServerInstance(config *conn.ProviderConfig, id string) err
 			}
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    conn.DefaultTimeout,
+		Timeout:    timeout,
 		Delay:      2 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -1150,7 +1855,7 @@ func terminateClassicServerInstance(config *conn.ProviderConfig, id string) erro
 	}
 
 	var resp *server.TerminateServerInstancesResponse
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := sdkresource.Retry(1*time.Minute, func() *sdkresource.RetryError {
 		var err error
 		LogCommonRequest("terminateClassicServerInstance", reqParams)
 		resp, err = config.Client.Server.V2Api.TerminateServerInstances(reqParams)
@@ -1158,9 +1863,9 @@ func terminateClassicServerInstance(config *conn.ProviderConfig, id string) erro
 			errBody, _ := GetCommonErrorBody(err)
 			if ContainsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorServerObjectInOperation2}) {
 				LogErrorResponse("retry terminateClassicServerInstance", err, reqParams)
-				return resource.RetryableError(err)
+				return sdkresource.RetryableError(err)
 			}
-			return resource.NonRetryableError(err)
+			return sdkresource.NonRetryableError(err)
 		}
 		LogResponse("terminateClassicServerInstance", resp)
 		return nil
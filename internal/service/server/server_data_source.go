@@ -23,7 +23,166 @@ func DataSourceNcloudServer() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudServer(), fieldMap, dataSourceNcloudServerRead)
+	return GetSingularDataSourceItemSchema(serverDataSourceItem(), fieldMap, dataSourceNcloudServerRead)
+}
+
+// serverDataSourceItem lists the server attributes the data sources expose.
+func serverDataSourceItem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"server_image_product_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_image_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_product_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_spec_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"login_key_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_protect_server_termination": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"internet_line_type": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "This parameter is no longer used.",
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"init_script_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placement_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_interface": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"is_encrypted_base_block_storage_volume": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"instance_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"memory_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"base_block_storage_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"platform_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hypervisor_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_image_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_forwarding_public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_forwarding_external_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"port_forwarding_internal_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"base_block_storage_disk_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_block_storage_disk_detail_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_fee_charging_monitoring": {
+				Type:       schema.TypeBool,
+				Computed:   true,
+				Deprecated: "This field no longer support",
+			},
+			"region": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "This field no longer support",
+			},
+		},
+	}
 }
 
 func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
@@ -53,67 +212,93 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func getServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+	var id string
+	if v, ok := d.GetOk("id"); ok {
+		id = v.(string)
+	}
+
 	if config.SupportVPC {
-		return getVpcServerList(d, config)
-	} else {
-		return getClassicServerList(d, config)
+		return getVpcServerList(config, id, GetMaxResults(d))
 	}
-}
 
-func getClassicServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
+	return getClassicServerList(config, regionNo, id, GetMaxResults(d))
+}
 
+// getClassicServerList reads every page of servers, or only the server id when
+// it is not empty, up to maxResults when it is positive
+func getClassicServerList(config *conn.ProviderConfig, regionNo *string, id string, maxResults int) ([]*ServerInstance, error) {
 	reqParams := &server.GetServerInstanceListRequest{
 		RegionNo: regionNo,
 	}
 
-	if v, ok := d.GetOk("id"); ok {
-		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
+	if id != "" {
+		reqParams.ServerInstanceNoList = []*string{ncloud.String(id)}
 	}
 
-	LogCommonRequest("getClassicServerList", reqParams)
-	resp, err := config.Client.Server.V2Api.GetServerInstanceList(reqParams)
+	instances, err := ListAllPages(maxResults, func(pageNo, pageSize int32) ([]*server.ServerInstance, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		LogCommonRequest("getClassicServerList", pageParams)
+		resp, err := config.Client.Server.V2Api.GetServerInstanceList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getClassicServerList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getClassicServerList", resp)
 
+		return resp.ServerInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getClassicServerList", err, reqParams)
 		return nil, err
 	}
 
-	LogResponse("getClassicServerList", resp)
-
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range instances {
 		list = append(list, convertClassicServerInstance(r))
 	}
 
 	return list, nil
 }
 
-func getVpcServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+// getVpcServerList is getClassicServerList for VPC servers
+func getVpcServerList(config *conn.ProviderConfig, id string, maxResults int) ([]*ServerInstance, error) {
 	client := config.Client
 
 	reqParams := &vserver.GetServerInstanceListRequest{
 		RegionCode: &config.RegionCode,
 	}
 
-	if v, ok := d.GetOk("id"); ok {
-		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
+	if id != "" {
+		reqParams.ServerInstanceNoList = []*string{ncloud.String(id)}
 	}
 
-	LogCommonRequest("getVpcServerList", reqParams)
+	instances, err := ListAllPages(maxResults, func(pageNo, pageSize int32) ([]*vserver.ServerInstance, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		LogCommonRequest("getVpcServerList", pageParams)
+		resp, err := client.Vserver.V2Api.GetServerInstanceList(&pageParams)
+		if err != nil {
+			LogErrorResponse("getVpcServerList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("getVpcServerList", resp)
 
-	resp, err := client.Vserver.V2Api.GetServerInstanceList(reqParams)
+		return resp.ServerInstanceList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("getVpcServerList", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getVpcServerList", resp)
 
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range instances {
 		list = append(list, convertVcpServerInstance(r))
 	}
 
@@ -40,7 +40,7 @@ func TestAccDataSourceNcloudServerImage_vpc_byCode(t *testing.T) {
 	dataName := "data.ncloud_server_image.test1"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/getServerImageProductList") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -80,7 +80,7 @@ func TestAccDataSourceNcloudServerImage_classic_byFilterProductCode(t *testing.T
 
 func TestAccDataSourceNcloudServerImage_vpc_byFilterProductCode(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/getServerImageProductList") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -106,7 +106,10 @@ func TestAccDataSourceNcloudServerImage_vpc_byFilterProductName(t *testing.T) {
 
 func testAccDataSourceNcloudServerImageByFilterProductName(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -132,7 +135,10 @@ func TestAccDataSourceNcloudServerImage_vpc_byBlockStorageSize(t *testing.T) {
 
 func testAccDataSourceNcloudServerImageByBlockStorageSize(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -174,6 +180,14 @@ func TestAccDataSourceNcloudServerImage_vpc_byPlatformType(t *testing.T) {
 	})
 }
 
+// testAccServerAPI returns the API of a server action on the classic or VPC platform
+func testAccServerAPI(isVpc bool, action string) string {
+	if isVpc {
+		return "vserver/" + action
+	}
+	return "server/" + action
+}
+
 func testAccDataSourceNcloudServerImageByCodeConfig(productCode string) string {
 	return fmt.Sprintf(`
 data "ncloud_server_image" "test1" {
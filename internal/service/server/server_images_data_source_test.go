@@ -21,7 +21,10 @@ func TestAccDataSourceNcloudServerImages_vpc_basic(t *testing.T) {
 
 func testAccDataSourceNcloudServerImagesBasic(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -47,7 +50,10 @@ func TestAccDataSourceNcloudServerImages_vpc_linux(t *testing.T) {
 
 func testAccDataSourceNcloudServerImagesLinux(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -73,7 +79,10 @@ func TestAccDataSourceNcloudServerImages_vpc_windows(t *testing.T) {
 
 func testAccDataSourceNcloudServerImagesWindows(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -99,7 +108,10 @@ func TestAccDataSourceNcloudServerImages_vpc_bareMetal(t *testing.T) {
 
 func testAccDataSourceNcloudServerImagesBareMetal(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -125,7 +137,10 @@ func TestAccDataSourceNcloudServerImages_vpc_blockStorageSize(t *testing.T) {
 
 func testAccDataSourceNcloudServerImagesBlockStorageSize(t *testing.T, isVpc bool) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck: func() {
+			TestAccPreCheck(t)
+			TestAccPreCheckMock(t, testAccServerAPI(isVpc, "getServerImageProductList"))
+		},
 		ProtoV6ProviderFactories: GetTestProviderFactories(isVpc),
 		Steps: []resource.TestStep{
 			{
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ list.ListResource              = &serverListResource{}
+	_ list.ListResourceWithConfigure = &serverListResource{}
+)
+
+// NewServerListResource lists the servers that ncloud_servers reads, for import blocks
+func NewServerListResource() list.ListResource {
+	return &serverListResource{}
+}
+
+type serverListResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *serverListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *serverListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{}
+}
+
+func (r *serverListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *serverListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var instances []*ServerInstance
+	var err error
+	if r.config.SupportVPC {
+		instances, err = getVpcServerList(r.config, "", int(req.Limit))
+	} else {
+		var regionNo *string
+		if r.config.RegionNo != "" {
+			regionNo = &r.config.RegionNo
+		}
+		instances, err = getClassicServerList(r.config, regionNo, "", int(req.Limit))
+	}
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("READING ERROR", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, output := range instances {
+			result := req.NewListResult(ctx)
+			result.DisplayName = ncloud.StringValue(output.ServerName)
+			result.Diagnostics.Append(framework.SetIDIdentity(ctx, result.Identity, types.StringPointerValue(output.ServerInstanceNo))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var model serverResourceModel
+				framework.NullAttributes(ctx, result.Resource)
+				result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+				result.Diagnostics.Append(model.refreshFromOutput(ctx, r.config, output)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerListResource_list(t *testing.T) {
+	ctx := context.Background()
+	config, serverInstanceNo := newTestServerInstance(t)
+
+	r := NewServerResource().(resource.ResourceWithIdentity)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	l := NewServerListResource().(list.ListResourceWithConfigure)
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: config}, &resource.ConfigureResponse{})
+	var configResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configResp)
+
+	var stream list.ListResultsStream
+	l.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configResp.Schema,
+			Raw:    tftypes.NewValue(configResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("listing: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 server, got %d", len(results))
+	}
+
+	var id string
+	if diags := results[0].Identity.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		t.Fatalf("reading identity: %v", diags)
+	}
+	if id != serverInstanceNo {
+		t.Errorf("expected identity %s, got %s", serverInstanceNo, id)
+	}
+	if results[0].DisplayName != "tf-acc-server" {
+		t.Errorf("unexpected display name %s", results[0].DisplayName)
+	}
+
+	var model serverResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading resource: %v", diags)
+	}
+	if model.ID.ValueString() != serverInstanceNo || model.Name.ValueString() != "tf-acc-server" {
+		t.Errorf("expected server %s named tf-acc-server, got %s named %s", serverInstanceNo, model.ID, model.Name)
+	}
+	if model.SubnetNo.ValueString() == "" || len(model.NetworkInterface.Elements()) != 1 {
+		t.Errorf("expected the subnet and network interface of the server, got %s and %s", model.SubnetNo, model.NetworkInterface)
+	}
+}
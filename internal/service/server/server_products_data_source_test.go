@@ -31,7 +31,7 @@ func TestAccDataSourceNcloudServerProducts_classic_basic(t *testing.T) {
 
 func TestAccDataSourceNcloudServerProducts_vpc_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vserver/getServerProductList") },
 		IsUnitTest:               false,
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ action.Action              = &serverRebootAction{}
+	_ action.ActionWithConfigure = &serverRebootAction{}
+)
+
+// NewServerRebootAction stops and starts a running server the same way
+// ncloud_server does when its spec changes, and waits until it runs again.
+func NewServerRebootAction() action.Action {
+	return &serverRebootAction{}
+}
+
+type serverRebootAction struct {
+	config *conn.ProviderConfig
+}
+
+func (a *serverRebootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_reboot"
+}
+
+func (a *serverRebootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_instance_no": schema.StringAttribute{
+				Required:    true,
+				Description: "Server instance number to reboot.",
+			},
+		},
+	}
+}
+
+func (a *serverRebootAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.config = config
+}
+
+func (a *serverRebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data serverRebootActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := data.ServerInstanceNo.ValueString()
+
+	instance, err := GetServerInstance(a.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+	if instance == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("server instance %s doesn't exist", id))
+		return
+	}
+	if status := ncloud.StringValue(instance.ServerInstanceStatus); status != "RUN" {
+		resp.Diagnostics.AddError("REBOOT ERROR", fmt.Sprintf("server instance %s is not running: %s", id, status))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping server instance %s", id)})
+	if err := stopThenWaitServerInstance(a.config, id); err != nil {
+		resp.Diagnostics.AddError("REBOOT ERROR", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting server instance %s", id)})
+	if err := startThenWaitServerInstance(a.config, id); err != nil {
+		resp.Diagnostics.AddError("REBOOT ERROR", err.Error())
+		return
+	}
+}
+
+type serverRebootActionModel struct {
+	ServerInstanceNo types.String `tfsdk:"server_instance_no"`
+}
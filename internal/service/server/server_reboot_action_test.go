@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func invokeServerReboot(t *testing.T, a *serverRebootAction, serverInstanceNo string) ([]string, action.InvokeResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	var progress []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"server_instance_no": tftypes.NewValue(tftypes.String, serverInstanceNo),
+			}),
+		},
+	}, &resp)
+
+	return progress, resp
+}
+
+func TestServerRebootAction_invoke(t *testing.T) {
+	config, serverInstanceNo := newTestServerInstance(t)
+	a := &serverRebootAction{config: config}
+
+	progress, resp := invokeServerReboot(t, a, serverInstanceNo)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("rebooting: %v", resp.Diagnostics)
+	}
+	if len(progress) != 2 {
+		t.Errorf("expected stop and start progress, got %v", progress)
+	}
+
+	instance, err := GetServerInstance(config, serverInstanceNo)
+	if err != nil {
+		t.Fatalf("reading server: %s", err)
+	}
+	if status := ncloud.StringValue(instance.ServerInstanceStatus); status != "RUN" {
+		t.Errorf("expected server to run after reboot, got %s", status)
+	}
+}
+
+func TestServerRebootAction_notRunning(t *testing.T) {
+	config, serverInstanceNo := newTestServerInstance(t)
+	a := &serverRebootAction{config: config}
+
+	if err := stopVpcServerInstance(config, serverInstanceNo); err != nil {
+		t.Fatalf("stopping server: %s", err)
+	}
+
+	if _, resp := invokeServerReboot(t, a, serverInstanceNo); !resp.Diagnostics.HasError() {
+		t.Error("expected stopped server to fail reboot")
+	}
+	if _, resp := invokeServerReboot(t, a, "999999"); !resp.Diagnostics.HasError() {
+		t.Error("expected missing server to fail reboot")
+	}
+}
@@ -13,7 +13,7 @@ func TestAccDataSourceNcloudServerSpecs_basic(t *testing.T) {
 	generationCode := "G3"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vserver/getServerSpecList") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
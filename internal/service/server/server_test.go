@@ -1,11 +1,14 @@
 package server_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -293,6 +296,166 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestResourceNcloudServer_upgradeStateV0(t *testing.T) {
+	state := testUpgradeResourceStateV0(t, "ncloud_server", `{
+		"id": "1234",
+		"region": "FKR",
+		"name": "tf-server",
+		"subnet_no": "5678",
+		"server_image_product_code": "SW.VSVR.OS.LNX64.ROCKY.0810.B050",
+		"member_server_image_no": "",
+		"fee_system_type_code": "",
+		"user_data": "",
+		"raid_type_name": "",
+		"access_control_group_configuration_no_list": [],
+		"network_interface": [
+			{"network_interface_no": "91", "order": 0, "subnet_no": "5678", "private_ip": "10.0.0.6"}
+		],
+		"cpu_count": 2,
+		"timeouts": null
+	}`)
+
+	for _, name := range []string{"member_server_image_no", "fee_system_type_code", "user_data", "raid_type_name", "access_control_group_configuration_no_list"} {
+		if !state[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, state[name])
+		}
+	}
+	if !state["init_script_no"].Equal(tftypes.NewValue(tftypes.String, "")) {
+		t.Errorf("expected init_script_no to be empty, got %s", state["init_script_no"])
+	}
+	if !state["region"].Equal(tftypes.NewValue(tftypes.String, "FKR")) {
+		t.Errorf("expected region to be kept, got %s", state["region"])
+	}
+	if !state["cpu_count"].Equal(tftypes.NewValue(tftypes.Number, 2)) {
+		t.Errorf("expected cpu_count to be kept, got %s", state["cpu_count"])
+	}
+
+	var networkInterfaces []tftypes.Value
+	if err := state["network_interface"].As(&networkInterfaces); err != nil || len(networkInterfaces) != 1 {
+		t.Fatalf("expected one network_interface, got %s", state["network_interface"])
+	}
+}
+
+// TestResourceNcloudServer_upgradeStateV0_sdk upgrades the state that the
+// SDKv2 ncloud_server saved for a VPC server created without
+// network_interface blocks.
+func TestResourceNcloudServer_upgradeStateV0_sdk(t *testing.T) {
+	state := testUpgradeResourceStateV0(t, "ncloud_server", `{
+		"access_control_group_configuration_no_list": null,
+		"base_block_storage_disk_detail_type": "SSD",
+		"base_block_storage_disk_type": "NET",
+		"base_block_storage_size": null,
+		"cpu_count": 2,
+		"description": "",
+		"fee_system_type_code": null,
+		"hypervisor_type": "XEN",
+		"id": "1024",
+		"init_script_no": null,
+		"instance_no": "1024",
+		"internet_line_type": null,
+		"is_encrypted_base_block_storage_volume": null,
+		"is_fee_charging_monitoring": null,
+		"is_protect_server_termination": false,
+		"login_key_name": "tf-4540-vm-key",
+		"member_server_image_no": null,
+		"memory_size": 8589934592,
+		"name": "tf-4540-vm",
+		"network_interface": [
+			{
+				"network_interface_no": "1025",
+				"order": 0,
+				"private_ip": "10.5.0.6",
+				"subnet_no": "1019"
+			}
+		],
+		"placement_group_no": null,
+		"platform_type": "LNX64",
+		"port_forwarding_external_port": null,
+		"port_forwarding_internal_port": null,
+		"port_forwarding_public_ip": null,
+		"private_ip": null,
+		"public_ip": "",
+		"raid_type_name": null,
+		"region": "KR",
+		"server_image_name": null,
+		"server_image_number": null,
+		"server_image_product_code": "SW.VSVR.OS.LNX64.ROCKY.0810.B050",
+		"server_product_code": "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002",
+		"server_spec_code": null,
+		"subnet_no": "1019",
+		"tag_list": [],
+		"timeouts": null,
+		"user_data": null,
+		"vpc_no": "1006",
+		"zone": "KR-2"
+	}`)
+
+	for name, want := range map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "1024"),
+		"region":      tftypes.NewValue(tftypes.String, "KR"),
+		"memory_size": tftypes.NewValue(tftypes.Number, 8589934592),
+		"subnet_no":   tftypes.NewValue(tftypes.String, "1019"),
+	} {
+		if !state[name].Equal(want) {
+			t.Errorf("expected %s to be %s, got %s", name, want, state[name])
+		}
+	}
+
+	var networkInterfaces []tftypes.Value
+	if err := state["network_interface"].As(&networkInterfaces); err != nil || len(networkInterfaces) != 1 {
+		t.Fatalf("expected the default network_interface to be kept, got %s", state["network_interface"])
+	}
+	var networkInterface map[string]tftypes.Value
+	if err := networkInterfaces[0].As(&networkInterface); err != nil {
+		t.Fatal(err)
+	}
+	if !networkInterface["private_ip"].Equal(tftypes.NewValue(tftypes.String, "10.5.0.6")) {
+		t.Errorf("expected the private IP of the network interface to be kept, got %s", networkInterface["private_ip"])
+	}
+}
+
+// testUpgradeResourceStateV0 upgrades a state saved by the SDKv2 version of a
+// resource and returns the upgraded attributes.
+func testUpgradeResourceStateV0(t *testing.T, typeName, rawState string) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	provider, err := ProtoV6ProviderFactories[ProviderName]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := provider.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("UpgradeResourceState: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var state map[string]tftypes.Value
+	if err := value.As(&state); err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &serverservice.ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
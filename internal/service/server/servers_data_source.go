@@ -19,7 +19,8 @@ func DataSourceNcloudServers() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 		},
 	}
 }
@@ -0,0 +1,304 @@
+//go:build sweep
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_server", &resource.Sweeper{
+		Name: "ncloud_server",
+		F:    sweepServers,
+	})
+
+	resource.AddTestSweepers("ncloud_block_storage", &resource.Sweeper{
+		Name:         "ncloud_block_storage",
+		F:            sweepBlockStorages,
+		Dependencies: []string{"ncloud_server", "ncloud_block_storage_snapshot"},
+	})
+
+	resource.AddTestSweepers("ncloud_block_storage_snapshot", &resource.Sweeper{
+		Name: "ncloud_block_storage_snapshot",
+		F:    sweepBlockStorageSnapshots,
+	})
+
+	resource.AddTestSweepers("ncloud_network_interface", &resource.Sweeper{
+		Name:         "ncloud_network_interface",
+		F:            sweepNetworkInterfaces,
+		Dependencies: []string{"ncloud_server"},
+	})
+
+	resource.AddTestSweepers("ncloud_public_ip", &resource.Sweeper{
+		Name:         "ncloud_public_ip",
+		F:            sweepPublicIps,
+		Dependencies: []string{"ncloud_server"},
+	})
+
+	resource.AddTestSweepers("ncloud_access_control_group", &resource.Sweeper{
+		Name:         "ncloud_access_control_group",
+		F:            sweepAccessControlGroups,
+		Dependencies: []string{"ncloud_subnet"},
+	})
+
+	resource.AddTestSweepers("ncloud_init_script", &resource.Sweeper{
+		Name:         "ncloud_init_script",
+		F:            sweepInitScripts,
+		Dependencies: []string{"ncloud_server", "ncloud_launch_configuration"},
+	})
+
+	resource.AddTestSweepers("ncloud_login_key", &resource.Sweeper{
+		Name:         "ncloud_login_key",
+		F:            sweepLoginKeys,
+		Dependencies: []string{"ncloud_server", "ncloud_launch_configuration", "ncloud_nks_cluster"},
+	})
+
+	resource.AddTestSweepers("ncloud_placement_group", &resource.Sweeper{
+		Name:         "ncloud_placement_group",
+		F:            sweepPlacementGroups,
+		Dependencies: []string{"ncloud_server"},
+	})
+}
+
+func sweepServers(region string) error {
+	var sweepables []sweep.Sweepable
+
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	resp, err := config.Client.Vserver.V2Api.GetServerInstanceList(&vserver.GetServerInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing servers: %w", err)
+	}
+	for _, v := range resp.ServerInstanceList {
+		if sweep.IsTestName(v.ServerName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewServerResource, map[string]string{
+				"id": *v.ServerInstanceNo,
+			}, config))
+		}
+	}
+
+	classicConfig, err := sweep.SharedRegionalClassicClient(region)
+	if err != nil {
+		return fmt.Errorf("getting classic client: %w", err)
+	}
+	classicResp, err := classicConfig.Client.Server.V2Api.GetServerInstanceList(&server.GetServerInstanceListRequest{RegionNo: &classicConfig.RegionNo})
+	if err != nil {
+		return fmt.Errorf("listing classic servers: %w", err)
+	}
+	for _, v := range classicResp.ServerInstanceList {
+		if sweep.IsTestName(v.ServerName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewServerResource, map[string]string{
+				"id": *v.ServerInstanceNo,
+			}, classicConfig))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepBlockStorages(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing block storages: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.BlockStorageInstanceList {
+		// basic block storages go away with their server
+		if !sweep.IsTestName(v.BlockStorageName) || *v.BlockStorageType.Code == "BASIC" {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewBlockStorageResource, map[string]string{
+			"id": *v.BlockStorageInstanceNo,
+		}, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepBlockStorageSnapshots(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceList(&vserver.GetBlockStorageSnapshotInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing block storage snapshots: %w", err)
+	}
+
+	r := ResourceNcloudBlockStorageSnapshot()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.BlockStorageSnapshotInstanceList {
+		if sweep.IsTestName(v.BlockStorageSnapshotName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.BlockStorageSnapshotInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkInterfaces(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(&vserver.GetNetworkInterfaceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing network interfaces: %w", err)
+	}
+
+	r := ResourceNcloudNetworkInterface()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.NetworkInterfaceList {
+		// default network interfaces go away with their server
+		if !sweep.IsTestName(v.NetworkInterfaceName) || (v.IsDefault != nil && *v.IsDefault) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.NetworkInterfaceNo, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepPublicIps(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetPublicIpInstanceList(&vserver.GetPublicIpInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing public ips: %w", err)
+	}
+
+	r := ResourceNcloudPublicIpInstance()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.PublicIpInstanceList {
+		// public IPs have no name, tests put theirs in the description
+		if sweep.IsTestName(v.PublicIpDescription) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.PublicIpInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepAccessControlGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing access control groups: %w", err)
+	}
+
+	r := ResourceNcloudAccessControlGroup()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.AccessControlGroupList {
+		// default access control groups go away with their VPC
+		if !sweep.IsTestName(v.AccessControlGroupName) || (v.IsDefault != nil && *v.IsDefault) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.AccessControlGroupNo, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepInitScripts(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetInitScriptList(&vserver.GetInitScriptListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing init scripts: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.InitScriptList {
+		if sweep.IsTestName(v.InitScriptName) {
+			sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewInitScriptResource, map[string]string{
+				"id": *v.InitScriptNo,
+			}, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepLoginKeys(region string) error {
+	var sweepables []sweep.Sweepable
+	seen := map[string]bool{}
+
+	for _, shared := range []func(string) (*conn.ProviderConfig, error){sweep.SharedRegionalClient, sweep.SharedRegionalClassicClient} {
+		config, err := shared(region)
+		if err != nil {
+			return fmt.Errorf("getting client: %w", err)
+		}
+
+		keys, err := GetLoginKeyList(config)
+		if err != nil {
+			return fmt.Errorf("listing login keys: %w", err)
+		}
+
+		for _, v := range keys {
+			// both platforms may list the same key
+			if sweep.IsTestName(v.KeyName) && !seen[*v.KeyName] {
+				seen[*v.KeyName] = true
+				sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewLoginKeyResource, map[string]string{
+					"id":       *v.KeyName,
+					"key_name": *v.KeyName,
+				}, config))
+			}
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepPlacementGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vserver.V2Api.GetPlacementGroupList(&vserver.GetPlacementGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing placement groups: %w", err)
+	}
+
+	r := ResourceNcloudPlacementGroup()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.PlacementGroupList {
+		if !sweep.IsTestName(v.PlacementGroupName) {
+			continue
+		}
+		d := r.Data(nil)
+		d.SetId(*v.PlacementGroupNo)
+		d.Set("placement_group_no", v.PlacementGroupNo)
+		sweepables = append(sweepables, sweep.NewSweepResource(r, d, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -0,0 +1,81 @@
+package ses
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestGetSESClusters_pages(t *testing.T) {
+	const total = 250
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/cluster/getClusterInfoList") {
+			http.NotFound(w, r)
+			return
+		}
+		pageNo, _ := strconv.Atoi(r.URL.Query().Get("pageNo"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		var allowed, disallowed []map[string]string
+		for i := (pageNo - 1) * pageSize; i < pageNo*pageSize && i < total; i++ {
+			cluster := map[string]string{
+				"serviceGroupInstanceNo": strconv.Itoa(i),
+				"clusterName":            fmt.Sprintf("cluster-%d", i),
+			}
+			// one in ten clusters belongs to another sub account
+			if i%10 == 0 {
+				disallowed = append(disallowed, cluster)
+			} else {
+				allowed = append(allowed, cluster)
+			}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": 0,
+			"result": map[string]interface{}{
+				"allowedClusters":    allowed,
+				"disallowedClusters": disallowed,
+				"totalCount":         total,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR"}
+	if err := config.NewClient(conn.Config{AccessKey: "a", SecretKey: "s", Region: "KR", APIGateway: srv.URL}, ""); err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	clusters, err := getSESClusters(context.Background(), config, 0)
+	if err != nil {
+		t.Fatalf("listing clusters: %s", err)
+	}
+	if len(clusters) != total {
+		t.Fatalf("expected %d clusters, got %d", total, len(clusters))
+	}
+	var allowed int
+	for _, c := range clusters {
+		if c.Allowed {
+			allowed++
+		}
+	}
+	if allowed != total-total/10 {
+		t.Errorf("expected %d allowed clusters, got %d", total-total/10, allowed)
+	}
+
+	clusters, err = getSESClusters(context.Background(), config, 5)
+	if err != nil {
+		t.Fatalf("listing clusters: %s", err)
+	}
+	if len(clusters) != 5 {
+		t.Errorf("expected max_results to cap the clusters at 5, got %d", len(clusters))
+	}
+}
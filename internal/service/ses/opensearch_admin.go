@@ -0,0 +1,205 @@
+package ses
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const opensearchRequestTimeout = 30 * time.Second
+
+// opensearchError is an error response of the OpenSearch REST API.
+type opensearchError struct {
+	StatusCode int
+	Type       string
+	Reason     string
+}
+
+func (e *opensearchError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("opensearch error %d %s: %s", e.StatusCode, e.Type, e.Reason)
+	}
+	return fmt.Sprintf("opensearch error %d: %s", e.StatusCode, e.Reason)
+}
+
+func isOpenSearchNotFound(err error) bool {
+	var e *opensearchError
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// opensearchAdmin calls the REST API of the OpenSearch cluster behind an SES
+// cluster, for the settings vses2 doesn't manage: snapshot repositories and
+// Index State Management policies.
+type opensearchAdmin struct {
+	endpoint string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newOpenSearchAdmin(endpoint, username, password, caCert string) (*opensearchAdmin, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("endpoint must be an http or https URL, got %q", endpoint)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("tls_ca_cert does not contain a PEM encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &opensearchAdmin{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		username: username,
+		password: password,
+		client:   &http.Client{Transport: transport, Timeout: opensearchRequestTimeout},
+	}, nil
+}
+
+// do sends body, if any, as JSON and decodes the response into out, if any.
+func (a *opensearchAdmin) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	u := a.endpoint + "/" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.username, a.password)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		e := &opensearchError{StatusCode: resp.StatusCode, Reason: strings.TrimSpace(string(b))}
+		var errResp struct {
+			Error struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(b, &errResp) == nil && errResp.Error.Type != "" {
+			e.Type = errResp.Error.Type
+			e.Reason = errResp.Error.Reason
+		}
+		return e
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+// snapshotRepository is a repository registered with the _snapshot API.
+type snapshotRepository struct {
+	Type     string            `json:"type"`
+	Settings map[string]string `json:"settings"`
+}
+
+// getSnapshotRepository returns nil if the repository doesn't exist.
+func (a *opensearchAdmin) getSnapshotRepository(ctx context.Context, name string) (*snapshotRepository, error) {
+	var resp map[string]snapshotRepository
+	if err := a.do(ctx, http.MethodGet, "_snapshot/"+url.PathEscape(name), nil, nil, &resp); err != nil {
+		if isOpenSearchNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	repo, ok := resp[name]
+	if !ok {
+		return nil, nil
+	}
+	return &repo, nil
+}
+
+// putSnapshotRepository registers or replaces a repository. OpenSearch checks
+// that every node can write to it before acknowledging.
+func (a *opensearchAdmin) putSnapshotRepository(ctx context.Context, name string, repo *snapshotRepository) error {
+	return a.do(ctx, http.MethodPut, "_snapshot/"+url.PathEscape(name), nil, repo, nil)
+}
+
+// deleteSnapshotRepository unregisters a repository. The snapshots in it are kept.
+func (a *opensearchAdmin) deleteSnapshotRepository(ctx context.Context, name string) error {
+	return a.do(ctx, http.MethodDelete, "_snapshot/"+url.PathEscape(name), nil, nil, nil)
+}
+
+// ismPolicy is a policy of the Index State Management plugin. SeqNo and
+// PrimaryTerm identify the stored version, which updates must name.
+type ismPolicy struct {
+	ID          string                 `json:"_id"`
+	SeqNo       int64                  `json:"_seq_no"`
+	PrimaryTerm int64                  `json:"_primary_term"`
+	Policy      map[string]interface{} `json:"policy"`
+}
+
+// getISMPolicy returns nil if the policy doesn't exist.
+func (a *opensearchAdmin) getISMPolicy(ctx context.Context, id string) (*ismPolicy, error) {
+	var policy ismPolicy
+	if err := a.do(ctx, http.MethodGet, "_plugins/_ism/policies/"+url.PathEscape(id), nil, nil, &policy); err != nil {
+		if isOpenSearchNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// putISMPolicy creates a policy, or replaces the version of it given by prev.
+func (a *opensearchAdmin) putISMPolicy(ctx context.Context, id string, policy map[string]interface{}, prev *ismPolicy) (*ismPolicy, error) {
+	var query url.Values
+	if prev != nil {
+		query = url.Values{
+			"if_seq_no":       {strconv.FormatInt(prev.SeqNo, 10)},
+			"if_primary_term": {strconv.FormatInt(prev.PrimaryTerm, 10)},
+		}
+	}
+
+	var out ismPolicy
+	body := map[string]interface{}{"policy": policy}
+	if err := a.do(ctx, http.MethodPut, "_plugins/_ism/policies/"+url.PathEscape(id), query, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// deleteISMPolicy deletes a policy.
+func (a *opensearchAdmin) deleteISMPolicy(ctx context.Context, id string) error {
+	return a.do(ctx, http.MethodDelete, "_plugins/_ism/policies/"+url.PathEscape(id), nil, nil, nil)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vses2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +44,13 @@ func ResourceNcloudSESCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(conn.DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultCreateTimeout),
 		},
+		CustomizeDiff: customdiff.ValidateChange("data_node.0.count", func(ctx context.Context, old, new, meta any) error {
+			// data nodes can only be added to an existing cluster.
+			if old.(int) > new.(int) {
+				return fmt.Errorf("data_node.0.count cannot be decreased, from %d to %d", old.(int), new.(int))
+			}
+			return nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -265,6 +273,11 @@ func ResourceNcloudSESCluster() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -354,6 +367,9 @@ func resourceNcloudSESClusterRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("vpc_no", cluster.VpcNo)
 	d.Set("login_key_name", cluster.LoginKeyName)
 	d.Set("manager_node_instance_no_list", cluster.ManagerNodeInstanceNoList)
+	// Imported resources and states written before deletion_protection
+	// existed have it unset. Storing the default keeps their plan empty.
+	d.Set("deletion_protection", d.Get("deletion_protection").(bool))
 
 	var userPassword string                               // API response not support user_password. Not currently available during import
 	if searchEngine, ok := d.GetOk("search_engine"); ok { // Create exist in config
@@ -565,6 +581,10 @@ func resourceNcloudSESClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(NotSupportClassic("resource `ncloud_ses_cluster`"))
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		return diag.FromErr(ErrorDeletionProtected("ncloud_ses_cluster", d.Id(), "deletion_protection"))
+	}
+
 	if err := waitForSESClusterActive(ctx, d, config, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -642,13 +662,39 @@ func GetSESCluster(ctx context.Context, config *conn.ProviderConfig, id string)
 	return resp.Result, nil
 }
 
-func getSESClusters(ctx context.Context, config *conn.ProviderConfig) (*vses2.GetSearchEngineClusterInfoListResponse, error) {
+// sesClusterSummary is a cluster of either list that GetClusterInfoList returns
+type sesClusterSummary struct {
+	ServiceGroupInstanceNo *string
+	ClusterName            *string
+	Allowed                bool
+}
+
+func getSESClusters(ctx context.Context, config *conn.ProviderConfig, maxResults int) ([]sesClusterSummary, error) {
+	clusters, err := ListAllPages(maxResults, func(pageNo, pageSize int32) ([]sesClusterSummary, *int32, error) {
+		resp, _, err := config.Client.Vses.V2Api.GetClusterInfoListUsingGET(ctx, &vses2.V2ControllerApiGetClusterInfoListUsingGETOpts{
+			PageNo:   int(pageNo),
+			PageSize: int(pageSize),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.Result == nil {
+			return nil, nil, nil
+		}
 
-	resp, _, err := config.Client.Vses.V2Api.GetClusterInfoListUsingGET(ctx, nil)
+		var page []sesClusterSummary
+		for _, c := range resp.Result.AllowedClusters {
+			page = append(page, sesClusterSummary{ServiceGroupInstanceNo: c.ServiceGroupInstanceNo, ClusterName: c.ClusterName, Allowed: true})
+		}
+		for _, c := range resp.Result.DisallowedClusters {
+			page = append(page, sesClusterSummary{ServiceGroupInstanceNo: c.ServiceGroupInstanceNo, ClusterName: c.ClusterName})
+		}
+		return page, ncloud.Int32(int32(resp.Result.TotalCount)), nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("GetSESClusterList", resp)
+	LogResponse("GetSESClusterList", clusters)
 
-	return resp.Result, nil
+	return clusters, nil
 }
@@ -13,12 +13,12 @@ import (
 func TestAccDataSourceNcloudSESCluster(t *testing.T) {
 	dataName := "data.ncloud_ses_cluster.cluster"
 	resourceName := "ncloud_ses_cluster.cluster"
-	testClusterName := GetTestClusterName()
+	testClusterName := RandomName(t, "es")
 	searchEngineVersionCode := "133"
 	region := os.Getenv("NCLOUD_REGION")
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vses2") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vses2"
@@ -20,27 +21,39 @@ const TF_TEST_SES_LOGIN_KEY = "tf-ses-login-key"
 func TestAccResourceNcloudSESCluster_basic(t *testing.T) {
 	var cluster vses2.OpenApiGetClusterInfoResponseVo
 	resourceName := "ncloud_ses_cluster.cluster"
-	testClusterName := GetTestClusterName()
+	testClusterName := RandomName(t, "es")
 	searchEngineVersionCode := "133"
 	region := os.Getenv("NCLOUD_REGION")
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vses2") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSESClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceSESClusterConfig(testClusterName, TF_TEST_SES_LOGIN_KEY, searchEngineVersionCode, region),
+				Config: testAccResourceSESClusterConfig(testClusterName, TF_TEST_SES_LOGIN_KEY, searchEngineVersionCode, region, 3),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSESClusterExists(resourceName, &cluster),
 					resource.TestCheckResourceAttr(resourceName, "cluster_name", testClusterName),
 				),
 			},
+			{
+				Config: testAccResourceSESClusterConfig(testClusterName, TF_TEST_SES_LOGIN_KEY, searchEngineVersionCode, region, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSESClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "data_node.0.count", "4"),
+				),
+			},
+			{
+				Config:      testAccResourceSESClusterConfig(testClusterName, TF_TEST_SES_LOGIN_KEY, searchEngineVersionCode, region, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("data_node.0.count cannot be decreased"),
+			},
 		},
 	})
 }
 
-func testAccResourceSESClusterConfig(testClusterName string, loginKey string, version string, region string) string {
+func testAccResourceSESClusterConfig(testClusterName string, loginKey string, version string, region string, dataNodeCount int) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "vpc" {
 	name               = "%[1]s"
@@ -89,12 +102,12 @@ resource "ncloud_ses_cluster" "cluster" {
   data_node {
 	  product_code       		= data.ncloud_ses_node_products.product_codes.codes.0.id
 	  subnet_no           		= ncloud_subnet.node_subnet.id
-	  count            		    = 3
+	  count            		    = %[5]d
 	  storage_size        		= 100
   }
   login_key_name                = ncloud_login_key.loginkey.key_name
 }
-`, testClusterName, loginKey, version, region)
+`, testClusterName, loginKey, version, region, dataNodeCount)
 }
 
 func testAccCheckSESClusterExists(n string, cluster *vses2.OpenApiGetClusterInfoResponseVo) resource.TestCheckFunc {
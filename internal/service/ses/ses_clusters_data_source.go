@@ -16,7 +16,8 @@ func DataSourceNcloudSESClusters() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceNcloudSESClustersRead,
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 			"clusters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -47,30 +48,15 @@ func dataSourceNcloudSESClustersRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(NotSupportClassic("dataSource `ncloud_ses_clusters`"))
 	}
 
-	clusters, err := getSESClusters(ctx, config)
+	clusters, err := getSESClusters(ctx, config, GetMaxResults(d))
 	if err != nil {
 		LogErrorResponse("GetSESClusters", err, "")
 		return diag.FromErr(err)
 	}
 
-	if clusters == nil {
-		d.SetId("")
-		return nil
-	}
-
 	resources := []map[string]interface{}{}
 
-	for _, r := range clusters.AllowedClusters {
-		instance := map[string]interface{}{
-			"id":                        ncloud.StringValue(r.ServiceGroupInstanceNo),
-			"service_group_instance_no": ncloud.StringValue(r.ServiceGroupInstanceNo),
-			"cluster_name":              ncloud.StringValue(r.ClusterName),
-		}
-
-		resources = append(resources, instance)
-	}
-
-	for _, r := range clusters.DisallowedClusters {
+	for _, r := range clusters {
 		instance := map[string]interface{}{
 			"id":                        ncloud.StringValue(r.ServiceGroupInstanceNo),
 			"service_group_instance_no": ncloud.StringValue(r.ServiceGroupInstanceNo),
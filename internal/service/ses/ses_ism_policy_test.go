@@ -0,0 +1,151 @@
+package ses_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+func TestAccResourceNcloudSESISMPolicy_basic(t *testing.T) {
+	opensearch := mockncp.NewOpenSearch()
+	defer opensearch.Close()
+
+	policyID := fmt.Sprintf("tf-policy-%s", acctest.RandString(5))
+	resourceName := "ncloud_ses_ism_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSESISMPolicyDestroy(opensearch),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSESISMPolicyConfigRaw(opensearch.URL(), policyID, `"not a policy"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("policy must be a JSON object"),
+			},
+			{
+				// OpenSearch adds a retry to the delete action, which must not show as a change.
+				Config: testAccSESISMPolicyConfig(opensearch.URL(), policyID, "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSESISMPolicyExists(opensearch, policyID),
+					resource.TestCheckResourceAttr(resourceName, "id", policyID),
+					resource.TestCheckResourceAttrSet(resourceName, "seq_no"),
+					resource.TestCheckResourceAttr(resourceName, "primary_term", "1"),
+				),
+			},
+			{
+				Config: testAccSESISMPolicyConfig(opensearch.URL(), policyID, "7d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"min_index_age":\s*"7d"`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           testAccSESImportID(opensearch.URL(), policyID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudSESISMPolicy_disappears(t *testing.T) {
+	opensearch := mockncp.NewOpenSearch()
+	defer opensearch.Close()
+
+	policyID := fmt.Sprintf("tf-policy-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSESISMPolicyDestroy(opensearch),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSESISMPolicyConfig(opensearch.URL(), policyID, "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSESISMPolicyExists(opensearch, policyID),
+					func(*terraform.State) error {
+						opensearch.RemoveISMPolicy(policyID)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSESISMPolicyExists(opensearch *mockncp.OpenSearch, policyID string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !opensearch.HasISMPolicy(policyID) {
+			return fmt.Errorf("ISM policy %s not found", policyID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSESISMPolicyDestroy(opensearch *mockncp.OpenSearch) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ncloud_ses_ism_policy" {
+				continue
+			}
+			if opensearch.HasISMPolicy(rs.Primary.ID) {
+				return fmt.Errorf("ISM policy %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccSESISMPolicyConfig(endpoint, policyID, deleteAfter string) string {
+	return testAccSESISMPolicyConfigRaw(endpoint, policyID, fmt.Sprintf(`jsonencode({
+		description   = "Delete old logs"
+		default_state = "hot"
+		states = [
+			{
+				name    = "hot"
+				actions = []
+				transitions = [
+					{
+						state_name = "delete"
+						conditions = {
+							min_index_age = "%[1]s"
+						}
+					}
+				]
+			},
+			{
+				name        = "delete"
+				actions     = [{ delete = {} }]
+				transitions = []
+			}
+		]
+		ism_template = [
+			{
+				index_patterns = ["logs-*"]
+				priority       = 100
+			}
+		]
+	})`, deleteAfter))
+}
+
+func testAccSESISMPolicyConfigRaw(endpoint, policyID, policy string) string {
+	return fmt.Sprintf(`
+resource "ncloud_ses_ism_policy" "policy" {
+	endpoint      = "%[1]s"
+	user_name     = "%[2]s"
+	user_password = "%[3]s"
+	policy_id     = "%[4]s"
+	policy        = %[5]s
+}
+`, endpoint, mockncp.OpenSearchUser, mockncp.OpenSearchPassword, policyID, policy)
+}
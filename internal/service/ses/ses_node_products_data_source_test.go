@@ -16,7 +16,7 @@ func TestAccDataSourceNcloudSESNodeProductCodes(t *testing.T) {
 	testName := "ses-product-test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vses2") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
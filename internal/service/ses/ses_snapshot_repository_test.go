@@ -0,0 +1,131 @@
+package ses_test
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+)
+
+func TestAccResourceNcloudSESSnapshotRepository_basic(t *testing.T) {
+	opensearch := mockncp.NewOpenSearch()
+	defer opensearch.Close()
+
+	name := fmt.Sprintf("tf-repo-%s", acctest.RandString(5))
+	resourceName := "ncloud_ses_snapshot_repository.repo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSESSnapshotRepositoryDestroy(opensearch),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSESSnapshotRepositoryConfig(opensearch.URL(), name, "tf-snapshots", `base_path = "daily"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSESSnapshotRepositoryExists(opensearch, name),
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "bucket", "tf-snapshots"),
+					resource.TestCheckResourceAttr(resourceName, "base_path", "daily"),
+					resource.TestCheckResourceAttr(resourceName, "settings.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.compress", "true"),
+				),
+			},
+			{
+				Config: testAccSESSnapshotRepositoryConfig(opensearch.URL(), name, "tf-snapshots-2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", "tf-snapshots-2"),
+					resource.TestCheckNoResourceAttr(resourceName, "base_path"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           testAccSESImportID(opensearch.URL(), name),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudSESSnapshotRepository_disappears(t *testing.T) {
+	opensearch := mockncp.NewOpenSearch()
+	defer opensearch.Close()
+
+	name := fmt.Sprintf("tf-repo-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSESSnapshotRepositoryDestroy(opensearch),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSESSnapshotRepositoryConfig(opensearch.URL(), name, "tf-snapshots", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSESSnapshotRepositoryExists(opensearch, name),
+					func(*terraform.State) error {
+						opensearch.RemoveSnapshotRepository(name)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSESSnapshotRepositoryExists(opensearch *mockncp.OpenSearch, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !opensearch.HasSnapshotRepository(name) {
+			return fmt.Errorf("snapshot repository %s not found", name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSESSnapshotRepositoryDestroy(opensearch *mockncp.OpenSearch) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ncloud_ses_snapshot_repository" {
+				continue
+			}
+			if opensearch.HasSnapshotRepository(rs.Primary.ID) {
+				return fmt.Errorf("snapshot repository %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccSESImportID returns the import identifier of name on the cluster at endpoint.
+func testAccSESImportID(endpoint, name string) string {
+	u, _ := url.Parse(endpoint)
+	u.User = url.UserPassword(mockncp.OpenSearchUser, mockncp.OpenSearchPassword)
+	u.Path = "/" + name
+	return u.String()
+}
+
+func testAccSESSnapshotRepositoryConfig(endpoint, name, bucket, extra string) string {
+	return fmt.Sprintf(`
+resource "ncloud_ses_snapshot_repository" "repo" {
+	endpoint                = "%[1]s"
+	user_name               = "%[2]s"
+	user_password           = "%[3]s"
+	name                    = "%[4]s"
+	bucket                  = "%[5]s"
+	object_storage_endpoint = "https://kr.object.private.ncloudstorage.com"
+	%[6]s
+
+	settings = {
+		compress = "true"
+	}
+}
+`, endpoint, mockncp.OpenSearchUser, mockncp.OpenSearchPassword, name, bucket, strings.TrimSpace(extra))
+}
@@ -12,7 +12,7 @@ func TestAccDataSourceNcloudSESVersions(t *testing.T) {
 	dataName := "data.ncloud_ses_versions.versions"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vses2") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
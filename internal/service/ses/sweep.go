@@ -0,0 +1,41 @@
+//go:build sweep
+
+package ses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_ses_cluster", &resource.Sweeper{
+		Name: "ncloud_ses_cluster",
+		F:    sweepSESClusters,
+	})
+}
+
+func sweepSESClusters(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	clusters, err := getSESClusters(context.Background(), config, 0)
+	if err != nil {
+		return fmt.Errorf("listing ses clusters: %w", err)
+	}
+
+	r := ResourceNcloudSESCluster()
+	var sweepables []sweep.Sweepable
+	for _, v := range clusters {
+		if v.Allowed && sweep.IsTestName(v.ClusterName) {
+			sweepables = append(sweepables, sweep.NewSweepResourceByID(r, *v.ServiceGroupInstanceNo, config))
+		}
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,12 +18,12 @@ import (
 
 func TestAccResourceNcloudNatGateway_basic(t *testing.T) {
 	var natGateway vpc.NatGatewayInstance
-	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "natgw")
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 	resourcePrivate := "ncloud_nat_gateway.nat_gateway_private"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNatGatewayInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNatGatewayDestroy,
 		Steps: []resource.TestStep{
@@ -57,11 +56,11 @@ func TestAccResourceNcloudNatGateway_basic(t *testing.T) {
 
 func TestAccResourceNcloudNatGateway_disappears(t *testing.T) {
 	var natGateway vpc.NatGatewayInstance
-	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "natgw")
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNatGatewayInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNatGatewayDestroy,
 		Steps: []resource.TestStep{
@@ -79,11 +78,11 @@ func TestAccResourceNcloudNatGateway_disappears(t *testing.T) {
 
 func TestAccResourceNcloudNatGateway_onlyRequiredParam(t *testing.T) {
 	var natGateway vpc.NatGatewayInstance
-	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "natgw")
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNatGatewayInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNatGatewayDestroy,
 		Steps: []resource.TestStep{
@@ -108,11 +107,11 @@ func TestAccResourceNcloudNatGateway_onlyRequiredParam(t *testing.T) {
 
 func TestAccResourceNcloudNatGateway_updateName(t *testing.T) {
 	var natGateway vpc.NatGatewayInstance
-	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "natgw")
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNatGatewayInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNatGatewayDestroy,
 		Steps: []resource.TestStep{
@@ -129,11 +128,11 @@ func TestAccResourceNcloudNatGateway_updateName(t *testing.T) {
 
 func TestAccResourceNcloudNatGateway_description(t *testing.T) {
 	var natGateway vpc.NatGatewayInstance
-	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "natgw")
 	resourceName := "ncloud_nat_gateway.nat_gateway"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNatGatewayInstance") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNatGatewayDestroy,
 		Steps: []resource.TestStep{
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,11 +17,11 @@ import (
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_basic(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "denyallow")
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNetworkAclDenyAllowGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDenyAllowGroupDestroy,
 		Steps: []resource.TestStep{
@@ -47,11 +46,11 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_basic(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_disappears(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-ds-%s", acctest.RandString(5))
+	name := RandomName(t, "denyallow")
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNetworkAclDenyAllowGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDenyAllowGroupDestroy,
 		Steps: []resource.TestStep{
@@ -69,11 +68,11 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_disappears(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_update(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-update-%s", acctest.RandString(5))
+	name := RandomName(t, "denyallow")
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNetworkAclDenyAllowGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDenyAllowGroupDestroy,
 		Steps: []resource.TestStep{
@@ -102,11 +101,11 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_update(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACLDenyAllowGroup_description(t *testing.T) {
 	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
-	name := fmt.Sprintf("tf-nacl-allow-desc-%s", acctest.RandString(5))
+	name := RandomName(t, "denyallow")
 	resourceName := "ncloud_network_acl_deny_allow_group.this"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createNetworkAclDenyAllowGroup") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDenyAllowGroupDestroy,
 		Steps: []resource.TestStep{
@@ -30,7 +30,8 @@ func DataSourceNcloudNetworkACLDenyAllowGroups() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 			"network_acl_deny_allow_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -63,22 +64,32 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("GetNetworkAclDenyAllowGroupList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(reqParams)
+	groups, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vpc.NetworkAclDenyAllowGroup, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
+		LogCommonRequest("GetNetworkAclDenyAllowGroupList", pageParams)
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(&pageParams)
+		if err != nil {
+			LogErrorResponse("GetNetworkAclDenyAllowGroupList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("GetNetworkAclDenyAllowGroupList", resp)
+
+		return resp.NetworkAclDenyAllowGroupList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNetworkAclDenyAllowGroupList", err, reqParams)
 		return err
 	}
-	LogResponse("GetNetworkAclDenyAllowGroupList", resp)
 
-	if resp == nil || len(resp.NetworkAclDenyAllowGroupList) == 0 {
+	if len(groups) == 0 {
 		return fmt.Errorf("no matching NetworkAclDenyAllowGroup found")
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclDenyAllowGroupList {
+	for _, r := range groups {
 		m := map[string]interface{}{
 			"id":                              *r.NetworkAclDenyAllowGroupNo,
 			"network_acl_deny_allow_group_no": *r.NetworkAclDenyAllowGroupNo,
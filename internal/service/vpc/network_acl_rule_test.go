@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,10 +20,10 @@ func TestAccResourceNcloudNetworkACLRule_basic(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
 	resourceName := "ncloud_network_acl_rule.nacl_rule"
-	name := fmt.Sprintf("test-network-acl-rule-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/addNetworkAclInboundRule") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLRuleDestroy,
 		Steps: []resource.TestStep{
@@ -44,10 +43,10 @@ func TestAccResourceNcloudNetworkACLRule_basic(t *testing.T) {
 func TestAccResourceNcloudNetworkACLRule_AssociatedSubnet(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
-	name := fmt.Sprintf("test-nacl-rule-subnet-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/addNetworkAclInboundRule") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLRuleDestroy,
 		Steps: []resource.TestStep{
@@ -64,10 +63,10 @@ func TestAccResourceNcloudNetworkACLRule_AssociatedSubnet(t *testing.T) {
 func TestAccResourceNcloudNetworkACLRule_disappears(t *testing.T) {
 	var networkACLRule []*vpc.NetworkAclRule
 
-	name := fmt.Sprintf("test-network-acl-rule-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/addNetworkAclInboundRule") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLRuleDestroy,
 		Steps: []resource.TestStep{
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,10 +17,10 @@ import (
 
 func TestAccResourceNcloudNetworkACL_basic(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 	resourceName := "ncloud_network_acl.nacl"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDestroy,
@@ -46,7 +45,7 @@ func TestAccResourceNcloudNetworkACL_basic(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_disappears(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -68,7 +67,7 @@ func TestAccResourceNcloudNetworkACL_disappears(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_onlyRequiredParam(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -96,10 +95,10 @@ func TestAccResourceNcloudNetworkACL_onlyRequiredParam(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_updateName(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 	resourceName := "ncloud_network_acl.nacl"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckNetworkACLDestroy,
@@ -121,7 +120,7 @@ func TestAccResourceNcloudNetworkACL_updateName(t *testing.T) {
 
 func TestAccResourceNcloudNetworkACL_description(t *testing.T) {
 	var networkACL vpc.NetworkAcl
-	name := fmt.Sprintf("test-network-acl-desc-%s", acctest.RandString(5))
+	name := RandomName(t, "nacl")
 	resourceName := "ncloud_network_acl.nacl"
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +33,8 @@ func DataSourceNcloudNetworkAcls() *schema.Resource {
 				Optional:    true,
 				Description: "The VPC ID that you want to filter from.",
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 
 			"network_acls": {
 				Type:     schema.TypeList,
@@ -72,22 +73,32 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	LogCommonRequest("GetNetworkAclList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(reqParams)
+	networkAcls, err := ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vpc.NetworkAcl, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
+		LogCommonRequest("GetNetworkAclList", pageParams)
+		resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(&pageParams)
+		if err != nil {
+			LogErrorResponse("GetNetworkAclList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("GetNetworkAclList", resp)
+
+		return resp.NetworkAclList, resp.TotalRows, nil
+	})
 	if err != nil {
-		LogErrorResponse("GetNetworkAclList", err, reqParams)
 		return err
 	}
-	LogResponse("GetNetworkAclList", resp)
 
-	if resp == nil || len(resp.NetworkAclList) == 0 {
+	if len(networkAcls) == 0 {
 		return fmt.Errorf("no matching Network ACL found")
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclList {
+	for _, r := range networkAcls {
 		instance := map[string]interface{}{
 			"id":             *r.NetworkAclNo,
 			"network_acl_no": *r.NetworkAclNo,
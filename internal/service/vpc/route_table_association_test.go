@@ -7,7 +7,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -21,11 +20,11 @@ func TestAccresourceNcloudRouteTableAssociation_basic(t *testing.T) {
 	var association vpc.Subnet
 	var routeTableNo string
 
-	name := fmt.Sprintf("test-assoc-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 	resourceName := "ncloud_route_table_association.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableAssociationDestroy,
 		Steps: []resource.TestStep{
@@ -50,11 +49,11 @@ func TestAccresourceNcloudRouteTableAssociation_disappears(t *testing.T) {
 	var association vpc.Subnet
 	var routeTableNo string
 
-	name := fmt.Sprintf("test-route-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 	resourceName := "ncloud_route_table_association.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableAssociationDestroy,
 		Steps: []resource.TestStep{
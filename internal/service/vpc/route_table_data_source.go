@@ -60,7 +60,7 @@ func dataSourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func getRouteTableList(d *schema.ResourceData, config *conn.ProviderConfig) (*vpc.GetRouteTableListResponse, error) {
+func getRouteTableList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*vpc.RouteTable, error) {
 	reqParams := &vpc.GetRouteTableListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -81,20 +81,25 @@ func getRouteTableList(d *schema.ResourceData, config *conn.ProviderConfig) (*vp
 		reqParams.RouteTableNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	LogCommonRequest("GetRouteTableList", reqParams)
-	resp, err := config.Client.Vpc.V2Api.GetRouteTableList(reqParams)
+	return ListAllPages(GetMaxResults(d), func(pageNo, pageSize int32) ([]*vpc.RouteTable, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
 
-	if err != nil {
-		LogErrorResponse("GetRouteTableList", err, reqParams)
-		return nil, err
-	}
+		LogCommonRequest("GetRouteTableList", pageParams)
+		resp, err := config.Client.Vpc.V2Api.GetRouteTableList(&pageParams)
+		if err != nil {
+			LogErrorResponse("GetRouteTableList", err, pageParams)
+			return nil, nil, err
+		}
+		LogResponse("GetRouteTableList", resp)
 
-	LogResponse("GetRouteTableList", resp)
-	return resp, nil
+		return resp.RouteTableList, resp.TotalRows, nil
+	})
 }
 
 func getRouteTableListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]map[string]interface{}, error) {
-	resp, err := getRouteTableList(d, config)
+	routeTables, err := getRouteTableList(d, config)
 
 	if err != nil {
 		return nil, err
@@ -102,7 +107,7 @@ func getRouteTableListFiltered(d *schema.ResourceData, config *conn.ProviderConf
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.RouteTableList {
+	for _, r := range routeTables {
 		instance := map[string]interface{}{
 			"id":                    *r.RouteTableNo,
 			"route_table_no":        *r.RouteTableNo,
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,10 +18,10 @@ import (
 func TestAccResourceNcloudRouteTable_basic(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
@@ -47,10 +46,10 @@ func TestAccResourceNcloudRouteTable_basic(t *testing.T) {
 func TestAccResourceNcloudRouteTable_disappears(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
@@ -69,10 +68,10 @@ func TestAccResourceNcloudRouteTable_disappears(t *testing.T) {
 func TestAccResourceNcloudRouteTable_onlyRequiredParam(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-required-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
@@ -97,10 +96,10 @@ func TestAccResourceNcloudRouteTable_onlyRequiredParam(t *testing.T) {
 func TestAccResourceNcloudRouteTable_updateName(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-update-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
@@ -122,10 +121,10 @@ func TestAccResourceNcloudRouteTable_updateName(t *testing.T) {
 func TestAccResourceNcloudRouteTable_description(t *testing.T) {
 	var routeTable vpc.RouteTable
 	resourceName := "ncloud_route_table.foo"
-	name := fmt.Sprintf("test-table-desc-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
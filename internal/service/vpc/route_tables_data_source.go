@@ -27,7 +27,8 @@ func DataSourceNcloudRouteTables() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"max_results": MaxResultsSchema(),
 			"route_tables": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
@@ -28,7 +27,7 @@ func TestAccDataSourceNcloudRouteTablesBasic(t *testing.T) {
 
 func TestAccDataSourceNcloudRouteTablesFilter(t *testing.T) {
 	dataName := "data.ncloud_route_tables.filter"
-	name := fmt.Sprintf("test-rt-data-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -64,7 +63,7 @@ func TestAccDataSourceNcloudRouteTablesName(t *testing.T) {
 }
 
 func TestAccDataSourceNcloudRouteTablesVpcNo(t *testing.T) {
-	name := fmt.Sprintf("test-table-data-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
@@ -80,6 +79,25 @@ func TestAccDataSourceNcloudRouteTablesVpcNo(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudRouteTablesMaxResults(t *testing.T) {
+	dataName := "data.ncloud_route_tables.max_results"
+	name := RandomName(t, "rt")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudRouteTablesConfigMaxResults(name),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttr(dataName, "route_tables.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceNcloudRouteTablesConfig() string {
 	return `
 data "ncloud_route_tables" "all" {}
@@ -136,3 +154,17 @@ data "ncloud_route_tables" "by_vpc_no" {
 }
 `, name)
 }
+
+func testAccDataSourceNcloudRouteTablesConfigMaxResults(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+data "ncloud_route_tables" "max_results" {
+	vpc_no      = ncloud_vpc.vpc.id
+	max_results = 1
+}
+`, name)
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,11 +18,11 @@ import (
 
 func TestAccresourceNcloudRoute_basic(t *testing.T) {
 	var route vpc.Route
-	name := fmt.Sprintf("test-route-basic-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 	resourceName := "ncloud_route.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteDestroy,
 		Steps: []resource.TestStep{
@@ -45,11 +44,11 @@ func TestAccresourceNcloudRoute_basic(t *testing.T) {
 
 func TestAccresourceNcloudRoute_disappears(t *testing.T) {
 	var route vpc.Route
-	name := fmt.Sprintf("test-route-disappear-%s", acctest.RandString(5))
+	name := RandomName(t, "rt")
 	resourceName := "ncloud_route.foo"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { TestAccPreCheck(t) },
+		PreCheck:                 func() { TestAccPreCheck(t); TestAccPreCheckMock(t, "vpc/createRouteTable") },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteDestroy,
 		Steps: []resource.TestStep{
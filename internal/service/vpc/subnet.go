@@ -33,6 +33,7 @@ var (
 	_ resource.Resource                = &subnetResource{}
 	_ resource.ResourceWithConfigure   = &subnetResource{}
 	_ resource.ResourceWithImportState = &subnetResource{}
+	_ resource.ResourceWithIdentity    = &subnetResource{}
 )
 
 func NewSubnetResource() resource.Resource {
@@ -44,7 +45,11 @@ type subnetResource struct {
 }
 
 func (s *subnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (s *subnetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = framework.IDIdentitySchema()
 }
 
 func (s *subnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -205,6 +210,7 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("refreshing subnet details", err.Error())
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (s *subnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -235,6 +241,8 @@ func (s *subnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -290,6 +298,7 @@ func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(framework.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
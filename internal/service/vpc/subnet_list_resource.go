@@ -0,0 +1,117 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ list.ListResource              = &subnetListResource{}
+	_ list.ListResourceWithConfigure = &subnetListResource{}
+)
+
+// NewSubnetListResource lists the subnets that ncloud_subnets reads, for import blocks
+func NewSubnetListResource() list.ListResource {
+	return &subnetListResource{}
+}
+
+type subnetListResource struct {
+	config *conn.ProviderConfig
+}
+
+func (s *subnetListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet"
+}
+
+func (s *subnetListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"vpc_no": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the subnets of this VPC.",
+			},
+		},
+	}
+}
+
+func (s *subnetListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	s.config = config
+}
+
+func (s *subnetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if !s.config.SupportVPC {
+		var diags diag.Diagnostics
+		diags.AddError("Not support classic", "list resource ncloud_subnet does not support classic")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var data subnetListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	reqParams := &vpc.GetSubnetListRequest{
+		RegionCode: &s.config.RegionCode,
+		VpcNo:      data.VpcNo.ValueStringPointer(),
+	}
+
+	subnets, err := getSubnetList(ctx, s.config, reqParams, int(req.Limit))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("GetSubnetList", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, output := range subnets {
+			result := req.NewListResult(ctx)
+			result.DisplayName = ncloud.StringValue(output.SubnetName)
+			result.Diagnostics.Append(framework.SetIDIdentity(ctx, result.Identity, types.StringPointerValue(output.SubnetNo))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var model subnetResourceModel
+				framework.NullAttributes(ctx, result.Resource)
+				result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+				if err := model.refreshFromOutput(output); err != nil {
+					result.Diagnostics.AddError("refreshing subnet details", err.Error())
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+type subnetListResourceModel struct {
+	VpcNo types.String `tfsdk:"vpc_no"`
+}
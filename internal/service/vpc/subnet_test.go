@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -18,11 +17,11 @@ import (
 
 func TestAccResourceNcloudSubnet_basic(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-basic-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "subnet")
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSubnetDestroy,
@@ -49,7 +48,7 @@ func TestAccResourceNcloudSubnet_basic(t *testing.T) {
 
 func TestAccResourceNcloudSubnet_disappears(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-disappears-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "subnet")
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -72,11 +71,11 @@ func TestAccResourceNcloudSubnet_disappears(t *testing.T) {
 
 func TestAccResourceNcloudSubnet_updateName(t *testing.T) {
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-name-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "subnet")
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSubnetDestroy,
@@ -104,7 +103,7 @@ func TestAccResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
 	t.Skip()
 
 	var subnet vpc.Subnet
-	name := fmt.Sprintf("test-subnet-update-nacl-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "subnet")
 	cidr := "10.2.2.0/24"
 	resourceName := "ncloud_subnet.bar"
 
@@ -130,17 +129,17 @@ func TestAccResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
 }
 
 func TestAccResourceNcloudSubnet_InvalidCIDR(t *testing.T) {
-	name := fmt.Sprintf("test-subnet-update-nacl-%s", sdkacctest.RandString(5))
+	name := RandomName(t, "subnet")
 	cidr := "10.3.2.0/24"
 
-	resource.Test(t, resource.TestCase{
+	VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckSubnetDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccResourceNcloudSubnetConfigInvalidCIDR(name, cidr),
-				ExpectError: regexp.MustCompile("The subnet must belong to the IPv4 CIDR of the specified VPC."),
+				ExpectError: regexp.MustCompile(`The subnet must belong\s+to the IPv4 CIDR of the specified VPC.`),
 			},
 		},
 	})
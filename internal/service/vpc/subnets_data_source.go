@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -81,6 +82,7 @@ func (s *subnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 				Description: "Usage type. GEN(Normal), LOADB(Load Balance), BM(BareMetal), NATGW(NAT Gateway). default : GEN(Normal).",
 			},
+			"max_results": common.MaxResultsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"filter": common.DataSourceFiltersBlock(),
@@ -191,25 +193,13 @@ func (s *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		reqParams.UsageTypeCode = data.UsageType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetSubnetList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
-
+	subnets, err := getSubnetList(ctx, s.config, reqParams, int(data.MaxResults.ValueInt64()))
 	if err != nil {
-		var diags diag.Diagnostics
-		diags.AddError(
-			"GetSubnetList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
-		)
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("GetSubnetList", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetSubnetList response", map[string]any{
-		"subnetResponse": common.MarshalUncheckedString(subnetResp),
-	})
 
-	subnetList, diags := flattenSubnets(subnetResp.SubnetList, s.config)
+	subnetList, diags := flattenSubnets(subnets, s.config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -223,6 +213,28 @@ func (s *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// getSubnetList reads every page of subnets matching reqParams, up to maxResults when it is positive
+func getSubnetList(ctx context.Context, config *conn.ProviderConfig, reqParams *vpc.GetSubnetListRequest, maxResults int) ([]*vpc.Subnet, error) {
+	return common.ListAllPages(maxResults, func(pageNo, pageSize int32) ([]*vpc.Subnet, *int32, error) {
+		pageParams := *reqParams
+		pageParams.PageNo = ncloud.Int32(pageNo)
+		pageParams.PageSize = ncloud.Int32(pageSize)
+
+		tflog.Info(ctx, "GetSubnetList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(pageParams),
+		})
+		subnetResp, err := config.Client.Vpc.V2Api.GetSubnetList(&pageParams)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(pageParams))
+		}
+		tflog.Info(ctx, "GetSubnetList response", map[string]any{
+			"subnetResponse": common.MarshalUncheckedString(subnetResp),
+		})
+
+		return subnetResp.SubnetList, subnetResp.TotalRows, nil
+	})
+}
+
 type subnetsDataSourceModel struct {
 	Filters      types.Set    `tfsdk:"filter"`
 	ID           types.String `tfsdk:"id"`
@@ -233,6 +245,7 @@ type subnetsDataSourceModel struct {
 	NetworkAclNo types.String `tfsdk:"network_acl_no"`
 	SubnetType   types.String `tfsdk:"subnet_type"`
 	UsageType    types.String `tfsdk:"usage_type"`
+	MaxResults   types.Int64  `tfsdk:"max_results"`
 	Subnets      types.List   `tfsdk:"subnets"`
 }
 
@@ -0,0 +1,258 @@
+//go:build sweep
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("ncloud_vpc", &resource.Sweeper{
+		Name: "ncloud_vpc",
+		F:    sweepVpcs,
+		Dependencies: []string{
+			"ncloud_subnet",
+			"ncloud_vpc_peering",
+			"ncloud_network_acl",
+			"ncloud_network_acl_deny_allow_group",
+			"ncloud_route_table",
+			"ncloud_access_control_group",
+			"ncloud_lb_target_group",
+		},
+	})
+
+	resource.AddTestSweepers("ncloud_subnet", &resource.Sweeper{
+		Name: "ncloud_subnet",
+		F:    sweepSubnets,
+		Dependencies: []string{
+			"ncloud_server",
+			"ncloud_network_interface",
+			"ncloud_nat_gateway",
+			"ncloud_lb",
+			"ncloud_auto_scaling_group",
+			"ncloud_nks_cluster",
+			"ncloud_mysql",
+			"ncloud_postgresql",
+			"ncloud_mongodb",
+			"ncloud_mssql",
+			"ncloud_redis",
+			"ncloud_hadoop",
+			"ncloud_ses_cluster",
+			"ncloud_cdss_cluster",
+			"ncloud_nas_volume",
+		},
+	})
+
+	resource.AddTestSweepers("ncloud_nat_gateway", &resource.Sweeper{
+		Name: "ncloud_nat_gateway",
+		F:    sweepNatGateways,
+	})
+
+	resource.AddTestSweepers("ncloud_vpc_peering", &resource.Sweeper{
+		Name: "ncloud_vpc_peering",
+		F:    sweepVpcPeerings,
+	})
+
+	resource.AddTestSweepers("ncloud_network_acl", &resource.Sweeper{
+		Name:         "ncloud_network_acl",
+		F:            sweepNetworkAcls,
+		Dependencies: []string{"ncloud_subnet"},
+	})
+
+	resource.AddTestSweepers("ncloud_network_acl_deny_allow_group", &resource.Sweeper{
+		Name:         "ncloud_network_acl_deny_allow_group",
+		F:            sweepNetworkAclDenyAllowGroups,
+		Dependencies: []string{"ncloud_network_acl"},
+	})
+
+	resource.AddTestSweepers("ncloud_route_table", &resource.Sweeper{
+		Name:         "ncloud_route_table",
+		F:            sweepRouteTables,
+		Dependencies: []string{"ncloud_subnet"},
+	})
+}
+
+func sweepVpcs(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetVpcList(&vpc.GetVpcListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing vpcs: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.VpcList {
+		if !sweep.IsTestName(v.VpcName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewVpcResource, map[string]string{
+			"id":     *v.VpcNo,
+			"vpc_no": *v.VpcNo,
+		}, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepSubnets(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetSubnetList(&vpc.GetSubnetListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing subnets: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.SubnetList {
+		if !sweep.IsTestName(v.SubnetName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewSubnetResource, map[string]string{
+			"id":        *v.SubnetNo,
+			"subnet_no": *v.SubnetNo,
+		}, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNatGateways(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetNatGatewayInstanceList(&vpc.GetNatGatewayInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing nat gateways: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.NatGatewayInstanceList {
+		if !sweep.IsTestName(v.NatGatewayName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewNatGatewayResource, map[string]string{
+			"id":             *v.NatGatewayInstanceNo,
+			"nat_gateway_no": *v.NatGatewayInstanceNo,
+		}, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepVpcPeerings(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetVpcPeeringInstanceList(&vpc.GetVpcPeeringInstanceListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing vpc peerings: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.VpcPeeringInstanceList {
+		if !sweep.IsTestName(v.VpcPeeringName) {
+			continue
+		}
+		sweepables = append(sweepables, sweep.NewSweepFrameworkResource(NewVpcPeeringResource, map[string]string{
+			"id":             *v.VpcPeeringInstanceNo,
+			"vpc_peering_no": *v.VpcPeeringInstanceNo,
+		}, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkAcls(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing network acls: %w", err)
+	}
+
+	r := ResourceNcloudNetworkACL()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.NetworkAclList {
+		// default network ACLs go away with their VPC
+		if !sweep.IsTestName(v.NetworkAclName) || (v.IsDefault != nil && *v.IsDefault) {
+			continue
+		}
+		d := r.Data(nil)
+		d.SetId(*v.NetworkAclNo)
+		d.Set("network_acl_no", v.NetworkAclNo)
+		sweepables = append(sweepables, sweep.NewSweepResource(r, d, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepNetworkAclDenyAllowGroups(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetNetworkAclDenyAllowGroupList(&vpc.GetNetworkAclDenyAllowGroupListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing network acl deny-allow groups: %w", err)
+	}
+
+	r := ResourceNcloudNetworkACLDenyAllowGroup()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.NetworkAclDenyAllowGroupList {
+		if !sweep.IsTestName(v.NetworkAclDenyAllowGroupName) {
+			continue
+		}
+		d := r.Data(nil)
+		d.SetId(*v.NetworkAclDenyAllowGroupNo)
+		sweepables = append(sweepables, sweep.NewSweepResource(r, d, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
+
+func sweepRouteTables(region string) error {
+	config, err := sweep.SharedRegionalClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	resp, err := config.Client.Vpc.V2Api.GetRouteTableList(&vpc.GetRouteTableListRequest{RegionCode: &config.RegionCode})
+	if err != nil {
+		return fmt.Errorf("listing route tables: %w", err)
+	}
+
+	r := ResourceNcloudRouteTable()
+	var sweepables []sweep.Sweepable
+	for _, v := range resp.RouteTableList {
+		// default route tables go away with their VPC
+		if !sweep.IsTestName(v.RouteTableName) || (v.IsDefault != nil && *v.IsDefault) {
+			continue
+		}
+		d := r.Data(nil)
+		d.SetId(*v.RouteTableNo)
+		d.Set("route_table_no", v.RouteTableNo)
+		sweepables = append(sweepables, sweep.NewSweepResource(r, d, config))
+	}
+
+	return sweep.SweepOrchestrator(context.Background(), sweepables)
+}
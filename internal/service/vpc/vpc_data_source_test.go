@@ -2,7 +2,6 @@ package vpc_test
 
 import (
 	"fmt"
-	"math/rand"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -10,13 +9,13 @@ import (
 )
 
 func TestAccDataSourceNcloudVpc(t *testing.T) {
-	rInt := rand.Intn(16)
+	rInt := acctest.RandIntn(t, 16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("testacc-vpc-basic-%d", rInt)
+	name := acctest.RandomName(t, "vpc")
 	resourceName := "ncloud_vpc.test"
 	dataName := "data.ncloud_vpc.by_id"
 
-	resource.Test(t, resource.TestCase{
+	acctest.VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
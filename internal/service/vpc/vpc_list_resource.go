@@ -0,0 +1,117 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ list.ListResource              = &vpcListResource{}
+	_ list.ListResourceWithConfigure = &vpcListResource{}
+)
+
+// NewVpcListResource lists the VPCs that ncloud_vpcs reads, for import blocks
+func NewVpcListResource() list.ListResource {
+	return &vpcListResource{}
+}
+
+type vpcListResource struct {
+	config *conn.ProviderConfig
+}
+
+func (v *vpcListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc"
+}
+
+func (v *vpcListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the VPC with this name.",
+			},
+		},
+	}
+}
+
+func (v *vpcListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.config = config
+}
+
+func (v *vpcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if !v.config.SupportVPC {
+		var diags diag.Diagnostics
+		diags.AddError("Not support classic", "list resource ncloud_vpc does not support classic")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var data vpcListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	reqParams := &vpc.GetVpcListRequest{
+		RegionCode: &v.config.RegionCode,
+		VpcName:    data.Name.ValueStringPointer(),
+	}
+
+	vpcs, err := getVpcList(ctx, v.config, reqParams, int(req.Limit))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("GetVpcList", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, output := range vpcs {
+			result := req.NewListResult(ctx)
+			result.DisplayName = ncloud.StringValue(output.VpcName)
+			result.Diagnostics.Append(framework.SetIDIdentity(ctx, result.Identity, types.StringPointerValue(output.VpcNo))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var model vpcResourceModel
+				framework.NullAttributes(ctx, result.Resource)
+				result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+				if err := model.refreshFromOutput(output, v.config); err != nil {
+					result.Diagnostics.AddError("refreshing vpc details", err.Error())
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+type vpcListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
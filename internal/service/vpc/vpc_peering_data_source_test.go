@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -12,7 +11,7 @@ import (
 )
 
 func TestAccDataSourceNcloudVpcPeering_basic(t *testing.T) {
-	name := fmt.Sprintf("test-peering-data-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "peering")
 	resourceName := "ncloud_vpc_peering.foo"
 	dataNameById := "data.ncloud_vpc_peering.by_id"
 	dataNameByName := "data.ncloud_vpc_peering.by_name"
@@ -20,7 +19,7 @@ func TestAccDataSourceNcloudVpcPeering_basic(t *testing.T) {
 	dataNameByTargetName := "data.ncloud_vpc_peering.by_target_name"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vpc/createVpcPeeringInstance") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
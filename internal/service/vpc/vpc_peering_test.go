@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -20,10 +19,10 @@ import (
 func TestAccResourceNcloudVpcPeering_basic(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-basic-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "peering")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vpc/createVpcPeeringInstance") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckVpcPeeringDestroy,
 		Steps: []resource.TestStep{
@@ -50,10 +49,10 @@ func TestAccResourceNcloudVpcPeering_Peering(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceNameMain := "ncloud_vpc_peering.foo"
 	resourceNamePeer := "ncloud_vpc_peering.bar"
-	name := fmt.Sprintf("test-peering-basic-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "peering")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vpc/createVpcPeeringInstance") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckVpcPeeringDestroy,
 		Steps: []resource.TestStep{
@@ -75,10 +74,10 @@ func TestAccResourceNcloudVpcPeering_Peering(t *testing.T) {
 func TestAccResourceNcloudVpcPeering_disappears(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-disap-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "peering")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vpc/createVpcPeeringInstance") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckVpcPeeringDestroy,
 		Steps: []resource.TestStep{
@@ -97,10 +96,10 @@ func TestAccResourceNcloudVpcPeering_disappears(t *testing.T) {
 func TestAccResourceNcloudVpcPeering_description(t *testing.T) {
 	var vpcPeeringInstance vpc.VpcPeeringInstance
 	resourceName := "ncloud_vpc_peering.foo"
-	name := fmt.Sprintf("test-peering-desc-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "peering")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		PreCheck:                 func() { acctest.TestAccPreCheck(t); acctest.TestAccPreCheckMock(t, "vpc/createVpcPeeringInstance") },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckRouteTableDestroy,
 		Steps: []resource.TestStep{
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -19,12 +18,12 @@ import (
 
 func TestAccResourceNcloudVpc_basic(t *testing.T) {
 	var vpc vpc.Vpc
-	rInt := rand.Intn(16)
+	rInt := acctest.RandIntn(t, 16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-basic-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "vpc")
 	resourceName := "ncloud_vpc.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.VCRTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckVpcDestroy,
@@ -50,7 +49,7 @@ func TestAccResourceNcloudVpc_disappears(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-disapr-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "vpc")
 	resourceName := "ncloud_vpc.test"
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +73,7 @@ func TestAccResourceNcloudVpc_updateName(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
 	cidr := fmt.Sprintf("10.%d.0.0/16", rInt)
-	name := fmt.Sprintf("test-vpc-name-%s", sdkacctest.RandString(5))
+	name := acctest.RandomName(t, "vpc")
 	resourceName := "ncloud_vpc.test"
 
 	resource.Test(t, resource.TestCase{
@@ -92,6 +91,53 @@ func TestAccResourceNcloudVpc_updateName(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudVpc_region(t *testing.T) {
+	var home, dr vpc.Vpc
+	name := acctest.RandomName(t, "vpc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudVpcConfigRegion(name, "JPN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("ncloud_vpc.home", &home),
+					testAccCheckVpcExists("ncloud_vpc.dr", &dr),
+					resource.TestCheckResourceAttr("ncloud_vpc.dr", "region", "JPN"),
+					resource.TestCheckResourceAttr("ncloud_network_acl.dr", "region", "JPN"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources["ncloud_vpc.home"].Primary.Attributes["region"]; got != *home.RegionCode {
+							return fmt.Errorf("home vpc has region %s in state, created in %s", got, *home.RegionCode)
+						}
+						if *dr.RegionCode != "JPN" {
+							return fmt.Errorf("dr vpc created in region %s, want JPN", *dr.RegionCode)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccResourceNcloudVpcConfigRegion(name, "JPN"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName: "ncloud_vpc.dr",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["ncloud_vpc.dr"].Primary.ID + "@JPN", nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccResourceNcloudVpcConfigRegion(name, "NOWHERE"),
+				ExpectError: regexp.MustCompile("no region data for region_code `NOWHERE`"),
+			},
+		},
+	})
+}
+
 func testAccResourceNcloudVpcConfig(name, cidr string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test" {
@@ -112,7 +158,11 @@ func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		config := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
+		config, err := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig).ForRegion(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+
 		vpcInstance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -125,13 +175,18 @@ func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
 }
 
 func testAccCheckVpcDestroy(s *terraform.State) error {
-	config := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
+	provider := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ncloud_vpc" {
 			continue
 		}
 
+		config, err := provider.ForRegion(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+
 		instance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
 
 		if err != nil {
@@ -164,3 +219,24 @@ func testAccCheckVpcDisappears(instance *vpc.Vpc) resource.TestCheckFunc {
 		return err
 	}
 }
+
+func testAccResourceNcloudVpcConfigRegion(name, region string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "home" {
+	name            = "%[1]s-home"
+	ipv4_cidr_block = "10.0.0.0/16"
+}
+
+resource "ncloud_vpc" "dr" {
+	region          = "%[2]s"
+	name            = "%[1]s-dr"
+	ipv4_cidr_block = "10.1.0.0/16"
+}
+
+resource "ncloud_network_acl" "dr" {
+	region = ncloud_vpc.dr.region
+	vpc_no = ncloud_vpc.dr.vpc_no
+	name   = "%[1]s-dr"
+}
+`, name, region)
+}
@@ -50,6 +50,7 @@ func (v *vpcsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			"vpc_no": schema.StringAttribute{
 				Optional: true,
 			},
+			"max_results": common.MaxResultsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"filter": common.DataSourceFiltersBlock(),
@@ -133,25 +134,13 @@ func (v *vpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		reqParams.VpcName = data.Name.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
-	vpcResp, err := v.config.Client.Vpc.V2Api.GetVpcList(reqParams)
-
+	vpcs, err := getVpcList(ctx, v.config, reqParams, int(data.MaxResults.ValueInt64()))
 	if err != nil {
-		var diags diag.Diagnostics
-		diags.AddError(
-			"GetVpcList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
-		)
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("GetVpcList", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetVpcList response", map[string]any{
-		"vpcResponse": common.MarshalUncheckedString(vpcResp),
-	})
 
-	vpcList, diags := flattenVpcs(ctx, vpcResp.VpcList, v.config)
+	vpcList, diags := flattenVpcs(ctx, vpcs, v.config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,12 +154,30 @@ func (v *vpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// getVpcList reads the VPCs matching reqParams, up to maxResults when it is positive
+func getVpcList(ctx context.Context, config *conn.ProviderConfig, reqParams *vpc.GetVpcListRequest, maxResults int) ([]*vpc.Vpc, error) {
+	tflog.Info(ctx, "GetVpcList", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	vpcResp, err := config.Client.Vpc.V2Api.GetVpcList(reqParams)
+	if err != nil {
+		return nil, fmt.Errorf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams))
+	}
+	tflog.Info(ctx, "GetVpcList response", map[string]any{
+		"vpcResponse": common.MarshalUncheckedString(vpcResp),
+	})
+
+	// The VPC list API has no paging parameters and returns every VPC at once
+	return common.LimitResults(vpcResp.VpcList, maxResults), nil
+}
+
 type vpcsDataSourceModel struct {
-	Filters types.Set    `tfsdk:"filter"`
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	VpcNo   types.String `tfsdk:"vpc_no"`
-	Vpcs    types.List   `tfsdk:"vpcs"`
+	Filters    types.Set    `tfsdk:"filter"`
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	VpcNo      types.String `tfsdk:"vpc_no"`
+	MaxResults types.Int64  `tfsdk:"max_results"`
+	Vpcs       types.List   `tfsdk:"vpcs"`
 }
 
 func (d *vpcsDataSourceModel) refreshFromVpcOutputModel(ctx context.Context, vpcModels []*vpcDataSourceModel, config *conn.ProviderConfig) diag.Diagnostics {
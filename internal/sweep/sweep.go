@@ -0,0 +1,232 @@
+// Package sweep removes infrastructure left behind by failed acceptance test runs.
+//
+// Sweepers are registered by the RegisterSweepers function of each service
+// package, which is only built with the sweep tag, and run with
+//
+//	make sweep SWEEP=KR
+//
+// Everything named by acctest.RandomName or acctest.GetTestPrefix is deleted, so
+// acceptance tests must name what they create with them, and sweepers must only
+// run against a dedicated test account.
+//
+// Sweepers delete objects without their Terraform state, so the
+// deletion_protection argument of a resource does not stop them. Protection
+// set on the object itself (NKS cluster and NAS volume return protection) is
+// honored.
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// testNamePattern matches the names generated by the acceptance tests:
+//   - RandomName, TestNamePrefix ("tf-acc") + a kind + 5 random letters, optionally followed by the
+//     suffixes the test configurations add for related objects, e.g. "tf-acc-vpc-abcde" or "tf-acc-my-abcde-key"
+//   - GetTestPrefix ("tfacc" + 5 random letters) with the suffix added by the tests, e.g. "tfaccabcde-vm" or "tfaccabcde_lb"
+var testNamePattern = regexp.MustCompile(`^(tf-acc-[a-z0-9]+-[a-z]{5}(-[a-z0-9]+)*|tfacc[a-z]{5}[-_][a-z0-9]+)$`)
+
+// testPrefixPattern matches GetTestPrefix, for objects named after it alone
+var testPrefixPattern = regexp.MustCompile(`^tfacc[a-z]{5}$`)
+
+var (
+	clientsMu sync.Mutex
+	clients   = map[string]*conn.ProviderConfig{}
+)
+
+// IsTestName reports whether name was generated by an acceptance test.
+func IsTestName(name *string) bool {
+	return name != nil && testNamePattern.MatchString(*name)
+}
+
+// IsTestPrefix reports whether name is a prefix generated by GetTestPrefix.
+func IsTestPrefix(name *string) bool {
+	return name != nil && testPrefixPattern.MatchString(*name)
+}
+
+// SharedRegionalClient returns the provider configuration of region, with credentials
+// and site read from the environment like the acceptance tests.
+func SharedRegionalClient(region string) (*conn.ProviderConfig, error) {
+	return sharedClient(region, true)
+}
+
+// SharedRegionalClassicClient is SharedRegionalClient for the Classic platform.
+func SharedRegionalClassicClient(region string) (*conn.ProviderConfig, error) {
+	return sharedClient(region, false)
+}
+
+func sharedClient(region string, supportVpc bool) (*conn.ProviderConfig, error) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	key := fmt.Sprintf("%s-%t", region, supportVpc)
+	if c, ok := clients[key]; ok {
+		return c, nil
+	}
+
+	accessKey, secretKey := os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY")
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("NCLOUD_ACCESS_KEY and NCLOUD_SECRET_KEY must be set for sweepers")
+	}
+
+	site := os.Getenv("NCLOUD_SITE")
+	apiGateway := os.Getenv("NCLOUD_API_GW")
+	if gw := conn.SiteAPIGateway(site); gw != "" {
+		apiGateway = gw
+	}
+	config := conn.Config{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     region,
+		APIGateway: apiGateway,
+	}
+	c := &conn.ProviderConfig{
+		Site:       site,
+		SupportVPC: supportVpc || site == "fin",
+		RegionCode: region,
+	}
+	if err := c.NewClient(config, os.Getenv("NCLOUD_OBS_ENDPOINT")); err != nil {
+		return nil, err
+	}
+	if err := c.LoadRegions(); err != nil {
+		return nil, err
+	}
+	if !c.IsValidRegionCode(region) {
+		return nil, fmt.Errorf("no region data for region_code `%s`", region)
+	}
+	if !c.SupportVPC {
+		c.RegionNo = *c.GetRegionNoByCode(region)
+	}
+	clients[key] = c
+
+	return c, nil
+}
+
+// Sweepable is a single object to delete.
+type Sweepable interface {
+	Delete(ctx context.Context) error
+}
+
+type sweepResource struct {
+	d        *schema.ResourceData
+	meta     interface{}
+	resource *schema.Resource
+}
+
+// NewSweepResource deletes d through the Delete function of an SDK resource, like `terraform destroy`.
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) Sweepable {
+	return &sweepResource{d: d, meta: meta, resource: resource}
+}
+
+// NewSweepResourceByID is NewSweepResource for resources whose Delete only reads the ID.
+func NewSweepResourceByID(resource *schema.Resource, id string, meta interface{}) Sweepable {
+	d := resource.Data(nil)
+	d.SetId(id)
+	return NewSweepResource(resource, d, meta)
+}
+
+func (r *sweepResource) Delete(ctx context.Context) error {
+	switch {
+	case r.resource.DeleteContext != nil:
+		if diags := r.resource.DeleteContext(ctx, r.d, r.meta); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	case r.resource.DeleteWithoutTimeout != nil:
+		if diags := r.resource.DeleteWithoutTimeout(ctx, r.d, r.meta); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	case r.resource.Delete != nil:
+		return r.resource.Delete(r.d, r.meta)
+	default:
+		return fmt.Errorf("resource has no delete function")
+	}
+	return nil
+}
+
+type sweepFrameworkResource struct {
+	factory func() resource.Resource
+	attrs   map[string]string
+	meta    *conn.ProviderConfig
+}
+
+// NewSweepFrameworkResource deletes an object through the Delete method of a framework resource.
+// Its state only holds the given string attributes, which must include everything Delete reads.
+func NewSweepFrameworkResource(factory func() resource.Resource, attrs map[string]string, meta *conn.ProviderConfig) Sweepable {
+	return &sweepFrameworkResource{factory: factory, attrs: attrs, meta: meta}
+}
+
+func (r *sweepFrameworkResource) Delete(ctx context.Context) error {
+	res := r.factory()
+
+	if v, ok := res.(resource.ResourceWithConfigure); ok {
+		resp := &resource.ConfigureResponse{}
+		v.Configure(ctx, resource.ConfigureRequest{ProviderData: r.meta}, resp)
+		if resp.Diagnostics.HasError() {
+			return fmt.Errorf("configuring resource: %v", resp.Diagnostics)
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return fmt.Errorf("reading resource schema: %v", schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nulls := map[string]tftypes.Value{}
+	for name, t := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(t, nil)
+	}
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, nulls),
+	}
+	for name, value := range r.attrs {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			return fmt.Errorf("setting %s: %v", name, diags)
+		}
+	}
+
+	resp := &resource.DeleteResponse{State: state}
+	res.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		return fmt.Errorf("%v", resp.Diagnostics)
+	}
+	return nil
+}
+
+// SweepOrchestrator deletes all sweepables concurrently and returns every error.
+func SweepOrchestrator(ctx context.Context, sweepables []Sweepable) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for _, s := range sweepables {
+		wg.Add(1)
+		go func(s Sweepable) {
+			defer wg.Done()
+			if err := s.Delete(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
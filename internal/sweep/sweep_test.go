@@ -0,0 +1,62 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+func TestIsTestName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected bool
+	}{
+		{"tf-acc-vpc-abcde", true},
+		{"tf-acc-my-abcde", true},
+		{"tf-acc-vm-abcde", true},
+		{"tf-acc-natgw-abcde", true},
+		{"tf-acc-my-abcde-key", true},
+		{"tf-acc-asg-abcde-prcnt", true},
+		{"tf-acc-nic-abcde-eth-1", true},
+		{"tfaccabcde-vm", true},
+		{"tfaccabcde_lb", true},
+		{"tf-acc-vpc", false},
+		{"tf-acc-vpc-abc", false},
+		{"tf-acc-vpc-abcdef", false},
+		{"tf-acc-vpc-12345", false},
+		{"tfaccabcd-vm", false},
+		{"tfabcde-vm", false},
+		{"tf-bucket-abcde", false},
+		{"tf-1234-cluster", false},
+		{"tf-prod-api", false},
+		{"tf-shared-vpc", false},
+		{"tf-state-store", false},
+		{"tf-infra-main-vpc", false},
+		{"tfstate-vm", false},
+		{"tf-1-prod", false},
+		{"test-env-web-2024", false},
+		{"tfstate", false},
+		{"tfaccabcde", false},
+		{"testing-bucket", false},
+		{"prod-tf-acc-vpc-abcde", false},
+	}
+
+	for _, tc := range cases {
+		if got := IsTestName(ncloud.String(tc.name)); got != tc.expected {
+			t.Errorf("IsTestName(%q) = %t, want %t", tc.name, got, tc.expected)
+		}
+	}
+
+	if IsTestName(nil) {
+		t.Error("IsTestName(nil) = true, want false")
+	}
+}
+
+func TestIsTestPrefix(t *testing.T) {
+	if !IsTestPrefix(ncloud.String("tfaccabcde")) {
+		t.Error(`IsTestPrefix("tfaccabcde") = false, want true`)
+	}
+	if IsTestPrefix(ncloud.String("tfaccabcde-vm")) || IsTestPrefix(ncloud.String("tf-acc-vpc-abcde")) || IsTestPrefix(ncloud.String("tfstate")) {
+		t.Error("IsTestPrefix matched a name with a suffix")
+	}
+}
@@ -0,0 +1,103 @@
+//go:build sweep
+
+package sweep_test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/mockncp"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/autoscaling"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/cdss"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/classicloadbalancer"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/devtools"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mssql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nasvolume"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/objectstorage"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/ses"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/sweep"
+)
+
+// TestMain runs the sweepers of every service when -sweep is given, and the tests otherwise.
+func TestMain(m *testing.M) {
+	autoscaling.RegisterSweepers()
+	cdss.RegisterSweepers()
+	classicloadbalancer.RegisterSweepers()
+	devtools.RegisterSweepers()
+	hadoop.RegisterSweepers()
+	loadbalancer.RegisterSweepers()
+	mongodb.RegisterSweepers()
+	mssql.RegisterSweepers()
+	mysql.RegisterSweepers()
+	nasvolume.RegisterSweepers()
+	nks.RegisterSweepers()
+	objectstorage.RegisterSweepers()
+	postgresql.RegisterSweepers()
+	redis.RegisterSweepers()
+	server.RegisterSweepers()
+	ses.RegisterSweepers()
+	vpc.RegisterSweepers()
+
+	resource.TestMain(m)
+}
+
+// TestSweepMock runs `make sweep` for the Redis config groups against the mock
+// server, and checks that only the config group named by a test is deleted.
+func TestSweepMock(t *testing.T) {
+	mock := mockncp.NewServer("KR")
+	defer mock.Close()
+
+	t.Setenv("NCLOUD_API_GW", mock.URL)
+	t.Setenv("NCLOUD_ACCESS_KEY", "mock")
+	t.Setenv("NCLOUD_SECRET_KEY", "mock")
+	t.Setenv("NCLOUD_SITE", "")
+
+	config, err := sweep.SharedRegionalClient("KR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := config.Client.Vredis.V2Api
+
+	for _, name := range []string{"tf-acc-cg-abcde", "tf-prod-cache"} {
+		_, err := client.CreateCloudRedisConfigGroup(&vredis.CreateCloudRedisConfigGroupRequest{
+			RegionCode:        ncloud.String("KR"),
+			ConfigGroupName:   ncloud.String(name),
+			CloudRedisVersion: ncloud.String("7.0.13-simple"),
+		})
+		if err != nil {
+			t.Fatalf("creating config group %s: %s", name, err)
+		}
+	}
+
+	// Sweepers run in place of the tests, so they need a process of their own
+	out, err := exec.Command(os.Args[0], "-sweep=KR", "-sweep-run=ncloud_redis_config_group").CombinedOutput()
+	if err != nil {
+		t.Fatalf("running sweepers: %s\n%s", err, out)
+	}
+
+	resp, err := client.GetCloudRedisConfigGroupList(&vredis.GetCloudRedisConfigGroupListRequest{RegionCode: ncloud.String("KR")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, v := range resp.CloudRedisConfigGroupList {
+		names = append(names, *v.ConfigGroupName)
+	}
+	if len(names) != 1 || names[0] != "tf-prod-cache" {
+		t.Errorf("config groups after sweeping = %v, want [tf-prod-cache]", names)
+	}
+}
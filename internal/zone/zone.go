@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"sync"
-
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -21,8 +19,6 @@ type Zone struct {
 	RegionCode      *string `json:"regionCode,omitempty"`
 }
 
-var zoneCache = sync.Map{}
-
 func ParseZoneNoParameter(config *conn.ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if zoneCode, zoneCodeOk := d.GetOk("zone"); zoneCodeOk {
 		zoneNo := GetZoneNoByCode(config, zoneCode.(string))
@@ -36,11 +32,11 @@ func ParseZoneNoParameter(config *conn.ProviderConfig, d *schema.ResourceData) (
 }
 
 func GetZoneNoByCode(config *conn.ProviderConfig, code string) string {
-	if zoneNo, ok := zoneCache.Load(code); ok {
-		return zoneNo.(string)
+	if zoneNo, ok := config.CachedZoneNo(code); ok {
+		return zoneNo
 	}
 	if zone, err := GetZoneByCode(config, code); err == nil && zone != nil {
-		zoneCache.Store(code, *zone.ZoneNo)
+		config.CacheZoneNo(code, *zone.ZoneNo)
 		return *zone.ZoneNo
 	}
 	return ""